@@ -306,6 +306,10 @@ func (c *CapsHostCPU) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), c)
 }
 
+func (c *CapsHostCPU) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(c, doc)
+}
+
 func (c *CapsHostCPU) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(c, "", "  ")
 	if err != nil {
@@ -318,6 +322,10 @@ func (c *Caps) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), c)
 }
 
+func (c *Caps) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(c, doc)
+}
+
 func (c *Caps) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(c, "", "  ")
 	if err != nil {
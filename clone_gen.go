@@ -0,0 +1,376 @@
+//go:build ignore
+// +build ignore
+
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+// This program generates document_clone.go, containing Clone and
+// Equal methods for every struct making up a document. Run it
+// with "go generate" after changing any of the structs
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const output = "document_clone.go"
+
+type generator struct {
+	pkg       *types.Package
+	structs   map[*types.Named]bool
+	marshaler *types.Named
+	imports   map[string]bool
+	buf       bytes.Buffer
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) typeName(t types.Type) string {
+	return types.TypeString(t, func(p *types.Package) string {
+		if p == g.pkg {
+			return ""
+		}
+		g.imports[p.Path()] = true
+		return p.Name()
+	})
+}
+
+// hasMethods reports whether t is one of the structs we are
+// generating Clone and Equal for
+func (g *generator) hasMethods(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	return ok && g.structs[named]
+}
+
+// isShallow reports whether a plain assignment fully copies t
+func (g *generator) isShallow(t types.Type) bool {
+	if g.hasMethods(t) {
+		return g.isShallow(t.Underlying())
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		return true
+	case *types.Array:
+		return g.isShallow(u.Elem())
+	case *types.Struct:
+		for i := 0; i < u.NumFields(); i++ {
+			if !g.isShallow(u.Field(i).Type()) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// clone emits statements assigning a deep copy of src, which
+// has already been shallow copied into dst
+func (g *generator) clone(dst, src string, t types.Type, depth int) {
+	if g.isShallow(t) {
+		return
+	}
+	if g.hasMethods(t) {
+		g.printf("%s = *%s.Clone()\n", dst, src)
+		return
+	}
+	idx := string(rune('i' + depth))
+	switch u := t.Underlying().(type) {
+	case *types.Pointer:
+		if g.hasMethods(u.Elem()) {
+			g.printf("%s = %s.Clone()\n", dst, src)
+			return
+		}
+		g.printf("if %s != nil {\n", src)
+		g.printf("v := *%s\n", src)
+		g.clone("v", "(*"+src+")", u.Elem(), depth+1)
+		g.printf("%s = &v\n", dst)
+		g.printf("}\n")
+	case *types.Slice:
+		g.printf("if %s != nil {\n", src)
+		g.printf("%s = make(%s, len(%s))\n", dst, g.typeName(t), src)
+		g.printf("copy(%s, %s)\n", dst, src)
+		if !g.isShallow(u.Elem()) {
+			g.printf("for %s := range %s {\n", idx, src)
+			g.clone(dst+"["+idx+"]", src+"["+idx+"]", u.Elem(), depth+1)
+			g.printf("}\n")
+		}
+		g.printf("}\n")
+	case *types.Map:
+		g.printf("if %s != nil {\n", src)
+		g.printf("%s = make(%s, len(%s))\n", dst, g.typeName(t), src)
+		g.printf("for k, v := range %s {\n", src)
+		g.clone("v", "v", u.Elem(), depth+1)
+		g.printf("%s[k] = v\n", dst)
+		g.printf("}\n")
+		g.printf("}\n")
+	case *types.Struct:
+		for i := 0; i < u.NumFields(); i++ {
+			f := u.Field(i)
+			g.clone(dst+"."+f.Name(), src+"."+f.Name(), f.Type(), depth)
+		}
+	default:
+		panic(fmt.Sprintf("cannot clone %s", t))
+	}
+}
+
+// optional reports whether a nil pointer to t marshals the same
+// as a pointer to an empty t. This holds for the union structs,
+// which are either handled by a custom MarshalXML method or are
+// embedded by their parent's MarshalXML method
+func (g *generator) optional(t types.Type, tag string) bool {
+	if !g.hasMethods(t) {
+		return false
+	}
+	if reflect.StructTag(tag).Get("xml") == "-" {
+		return true
+	}
+	marshaler := g.marshaler.Underlying().(*types.Interface)
+	return types.Implements(types.NewPointer(t), marshaler)
+}
+
+// equal emits statements returning false if a and b differ in
+// a way which would change the marshalled XML
+func (g *generator) equal(a, b string, t types.Type, depth int) {
+	if g.hasMethods(t) {
+		g.printf("if !%s.Equal(&%s) {\nreturn false\n}\n", a, b)
+		return
+	}
+	idx := string(rune('i' + depth))
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		g.printf("if %s != %s {\nreturn false\n}\n", a, b)
+	case *types.Pointer:
+		if g.hasMethods(u.Elem()) {
+			g.printf("if !%s.Equal(%s) {\nreturn false\n}\n", a, b)
+			return
+		}
+		g.printf("if (%s == nil) != (%s == nil) {\nreturn false\n}\n", a, b)
+		g.printf("if %s != nil {\n", a)
+		g.equal("(*"+a+")", "(*"+b+")", u.Elem(), depth+1)
+		g.printf("}\n")
+	case *types.Slice:
+		g.printf("if len(%s) != len(%s) {\nreturn false\n}\n", a, b)
+		g.printf("for %s := range %s {\n", idx, a)
+		g.equal(a+"["+idx+"]", b+"["+idx+"]", u.Elem(), depth+1)
+		g.printf("}\n")
+	case *types.Map:
+		g.printf("if len(%s) != len(%s) {\nreturn false\n}\n", a, b)
+		g.printf("for k, v := range %s {\n", a)
+		g.printf("w, ok := %s[k]\nif !ok {\nreturn false\n}\n", b)
+		g.equal("v", "w", u.Elem(), depth+1)
+		g.printf("}\n")
+	case *types.Struct:
+		if types.Comparable(t) {
+			g.printf("if %s != %s {\nreturn false\n}\n", a, b)
+			return
+		}
+		for i := 0; i < u.NumFields(); i++ {
+			f := u.Field(i)
+			g.equal(a+"."+f.Name(), b+"."+f.Name(), f.Type(), depth)
+		}
+	default:
+		panic(fmt.Sprintf("cannot compare %s", t))
+	}
+}
+
+func (g *generator) generate(named *types.Named) {
+	name := named.Obj().Name()
+	st := named.Underlying().(*types.Struct)
+
+	g.printf("\n// Clone returns a deep copy of x\n")
+	g.printf("func (x *%s) Clone() *%s {\n", name, name)
+	g.printf("if x == nil {\nreturn nil\n}\n")
+	g.printf("y := *x\n")
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		g.clone("y."+f.Name(), "x."+f.Name(), f.Type(), 0)
+	}
+	g.printf("return &y\n}\n")
+
+	g.printf("\n// Equal reports whether x and y would marshal to the same XML\n")
+	g.printf("func (x *%s) Equal(y *%s) bool {\n", name, name)
+	g.printf("if x == nil || y == nil {\nreturn x == y\n}\n")
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		// Where the element name is fixed by the struct tag, it
+		// is only filled in by the decoder
+		tag := reflect.StructTag(st.Tag(i)).Get("xml")
+		if f.Name() == "XMLName" && tag != "" {
+			continue
+		}
+		if ptr, ok := f.Type().(*types.Pointer); ok && g.optional(ptr.Elem(), st.Tag(i)) {
+			g.printf("if !x.%s.Equal(y.%s) &&\n", f.Name(), f.Name())
+			g.printf("!(x.%s == nil && y.%s.Equal(&%s{})) &&\n",
+				f.Name(), f.Name(), g.typeName(ptr.Elem()))
+			g.printf("!(y.%s == nil && x.%s.Equal(&%s{})) {\nreturn false\n}\n",
+				f.Name(), f.Name(), g.typeName(ptr.Elem()))
+			continue
+		}
+		g.equal("x."+f.Name(), "y."+f.Name(), f.Type(), 0)
+	}
+	g.printf("return true\n}\n")
+}
+
+// addReachable adds t, and every struct reachable through its
+// fields, to the structs we are generating Clone and Equal for
+func (g *generator) addReachable(list []*types.Named, t types.Type) []*types.Named {
+	switch u := t.(type) {
+	case *types.Pointer:
+		return g.addReachable(list, u.Elem())
+	case *types.Slice:
+		return g.addReachable(list, u.Elem())
+	case *types.Array:
+		return g.addReachable(list, u.Elem())
+	case *types.Map:
+		list = g.addReachable(list, u.Key())
+		return g.addReachable(list, u.Elem())
+	case *types.Named:
+		st, ok := u.Underlying().(*types.Struct)
+		if !ok || g.structs[u] || u.Obj().Pkg() != g.pkg || !u.Obj().Exported() {
+			return list
+		}
+		// Structs with private state, such as DomainBuilder,
+		// cannot be meaningfully copied or compared
+		for i := 0; i < st.NumFields(); i++ {
+			if !st.Field(i).Exported() {
+				return list
+			}
+		}
+		g.structs[u] = true
+		list = append(list, u)
+		for i := 0; i < st.NumFields(); i++ {
+			list = g.addReachable(list, st.Field(i).Type())
+		}
+	}
+	return list
+}
+
+func main() {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != output
+	}, parser.ParseComments)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	var files []*ast.File
+	for _, file := range pkgs["libvirtxml"].Files {
+		files = append(files, file)
+	}
+	// Function bodies may call the methods being generated, so
+	// only the declarations are type checked
+	conf := types.Config{
+		Importer:                 importer.ForCompiler(fset, "source", nil),
+		IgnoreFuncBodies:         true,
+		DisableUnusedImportCheck: true,
+	}
+	pkg, err := conf.Check("libvirtxml", fset, files, nil)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	g := &generator{
+		pkg:     pkg,
+		structs: make(map[*types.Named]bool),
+		imports: make(map[string]bool),
+	}
+	for _, imp := range pkg.Imports() {
+		if imp.Path() == "encoding/xml" {
+			g.marshaler = imp.Scope().Lookup("Marshaler").Type().(*types.Named)
+		}
+	}
+	// Only the types making up documents are of interest, not
+	// helpers like DnsmasqConfigOptions which happen to be structs
+	document := pkg.Scope().Lookup("Document").Type().Underlying().(*types.Interface)
+	var list []*types.Named
+	for _, name := range pkg.Scope().Names() {
+		obj, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+		if !ok || !obj.Exported() || obj.IsAlias() {
+			continue
+		}
+		if types.Implements(types.NewPointer(obj.Type()), document) {
+			list = g.addReachable(list, obj.Type())
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Obj().Pos() < list[j].Obj().Pos()
+	})
+
+	header, err := ioutil.ReadFile("clone_gen.go")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	license := string(header)
+	license = license[strings.Index(license, "/*"):]
+	license = license[:strings.Index(license, "*/")+2]
+
+	for _, named := range list {
+		g.generate(named)
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by clone_gen.go. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "%s\n\npackage libvirtxml\n", license)
+	if len(g.imports) != 0 {
+		var paths []string
+		for path := range g.imports {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		fmt.Fprintf(&out, "\nimport (\n")
+		for _, path := range paths {
+			fmt.Fprintf(&out, "%q\n", path)
+		}
+		fmt.Fprintf(&out, ")\n")
+	}
+	out.Write(g.buf.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := ioutil.WriteFile(output, src, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,261 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CPUSet is a set of CPU or NUMA node numbers, as used in libvirt's
+// cpuset and nodeset attributes. The numbers are kept sorted in
+// ascending order without duplicates
+type CPUSet []uint
+
+// Largest number accepted by ParseCPUSet, to stop typos such as
+// "0-40000000" consuming all memory
+const cpuSetMax = 65535
+
+func parseCPUSetNumber(s string) (uint, error) {
+	val, err := strconv.ParseUint(strings.TrimSpace(s), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid number '%s' in CPU set", s)
+	}
+	if val > cpuSetMax {
+		return 0, fmt.Errorf("Number %d in CPU set is too large", val)
+	}
+	return uint(val), nil
+}
+
+// ParseCPUSet parses libvirt's range syntax, a comma separated list
+// of numbers "N", ranges "N-M" and exclusions "^N", applied in order.
+// For example "0-7,^3,10" is the set 0, 1, 2, 4, 5, 6, 7, 10. An empty
+// string gives an empty set
+func ParseCPUSet(s string) (CPUSet, error) {
+	if strings.TrimSpace(s) == "" {
+		return CPUSet{}, nil
+	}
+
+	members := make(map[uint]bool)
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if strings.HasPrefix(item, "^") {
+			num, err := parseCPUSetNumber(item[1:])
+			if err != nil {
+				return nil, err
+			}
+			delete(members, num)
+			continue
+		}
+
+		bounds := strings.SplitN(item, "-", 2)
+		start, err := parseCPUSetNumber(bounds[0])
+		if err != nil {
+			return nil, err
+		}
+		end := start
+		if len(bounds) == 2 {
+			end, err = parseCPUSetNumber(bounds[1])
+			if err != nil {
+				return nil, err
+			}
+			if end < start {
+				return nil, fmt.Errorf("Invalid range '%s' in CPU set", item)
+			}
+		}
+		for num := start; num <= end; num++ {
+			members[num] = true
+		}
+	}
+
+	set := make(CPUSet, 0, len(members))
+	for num := range members {
+		set = append(set, num)
+	}
+	sort.Slice(set, func(i, j int) bool {
+		return set[i] < set[j]
+	})
+	return set, nil
+}
+
+// NewCPUSet returns a set holding the given numbers
+func NewCPUSet(nums ...uint) CPUSet {
+	return CPUSet{}.Union(CPUSet(nums))
+}
+
+// String formats the set in libvirt's range syntax, eg "0-2,4,6-7"
+func (s CPUSet) String() string {
+	var items []string
+	for i := 0; i < len(s); {
+		j := i
+		for j+1 < len(s) && s[j+1] == s[j]+1 {
+			j++
+		}
+		if j == i {
+			items = append(items, strconv.FormatUint(uint64(s[i]), 10))
+		} else {
+			items = append(items, fmt.Sprintf("%d-%d", s[i], s[j]))
+		}
+		i = j + 1
+	}
+	return strings.Join(items, ",")
+}
+
+// Contains reports whether num is a member of the set
+func (s CPUSet) Contains(num uint) bool {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i] >= num
+	})
+	return i < len(s) && s[i] == num
+}
+
+// Union returns the numbers in either set
+func (s CPUSet) Union(other CPUSet) CPUSet {
+	members := make(map[uint]bool)
+	for _, num := range s {
+		members[num] = true
+	}
+	for _, num := range other {
+		members[num] = true
+	}
+	set := make(CPUSet, 0, len(members))
+	for num := range members {
+		set = append(set, num)
+	}
+	sort.Slice(set, func(i, j int) bool {
+		return set[i] < set[j]
+	})
+	return set
+}
+
+// Intersect returns the numbers in both sets
+func (s CPUSet) Intersect(other CPUSet) CPUSet {
+	set := CPUSet{}
+	for _, num := range s {
+		if other.Contains(num) {
+			set = append(set, num)
+		}
+	}
+	return set
+}
+
+// Subtract returns the numbers in s which are not in other
+func (s CPUSet) Subtract(other CPUSet) CPUSet {
+	set := CPUSet{}
+	for _, num := range s {
+		if !other.Contains(num) {
+			set = append(set, num)
+		}
+	}
+	return set
+}
+
+// Equal reports whether both sets have the same members
+func (s CPUSet) Equal(other CPUSet) bool {
+	if len(s) != len(other) {
+		return false
+	}
+	for i := range s {
+		if s[i] != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *DomainVCPU) GetCPUSet() (CPUSet, error) {
+	return ParseCPUSet(v.CPUSet)
+}
+
+func (v *DomainVCPU) SetCPUSet(set CPUSet) {
+	v.CPUSet = set.String()
+}
+
+func (p *DomainCPUTuneVCPUPin) GetCPUSet() (CPUSet, error) {
+	return ParseCPUSet(p.CPUSet)
+}
+
+func (p *DomainCPUTuneVCPUPin) SetCPUSet(set CPUSet) {
+	p.CPUSet = set.String()
+}
+
+func (p *DomainCPUTuneEmulatorPin) GetCPUSet() (CPUSet, error) {
+	return ParseCPUSet(p.CPUSet)
+}
+
+func (p *DomainCPUTuneEmulatorPin) SetCPUSet(set CPUSet) {
+	p.CPUSet = set.String()
+}
+
+func (p *DomainCPUTuneIOThreadPin) GetCPUSet() (CPUSet, error) {
+	return ParseCPUSet(p.CPUSet)
+}
+
+func (p *DomainCPUTuneIOThreadPin) SetCPUSet(set CPUSet) {
+	p.CPUSet = set.String()
+}
+
+func (c *DomainCell) GetCPUSet() (CPUSet, error) {
+	return ParseCPUSet(c.CPUs)
+}
+
+func (c *DomainCell) SetCPUSet(set CPUSet) {
+	c.CPUs = set.String()
+}
+
+func (m *DomainNUMATuneMemory) GetNodeSet() (CPUSet, error) {
+	return ParseCPUSet(m.Nodeset)
+}
+
+func (m *DomainNUMATuneMemory) SetNodeSet(set CPUSet) {
+	m.Nodeset = set.String()
+}
+
+func (m *DomainNUMATuneMemNode) GetNodeSet() (CPUSet, error) {
+	return ParseCPUSet(m.Nodeset)
+}
+
+func (m *DomainNUMATuneMemNode) SetNodeSet(set CPUSet) {
+	m.Nodeset = set.String()
+}
+
+func (h *DomainMemoryHugepage) GetNodeSet() (CPUSet, error) {
+	return ParseCPUSet(h.Nodeset)
+}
+
+func (h *DomainMemoryHugepage) SetNodeSet(set CPUSet) {
+	h.Nodeset = set.String()
+}
+
+func (c *CapsHostNUMACPU) GetSiblings() (CPUSet, error) {
+	return ParseCPUSet(c.Siblings)
+}
+
+func (c *CapsHostNUMACPU) SetSiblings(set CPUSet) {
+	c.Siblings = set.String()
+}
@@ -0,0 +1,115 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"testing"
+)
+
+func TestParseCPUSet(t *testing.T) {
+	var tests = []struct {
+		Input    string
+		Members  []uint
+		Expected string
+	}{
+		{"", []uint{}, ""},
+		{"3", []uint{3}, "3"},
+		{"0-7,^3,10", []uint{0, 1, 2, 4, 5, 6, 7, 10}, "0-2,4-7,10"},
+		{" 1 , 5-6 ,2", []uint{1, 2, 5, 6}, "1-2,5-6"},
+		{"^3,2-4", []uint{2, 3, 4}, "2-4"},
+		{"0-3,2-5,^5", []uint{0, 1, 2, 3, 4}, "0-4"},
+	}
+
+	for _, test := range tests {
+		set, err := ParseCPUSet(test.Input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !set.Equal(CPUSet(test.Members)) {
+			t.Errorf("'%s': expected %v got %v", test.Input, test.Members, []uint(set))
+		}
+		if set.String() != test.Expected {
+			t.Errorf("'%s': expected '%s' got '%s'", test.Input, test.Expected, set.String())
+		}
+	}
+
+	for _, input := range []string{"a", "1-", "4-2", "^1-3", "1,,2", "-1", "0-100000"} {
+		if _, err := ParseCPUSet(input); err == nil {
+			t.Errorf("Expected error parsing '%s'", input)
+		}
+	}
+}
+
+func TestCPUSetOperations(t *testing.T) {
+	a, err := ParseCPUSet("0-7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := NewCPUSet(6, 4, 12, 8)
+
+	var tests = []struct {
+		Name     string
+		Result   CPUSet
+		Expected string
+	}{
+		{"union", a.Union(b), "0-8,12"},
+		{"intersect", a.Intersect(b), "4,6"},
+		{"subtract", a.Subtract(b), "0-3,5,7"},
+		{"subtract reverse", b.Subtract(a), "8,12"},
+	}
+	for _, test := range tests {
+		if test.Result.String() != test.Expected {
+			t.Errorf("%s: expected '%s' got '%s'", test.Name, test.Expected, test.Result.String())
+		}
+	}
+
+	if !a.Contains(7) || a.Contains(8) {
+		t.Error("Unexpected result from Contains")
+	}
+}
+
+func TestCPUSetAccessors(t *testing.T) {
+	vcpu := &DomainVCPU{CPUSet: "1-4,^2"}
+	set, err := vcpu.GetCPUSet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vcpu.SetCPUSet(set.Union(NewCPUSet(5)))
+	if vcpu.CPUSet != "1,3-5" {
+		t.Errorf("Expected '1,3-5' got '%s'", vcpu.CPUSet)
+	}
+
+	cell := &DomainCell{}
+	cell.SetCPUSet(NewCPUSet(0, 1, 2, 3))
+	if cell.CPUs != "0-3" {
+		t.Errorf("Expected '0-3' got '%s'", cell.CPUs)
+	}
+
+	numa := &DomainNUMATuneMemory{Nodeset: "x"}
+	if _, err := numa.GetNodeSet(); err == nil {
+		t.Error("Expected error parsing invalid nodeset")
+	}
+}
@@ -1,5 +1,7 @@
 package libvirtxml
 
+//go:generate go run clone_gen.go
+
 type Document interface {
 	Unmarshal(doc string) error
 	Marshal() (string, error)
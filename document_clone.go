@@ -0,0 +1,24030 @@
+// Code generated by clone_gen.go. DO NOT EDIT.
+
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+// Clone returns a deep copy of x
+func (x *CapsHostCPUTopology) Clone() *CapsHostCPUTopology {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostCPUTopology) Equal(y *CapsHostCPUTopology) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Sockets != y.Sockets {
+		return false
+	}
+	if x.Cores != y.Cores {
+		return false
+	}
+	if x.Threads != y.Threads {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostCPUFeatureFlag) Clone() *CapsHostCPUFeatureFlag {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostCPUFeatureFlag) Equal(y *CapsHostCPUFeatureFlag) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostCPUPageSize) Clone() *CapsHostCPUPageSize {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostCPUPageSize) Equal(y *CapsHostCPUPageSize) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Size != y.Size {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostCPUMicrocode) Clone() *CapsHostCPUMicrocode {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostCPUMicrocode) Equal(y *CapsHostCPUMicrocode) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Version != y.Version {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostCPU) Clone() *CapsHostCPU {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Topology = x.Topology.Clone()
+	if x.FeatureFlags != nil {
+		y.FeatureFlags = make([]CapsHostCPUFeatureFlag, len(x.FeatureFlags))
+		copy(y.FeatureFlags, x.FeatureFlags)
+	}
+	y.Features = x.Features.Clone()
+	if x.PageSizes != nil {
+		y.PageSizes = make([]CapsHostCPUPageSize, len(x.PageSizes))
+		copy(y.PageSizes, x.PageSizes)
+	}
+	y.Microcode = x.Microcode.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostCPU) Equal(y *CapsHostCPU) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Arch != y.Arch {
+		return false
+	}
+	if x.Model != y.Model {
+		return false
+	}
+	if x.Vendor != y.Vendor {
+		return false
+	}
+	if !x.Topology.Equal(y.Topology) {
+		return false
+	}
+	if len(x.FeatureFlags) != len(y.FeatureFlags) {
+		return false
+	}
+	for i := range x.FeatureFlags {
+		if !x.FeatureFlags[i].Equal(&y.FeatureFlags[i]) {
+			return false
+		}
+	}
+	if !x.Features.Equal(y.Features) {
+		return false
+	}
+	if len(x.PageSizes) != len(y.PageSizes) {
+		return false
+	}
+	for i := range x.PageSizes {
+		if !x.PageSizes[i].Equal(&y.PageSizes[i]) {
+			return false
+		}
+	}
+	if !x.Microcode.Equal(y.Microcode) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostCPUFeature) Clone() *CapsHostCPUFeature {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostCPUFeature) Equal(y *CapsHostCPUFeature) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostCPUFeatures) Clone() *CapsHostCPUFeatures {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.PAE = x.PAE.Clone()
+	y.NonPAE = x.NonPAE.Clone()
+	y.SVM = x.SVM.Clone()
+	y.VMX = x.VMX.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostCPUFeatures) Equal(y *CapsHostCPUFeatures) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.PAE.Equal(y.PAE) {
+		return false
+	}
+	if !x.NonPAE.Equal(y.NonPAE) {
+		return false
+	}
+	if !x.SVM.Equal(y.SVM) {
+		return false
+	}
+	if !x.VMX.Equal(y.VMX) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostNUMAMemory) Clone() *CapsHostNUMAMemory {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostNUMAMemory) Equal(y *CapsHostNUMAMemory) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Size != y.Size {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostNUMAPageInfo) Clone() *CapsHostNUMAPageInfo {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostNUMAPageInfo) Equal(y *CapsHostNUMAPageInfo) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Size != y.Size {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	if x.Count != y.Count {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostNUMACPU) Clone() *CapsHostNUMACPU {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.SocketID != nil {
+		v := *x.SocketID
+		y.SocketID = &v
+	}
+	if x.DieID != nil {
+		v := *x.DieID
+		y.DieID = &v
+	}
+	if x.CoreID != nil {
+		v := *x.CoreID
+		y.CoreID = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostNUMACPU) Equal(y *CapsHostNUMACPU) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ID != y.ID {
+		return false
+	}
+	if (x.SocketID == nil) != (y.SocketID == nil) {
+		return false
+	}
+	if x.SocketID != nil {
+		if (*x.SocketID) != (*y.SocketID) {
+			return false
+		}
+	}
+	if (x.DieID == nil) != (y.DieID == nil) {
+		return false
+	}
+	if x.DieID != nil {
+		if (*x.DieID) != (*y.DieID) {
+			return false
+		}
+	}
+	if (x.CoreID == nil) != (y.CoreID == nil) {
+		return false
+	}
+	if x.CoreID != nil {
+		if (*x.CoreID) != (*y.CoreID) {
+			return false
+		}
+	}
+	if x.Siblings != y.Siblings {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostNUMASibling) Clone() *CapsHostNUMASibling {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostNUMASibling) Equal(y *CapsHostNUMASibling) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ID != y.ID {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostNUMACell) Clone() *CapsHostNUMACell {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Memory = x.Memory.Clone()
+	if x.PageInfo != nil {
+		y.PageInfo = make([]CapsHostNUMAPageInfo, len(x.PageInfo))
+		copy(y.PageInfo, x.PageInfo)
+	}
+	y.Distances = x.Distances.Clone()
+	y.CPUS = x.CPUS.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostNUMACell) Equal(y *CapsHostNUMACell) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ID != y.ID {
+		return false
+	}
+	if !x.Memory.Equal(y.Memory) {
+		return false
+	}
+	if len(x.PageInfo) != len(y.PageInfo) {
+		return false
+	}
+	for i := range x.PageInfo {
+		if !x.PageInfo[i].Equal(&y.PageInfo[i]) {
+			return false
+		}
+	}
+	if !x.Distances.Equal(y.Distances) {
+		return false
+	}
+	if !x.CPUS.Equal(y.CPUS) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostNUMADistances) Clone() *CapsHostNUMADistances {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Siblings != nil {
+		y.Siblings = make([]CapsHostNUMASibling, len(x.Siblings))
+		copy(y.Siblings, x.Siblings)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostNUMADistances) Equal(y *CapsHostNUMADistances) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Siblings) != len(y.Siblings) {
+		return false
+	}
+	for i := range x.Siblings {
+		if !x.Siblings[i].Equal(&y.Siblings[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostNUMACPUs) Clone() *CapsHostNUMACPUs {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.CPUs != nil {
+		y.CPUs = make([]CapsHostNUMACPU, len(x.CPUs))
+		copy(y.CPUs, x.CPUs)
+		for i := range x.CPUs {
+			y.CPUs[i] = *x.CPUs[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostNUMACPUs) Equal(y *CapsHostNUMACPUs) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Num != y.Num {
+		return false
+	}
+	if len(x.CPUs) != len(y.CPUs) {
+		return false
+	}
+	for i := range x.CPUs {
+		if !x.CPUs[i].Equal(&y.CPUs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostNUMATopology) Clone() *CapsHostNUMATopology {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Cells = x.Cells.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostNUMATopology) Equal(y *CapsHostNUMATopology) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Cells.Equal(y.Cells) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostNUMACells) Clone() *CapsHostNUMACells {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Cells != nil {
+		y.Cells = make([]CapsHostNUMACell, len(x.Cells))
+		copy(y.Cells, x.Cells)
+		for i := range x.Cells {
+			y.Cells[i] = *x.Cells[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostNUMACells) Equal(y *CapsHostNUMACells) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Num != y.Num {
+		return false
+	}
+	if len(x.Cells) != len(y.Cells) {
+		return false
+	}
+	for i := range x.Cells {
+		if !x.Cells[i].Equal(&y.Cells[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostSecModelLabel) Clone() *CapsHostSecModelLabel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostSecModelLabel) Equal(y *CapsHostSecModelLabel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostSecModel) Clone() *CapsHostSecModel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Labels != nil {
+		y.Labels = make([]CapsHostSecModelLabel, len(x.Labels))
+		copy(y.Labels, x.Labels)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostSecModel) Equal(y *CapsHostSecModel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.DOI != y.DOI {
+		return false
+	}
+	if len(x.Labels) != len(y.Labels) {
+		return false
+	}
+	for i := range x.Labels {
+		if !x.Labels[i].Equal(&y.Labels[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostMigrationFeatures) Clone() *CapsHostMigrationFeatures {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Live = x.Live.Clone()
+	y.URITransports = x.URITransports.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostMigrationFeatures) Equal(y *CapsHostMigrationFeatures) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Live.Equal(y.Live) {
+		return false
+	}
+	if !x.URITransports.Equal(y.URITransports) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostMigrationLive) Clone() *CapsHostMigrationLive {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostMigrationLive) Equal(y *CapsHostMigrationLive) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostMigrationURITransports) Clone() *CapsHostMigrationURITransports {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.URI != nil {
+		y.URI = make([]string, len(x.URI))
+		copy(y.URI, x.URI)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostMigrationURITransports) Equal(y *CapsHostMigrationURITransports) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.URI) != len(y.URI) {
+		return false
+	}
+	for i := range x.URI {
+		if x.URI[i] != y.URI[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHost) Clone() *CapsHost {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.CPU = x.CPU.Clone()
+	y.PowerManagement = x.PowerManagement.Clone()
+	y.IOMMU = x.IOMMU.Clone()
+	y.MigrationFeatures = x.MigrationFeatures.Clone()
+	y.NUMA = x.NUMA.Clone()
+	y.Cache = x.Cache.Clone()
+	y.MemoryBandwidth = x.MemoryBandwidth.Clone()
+	if x.SecModel != nil {
+		y.SecModel = make([]CapsHostSecModel, len(x.SecModel))
+		copy(y.SecModel, x.SecModel)
+		for i := range x.SecModel {
+			y.SecModel[i] = *x.SecModel[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHost) Equal(y *CapsHost) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.UUID != y.UUID {
+		return false
+	}
+	if !x.CPU.Equal(y.CPU) {
+		return false
+	}
+	if !x.PowerManagement.Equal(y.PowerManagement) {
+		return false
+	}
+	if !x.IOMMU.Equal(y.IOMMU) {
+		return false
+	}
+	if !x.MigrationFeatures.Equal(y.MigrationFeatures) {
+		return false
+	}
+	if !x.NUMA.Equal(y.NUMA) {
+		return false
+	}
+	if !x.Cache.Equal(y.Cache) {
+		return false
+	}
+	if !x.MemoryBandwidth.Equal(y.MemoryBandwidth) {
+		return false
+	}
+	if len(x.SecModel) != len(y.SecModel) {
+		return false
+	}
+	for i := range x.SecModel {
+		if !x.SecModel[i].Equal(&y.SecModel[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostPowerManagement) Clone() *CapsHostPowerManagement {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.SuspendMem = x.SuspendMem.Clone()
+	y.SuspendDisk = x.SuspendDisk.Clone()
+	y.SuspendHybrid = x.SuspendHybrid.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostPowerManagement) Equal(y *CapsHostPowerManagement) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.SuspendMem.Equal(y.SuspendMem) {
+		return false
+	}
+	if !x.SuspendDisk.Equal(y.SuspendDisk) {
+		return false
+	}
+	if !x.SuspendHybrid.Equal(y.SuspendHybrid) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostPowerManagementMode) Clone() *CapsHostPowerManagementMode {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostPowerManagementMode) Equal(y *CapsHostPowerManagementMode) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostIOMMU) Clone() *CapsHostIOMMU {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostIOMMU) Equal(y *CapsHostIOMMU) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Support != y.Support {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostCache) Clone() *CapsHostCache {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Banks != nil {
+		y.Banks = make([]CapsHostCacheBank, len(x.Banks))
+		copy(y.Banks, x.Banks)
+		for i := range x.Banks {
+			y.Banks[i] = *x.Banks[i].Clone()
+		}
+	}
+	y.Monitor = x.Monitor.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostCache) Equal(y *CapsHostCache) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Banks) != len(y.Banks) {
+		return false
+	}
+	for i := range x.Banks {
+		if !x.Banks[i].Equal(&y.Banks[i]) {
+			return false
+		}
+	}
+	if !x.Monitor.Equal(y.Monitor) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostCacheBank) Clone() *CapsHostCacheBank {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Control != nil {
+		y.Control = make([]CapsHostCacheControl, len(x.Control))
+		copy(y.Control, x.Control)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostCacheBank) Equal(y *CapsHostCacheBank) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ID != y.ID {
+		return false
+	}
+	if x.Level != y.Level {
+		return false
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Size != y.Size {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	if x.CPUs != y.CPUs {
+		return false
+	}
+	if len(x.Control) != len(y.Control) {
+		return false
+	}
+	for i := range x.Control {
+		if !x.Control[i].Equal(&y.Control[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostCacheMonitor) Clone() *CapsHostCacheMonitor {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Features != nil {
+		y.Features = make([]CapsHostCacheMonitorFeature, len(x.Features))
+		copy(y.Features, x.Features)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostCacheMonitor) Equal(y *CapsHostCacheMonitor) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Level != y.Level {
+		return false
+	}
+	if x.ResueThreshold != y.ResueThreshold {
+		return false
+	}
+	if x.MaxMonitors != y.MaxMonitors {
+		return false
+	}
+	if len(x.Features) != len(y.Features) {
+		return false
+	}
+	for i := range x.Features {
+		if !x.Features[i].Equal(&y.Features[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostCacheMonitorFeature) Clone() *CapsHostCacheMonitorFeature {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostCacheMonitorFeature) Equal(y *CapsHostCacheMonitorFeature) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostCacheControl) Clone() *CapsHostCacheControl {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostCacheControl) Equal(y *CapsHostCacheControl) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Granularity != y.Granularity {
+		return false
+	}
+	if x.Min != y.Min {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.MaxAllows != y.MaxAllows {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostMemoryBandwidth) Clone() *CapsHostMemoryBandwidth {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Nodes != nil {
+		y.Nodes = make([]CapsHostMemoryBandwidthNode, len(x.Nodes))
+		copy(y.Nodes, x.Nodes)
+		for i := range x.Nodes {
+			y.Nodes[i] = *x.Nodes[i].Clone()
+		}
+	}
+	y.Monitor = x.Monitor.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostMemoryBandwidth) Equal(y *CapsHostMemoryBandwidth) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Nodes) != len(y.Nodes) {
+		return false
+	}
+	for i := range x.Nodes {
+		if !x.Nodes[i].Equal(&y.Nodes[i]) {
+			return false
+		}
+	}
+	if !x.Monitor.Equal(y.Monitor) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostMemoryBandwidthNode) Clone() *CapsHostMemoryBandwidthNode {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Control = x.Control.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostMemoryBandwidthNode) Equal(y *CapsHostMemoryBandwidthNode) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ID != y.ID {
+		return false
+	}
+	if x.CPUs != y.CPUs {
+		return false
+	}
+	if !x.Control.Equal(y.Control) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostMemoryBandwidthNodeControl) Clone() *CapsHostMemoryBandwidthNodeControl {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostMemoryBandwidthNodeControl) Equal(y *CapsHostMemoryBandwidthNodeControl) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Granularity != y.Granularity {
+		return false
+	}
+	if x.Min != y.Min {
+		return false
+	}
+	if x.MaxAllocs != y.MaxAllocs {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostMemoryBandwidthMonitor) Clone() *CapsHostMemoryBandwidthMonitor {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Features != nil {
+		y.Features = make([]CapsHostMemoryBandwidthMonitorFeature, len(x.Features))
+		copy(y.Features, x.Features)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostMemoryBandwidthMonitor) Equal(y *CapsHostMemoryBandwidthMonitor) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.MaxMonitors != y.MaxMonitors {
+		return false
+	}
+	if len(x.Features) != len(y.Features) {
+		return false
+	}
+	for i := range x.Features {
+		if !x.Features[i].Equal(&y.Features[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsHostMemoryBandwidthMonitorFeature) Clone() *CapsHostMemoryBandwidthMonitorFeature {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsHostMemoryBandwidthMonitorFeature) Equal(y *CapsHostMemoryBandwidthMonitorFeature) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsGuestMachine) Clone() *CapsGuestMachine {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsGuestMachine) Equal(y *CapsGuestMachine) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.MaxCPUs != y.MaxCPUs {
+		return false
+	}
+	if x.Canonical != y.Canonical {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsGuestDomain) Clone() *CapsGuestDomain {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Machines != nil {
+		y.Machines = make([]CapsGuestMachine, len(x.Machines))
+		copy(y.Machines, x.Machines)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsGuestDomain) Equal(y *CapsGuestDomain) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Emulator != y.Emulator {
+		return false
+	}
+	if len(x.Machines) != len(y.Machines) {
+		return false
+	}
+	for i := range x.Machines {
+		if !x.Machines[i].Equal(&y.Machines[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsGuestArch) Clone() *CapsGuestArch {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Machines != nil {
+		y.Machines = make([]CapsGuestMachine, len(x.Machines))
+		copy(y.Machines, x.Machines)
+	}
+	if x.Domains != nil {
+		y.Domains = make([]CapsGuestDomain, len(x.Domains))
+		copy(y.Domains, x.Domains)
+		for i := range x.Domains {
+			y.Domains[i] = *x.Domains[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsGuestArch) Equal(y *CapsGuestArch) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.WordSize != y.WordSize {
+		return false
+	}
+	if x.Emulator != y.Emulator {
+		return false
+	}
+	if x.Loader != y.Loader {
+		return false
+	}
+	if len(x.Machines) != len(y.Machines) {
+		return false
+	}
+	for i := range x.Machines {
+		if !x.Machines[i].Equal(&y.Machines[i]) {
+			return false
+		}
+	}
+	if len(x.Domains) != len(y.Domains) {
+		return false
+	}
+	for i := range x.Domains {
+		if !x.Domains[i].Equal(&y.Domains[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsGuestFeatureCPUSelection) Clone() *CapsGuestFeatureCPUSelection {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsGuestFeatureCPUSelection) Equal(y *CapsGuestFeatureCPUSelection) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsGuestFeatureDeviceBoot) Clone() *CapsGuestFeatureDeviceBoot {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsGuestFeatureDeviceBoot) Equal(y *CapsGuestFeatureDeviceBoot) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsGuestFeaturePAE) Clone() *CapsGuestFeaturePAE {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsGuestFeaturePAE) Equal(y *CapsGuestFeaturePAE) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsGuestFeatureNonPAE) Clone() *CapsGuestFeatureNonPAE {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsGuestFeatureNonPAE) Equal(y *CapsGuestFeatureNonPAE) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsGuestFeatureDiskSnapshot) Clone() *CapsGuestFeatureDiskSnapshot {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsGuestFeatureDiskSnapshot) Equal(y *CapsGuestFeatureDiskSnapshot) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Default != y.Default {
+		return false
+	}
+	if x.Toggle != y.Toggle {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsGuestFeatureAPIC) Clone() *CapsGuestFeatureAPIC {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsGuestFeatureAPIC) Equal(y *CapsGuestFeatureAPIC) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Default != y.Default {
+		return false
+	}
+	if x.Toggle != y.Toggle {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsGuestFeatureACPI) Clone() *CapsGuestFeatureACPI {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsGuestFeatureACPI) Equal(y *CapsGuestFeatureACPI) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Default != y.Default {
+		return false
+	}
+	if x.Toggle != y.Toggle {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsGuestFeatureIA64BE) Clone() *CapsGuestFeatureIA64BE {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsGuestFeatureIA64BE) Equal(y *CapsGuestFeatureIA64BE) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsGuestFeatures) Clone() *CapsGuestFeatures {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.CPUSelection = x.CPUSelection.Clone()
+	y.DeviceBoot = x.DeviceBoot.Clone()
+	y.DiskSnapshot = x.DiskSnapshot.Clone()
+	y.PAE = x.PAE.Clone()
+	y.NonPAE = x.NonPAE.Clone()
+	y.APIC = x.APIC.Clone()
+	y.ACPI = x.ACPI.Clone()
+	y.IA64BE = x.IA64BE.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsGuestFeatures) Equal(y *CapsGuestFeatures) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.CPUSelection.Equal(y.CPUSelection) {
+		return false
+	}
+	if !x.DeviceBoot.Equal(y.DeviceBoot) {
+		return false
+	}
+	if !x.DiskSnapshot.Equal(y.DiskSnapshot) {
+		return false
+	}
+	if !x.PAE.Equal(y.PAE) {
+		return false
+	}
+	if !x.NonPAE.Equal(y.NonPAE) {
+		return false
+	}
+	if !x.APIC.Equal(y.APIC) {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.IA64BE.Equal(y.IA64BE) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *CapsGuest) Clone() *CapsGuest {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Arch = *x.Arch.Clone()
+	y.Features = x.Features.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *CapsGuest) Equal(y *CapsGuest) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.OSType != y.OSType {
+		return false
+	}
+	if !x.Arch.Equal(&y.Arch) {
+		return false
+	}
+	if !x.Features.Equal(y.Features) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *Caps) Clone() *Caps {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Host = *x.Host.Clone()
+	if x.Guests != nil {
+		y.Guests = make([]CapsGuest, len(x.Guests))
+		copy(y.Guests, x.Guests)
+		for i := range x.Guests {
+			y.Guests[i] = *x.Guests[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *Caps) Equal(y *Caps) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Host.Equal(&y.Host) {
+		return false
+	}
+	if len(x.Guests) != len(y.Guests) {
+		return false
+	}
+	for i := range x.Guests {
+		if !x.Guests[i].Equal(&y.Guests[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainControllerPCIHole64) Clone() *DomainControllerPCIHole64 {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainControllerPCIHole64) Equal(y *DomainControllerPCIHole64) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Size != y.Size {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainControllerPCIModel) Clone() *DomainControllerPCIModel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainControllerPCIModel) Equal(y *DomainControllerPCIModel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainControllerPCITarget) Clone() *DomainControllerPCITarget {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.ChassisNr != nil {
+		v := *x.ChassisNr
+		y.ChassisNr = &v
+	}
+	if x.Chassis != nil {
+		v := *x.Chassis
+		y.Chassis = &v
+	}
+	if x.Port != nil {
+		v := *x.Port
+		y.Port = &v
+	}
+	if x.BusNr != nil {
+		v := *x.BusNr
+		y.BusNr = &v
+	}
+	if x.Index != nil {
+		v := *x.Index
+		y.Index = &v
+	}
+	if x.NUMANode != nil {
+		v := *x.NUMANode
+		y.NUMANode = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainControllerPCITarget) Equal(y *DomainControllerPCITarget) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.ChassisNr == nil) != (y.ChassisNr == nil) {
+		return false
+	}
+	if x.ChassisNr != nil {
+		if (*x.ChassisNr) != (*y.ChassisNr) {
+			return false
+		}
+	}
+	if (x.Chassis == nil) != (y.Chassis == nil) {
+		return false
+	}
+	if x.Chassis != nil {
+		if (*x.Chassis) != (*y.Chassis) {
+			return false
+		}
+	}
+	if (x.Port == nil) != (y.Port == nil) {
+		return false
+	}
+	if x.Port != nil {
+		if (*x.Port) != (*y.Port) {
+			return false
+		}
+	}
+	if (x.BusNr == nil) != (y.BusNr == nil) {
+		return false
+	}
+	if x.BusNr != nil {
+		if (*x.BusNr) != (*y.BusNr) {
+			return false
+		}
+	}
+	if (x.Index == nil) != (y.Index == nil) {
+		return false
+	}
+	if x.Index != nil {
+		if (*x.Index) != (*y.Index) {
+			return false
+		}
+	}
+	if (x.NUMANode == nil) != (y.NUMANode == nil) {
+		return false
+	}
+	if x.NUMANode != nil {
+		if (*x.NUMANode) != (*y.NUMANode) {
+			return false
+		}
+	}
+	if x.Hotplug != y.Hotplug {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainControllerPCI) Clone() *DomainControllerPCI {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Model = x.Model.Clone()
+	y.Target = x.Target.Clone()
+	y.Hole64 = x.Hole64.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainControllerPCI) Equal(y *DomainControllerPCI) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Model.Equal(y.Model) {
+		return false
+	}
+	if !x.Target.Equal(y.Target) &&
+		!(x.Target == nil && y.Target.Equal(&DomainControllerPCITarget{})) &&
+		!(y.Target == nil && x.Target.Equal(&DomainControllerPCITarget{})) {
+		return false
+	}
+	if !x.Hole64.Equal(y.Hole64) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainControllerUSBMaster) Clone() *DomainControllerUSBMaster {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainControllerUSBMaster) Equal(y *DomainControllerUSBMaster) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.StartPort != y.StartPort {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainControllerUSB) Clone() *DomainControllerUSB {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Port != nil {
+		v := *x.Port
+		y.Port = &v
+	}
+	y.Master = x.Master.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainControllerUSB) Equal(y *DomainControllerUSB) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.Port == nil) != (y.Port == nil) {
+		return false
+	}
+	if x.Port != nil {
+		if (*x.Port) != (*y.Port) {
+			return false
+		}
+	}
+	if !x.Master.Equal(y.Master) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainControllerVirtIOSerial) Clone() *DomainControllerVirtIOSerial {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Ports != nil {
+		v := *x.Ports
+		y.Ports = &v
+	}
+	if x.Vectors != nil {
+		v := *x.Vectors
+		y.Vectors = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainControllerVirtIOSerial) Equal(y *DomainControllerVirtIOSerial) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.Ports == nil) != (y.Ports == nil) {
+		return false
+	}
+	if x.Ports != nil {
+		if (*x.Ports) != (*y.Ports) {
+			return false
+		}
+	}
+	if (x.Vectors == nil) != (y.Vectors == nil) {
+		return false
+	}
+	if x.Vectors != nil {
+		if (*x.Vectors) != (*y.Vectors) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainControllerXenBus) Clone() *DomainControllerXenBus {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainControllerXenBus) Equal(y *DomainControllerXenBus) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.MaxGrantFrames != y.MaxGrantFrames {
+		return false
+	}
+	if x.MaxEventChannels != y.MaxEventChannels {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainControllerDriver) Clone() *DomainControllerDriver {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Queues != nil {
+		v := *x.Queues
+		y.Queues = &v
+	}
+	if x.CmdPerLUN != nil {
+		v := *x.CmdPerLUN
+		y.CmdPerLUN = &v
+	}
+	if x.MaxSectors != nil {
+		v := *x.MaxSectors
+		y.MaxSectors = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainControllerDriver) Equal(y *DomainControllerDriver) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.Queues == nil) != (y.Queues == nil) {
+		return false
+	}
+	if x.Queues != nil {
+		if (*x.Queues) != (*y.Queues) {
+			return false
+		}
+	}
+	if (x.CmdPerLUN == nil) != (y.CmdPerLUN == nil) {
+		return false
+	}
+	if x.CmdPerLUN != nil {
+		if (*x.CmdPerLUN) != (*y.CmdPerLUN) {
+			return false
+		}
+	}
+	if (x.MaxSectors == nil) != (y.MaxSectors == nil) {
+		return false
+	}
+	if x.MaxSectors != nil {
+		if (*x.MaxSectors) != (*y.MaxSectors) {
+			return false
+		}
+	}
+	if x.IOEventFD != y.IOEventFD {
+		return false
+	}
+	if x.IOThread != y.IOThread {
+		return false
+	}
+	if x.IOMMU != y.IOMMU {
+		return false
+	}
+	if x.ATS != y.ATS {
+		return false
+	}
+	if x.Packed != y.Packed {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainController) Clone() *DomainController {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Index != nil {
+		v := *x.Index
+		y.Index = &v
+	}
+	y.Driver = x.Driver.Clone()
+	y.PCI = x.PCI.Clone()
+	y.USB = x.USB.Clone()
+	y.VirtIOSerial = x.VirtIOSerial.Clone()
+	y.XenBus = x.XenBus.Clone()
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainController) Equal(y *DomainController) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if (x.Index == nil) != (y.Index == nil) {
+		return false
+	}
+	if x.Index != nil {
+		if (*x.Index) != (*y.Index) {
+			return false
+		}
+	}
+	if x.Model != y.Model {
+		return false
+	}
+	if !x.Driver.Equal(y.Driver) {
+		return false
+	}
+	if !x.PCI.Equal(y.PCI) &&
+		!(x.PCI == nil && y.PCI.Equal(&DomainControllerPCI{})) &&
+		!(y.PCI == nil && x.PCI.Equal(&DomainControllerPCI{})) {
+		return false
+	}
+	if !x.USB.Equal(y.USB) &&
+		!(x.USB == nil && y.USB.Equal(&DomainControllerUSB{})) &&
+		!(y.USB == nil && x.USB.Equal(&DomainControllerUSB{})) {
+		return false
+	}
+	if !x.VirtIOSerial.Equal(y.VirtIOSerial) &&
+		!(x.VirtIOSerial == nil && y.VirtIOSerial.Equal(&DomainControllerVirtIOSerial{})) &&
+		!(y.VirtIOSerial == nil && x.VirtIOSerial.Equal(&DomainControllerVirtIOSerial{})) {
+		return false
+	}
+	if !x.XenBus.Equal(y.XenBus) &&
+		!(x.XenBus == nil && y.XenBus.Equal(&DomainControllerXenBus{})) &&
+		!(y.XenBus == nil && x.XenBus.Equal(&DomainControllerXenBus{})) {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskSecret) Clone() *DomainDiskSecret {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskSecret) Equal(y *DomainDiskSecret) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Usage != y.Usage {
+		return false
+	}
+	if x.UUID != y.UUID {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskAuth) Clone() *DomainDiskAuth {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Secret = x.Secret.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskAuth) Equal(y *DomainDiskAuth) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Username != y.Username {
+		return false
+	}
+	if !x.Secret.Equal(y.Secret) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskSourceHost) Clone() *DomainDiskSourceHost {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskSourceHost) Equal(y *DomainDiskSourceHost) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Transport != y.Transport {
+		return false
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Port != y.Port {
+		return false
+	}
+	if x.Socket != y.Socket {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskSourceSSL) Clone() *DomainDiskSourceSSL {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskSourceSSL) Equal(y *DomainDiskSourceSSL) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Verify != y.Verify {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskCookie) Clone() *DomainDiskCookie {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskCookie) Equal(y *DomainDiskCookie) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskCookies) Clone() *DomainDiskCookies {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Cookies != nil {
+		y.Cookies = make([]DomainDiskCookie, len(x.Cookies))
+		copy(y.Cookies, x.Cookies)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskCookies) Equal(y *DomainDiskCookies) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Cookies) != len(y.Cookies) {
+		return false
+	}
+	for i := range x.Cookies {
+		if !x.Cookies[i].Equal(&y.Cookies[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskSourceReadahead) Clone() *DomainDiskSourceReadahead {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskSourceReadahead) Equal(y *DomainDiskSourceReadahead) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Size != y.Size {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskSourceTimeout) Clone() *DomainDiskSourceTimeout {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskSourceTimeout) Equal(y *DomainDiskSourceTimeout) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Seconds != y.Seconds {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskReservationsSource) Clone() *DomainDiskReservationsSource {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Null = x.Null.Clone()
+	y.VC = x.VC.Clone()
+	y.Pty = x.Pty.Clone()
+	y.Dev = x.Dev.Clone()
+	y.File = x.File.Clone()
+	y.Pipe = x.Pipe.Clone()
+	y.StdIO = x.StdIO.Clone()
+	y.UDP = x.UDP.Clone()
+	y.TCP = x.TCP.Clone()
+	y.UNIX = x.UNIX.Clone()
+	y.SpiceVMC = x.SpiceVMC.Clone()
+	y.SpicePort = x.SpicePort.Clone()
+	y.NMDM = x.NMDM.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskReservationsSource) Equal(y *DomainDiskReservationsSource) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Null.Equal(y.Null) &&
+		!(x.Null == nil && y.Null.Equal(&DomainChardevSourceNull{})) &&
+		!(y.Null == nil && x.Null.Equal(&DomainChardevSourceNull{})) {
+		return false
+	}
+	if !x.VC.Equal(y.VC) &&
+		!(x.VC == nil && y.VC.Equal(&DomainChardevSourceVC{})) &&
+		!(y.VC == nil && x.VC.Equal(&DomainChardevSourceVC{})) {
+		return false
+	}
+	if !x.Pty.Equal(y.Pty) &&
+		!(x.Pty == nil && y.Pty.Equal(&DomainChardevSourcePty{})) &&
+		!(y.Pty == nil && x.Pty.Equal(&DomainChardevSourcePty{})) {
+		return false
+	}
+	if !x.Dev.Equal(y.Dev) &&
+		!(x.Dev == nil && y.Dev.Equal(&DomainChardevSourceDev{})) &&
+		!(y.Dev == nil && x.Dev.Equal(&DomainChardevSourceDev{})) {
+		return false
+	}
+	if !x.File.Equal(y.File) &&
+		!(x.File == nil && y.File.Equal(&DomainChardevSourceFile{})) &&
+		!(y.File == nil && x.File.Equal(&DomainChardevSourceFile{})) {
+		return false
+	}
+	if !x.Pipe.Equal(y.Pipe) &&
+		!(x.Pipe == nil && y.Pipe.Equal(&DomainChardevSourcePipe{})) &&
+		!(y.Pipe == nil && x.Pipe.Equal(&DomainChardevSourcePipe{})) {
+		return false
+	}
+	if !x.StdIO.Equal(y.StdIO) &&
+		!(x.StdIO == nil && y.StdIO.Equal(&DomainChardevSourceStdIO{})) &&
+		!(y.StdIO == nil && x.StdIO.Equal(&DomainChardevSourceStdIO{})) {
+		return false
+	}
+	if !x.UDP.Equal(y.UDP) &&
+		!(x.UDP == nil && y.UDP.Equal(&DomainChardevSourceUDP{})) &&
+		!(y.UDP == nil && x.UDP.Equal(&DomainChardevSourceUDP{})) {
+		return false
+	}
+	if !x.TCP.Equal(y.TCP) &&
+		!(x.TCP == nil && y.TCP.Equal(&DomainChardevSourceTCP{})) &&
+		!(y.TCP == nil && x.TCP.Equal(&DomainChardevSourceTCP{})) {
+		return false
+	}
+	if !x.UNIX.Equal(y.UNIX) &&
+		!(x.UNIX == nil && y.UNIX.Equal(&DomainChardevSourceUNIX{})) &&
+		!(y.UNIX == nil && x.UNIX.Equal(&DomainChardevSourceUNIX{})) {
+		return false
+	}
+	if !x.SpiceVMC.Equal(y.SpiceVMC) &&
+		!(x.SpiceVMC == nil && y.SpiceVMC.Equal(&DomainChardevSourceSpiceVMC{})) &&
+		!(y.SpiceVMC == nil && x.SpiceVMC.Equal(&DomainChardevSourceSpiceVMC{})) {
+		return false
+	}
+	if !x.SpicePort.Equal(y.SpicePort) &&
+		!(x.SpicePort == nil && y.SpicePort.Equal(&DomainChardevSourceSpicePort{})) &&
+		!(y.SpicePort == nil && x.SpicePort.Equal(&DomainChardevSourceSpicePort{})) {
+		return false
+	}
+	if !x.NMDM.Equal(y.NMDM) &&
+		!(x.NMDM == nil && y.NMDM.Equal(&DomainChardevSourceNMDM{})) &&
+		!(y.NMDM == nil && x.NMDM.Equal(&DomainChardevSourceNMDM{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskReservations) Clone() *DomainDiskReservations {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Source = x.Source.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskReservations) Equal(y *DomainDiskReservations) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Enabled != y.Enabled {
+		return false
+	}
+	if x.Managed != y.Managed {
+		return false
+	}
+	if !x.Source.Equal(y.Source) &&
+		!(x.Source == nil && y.Source.Equal(&DomainDiskReservationsSource{})) &&
+		!(y.Source == nil && x.Source.Equal(&DomainDiskReservationsSource{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskSource) Clone() *DomainDiskSource {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.File = x.File.Clone()
+	y.Block = x.Block.Clone()
+	y.Dir = x.Dir.Clone()
+	y.Network = x.Network.Clone()
+	y.Volume = x.Volume.Clone()
+	y.NVME = x.NVME.Clone()
+	y.VHostUser = x.VHostUser.Clone()
+	y.Encryption = x.Encryption.Clone()
+	y.Reservations = x.Reservations.Clone()
+	y.Slices = x.Slices.Clone()
+	y.SSL = x.SSL.Clone()
+	y.Cookies = x.Cookies.Clone()
+	y.Readahead = x.Readahead.Clone()
+	y.Timeout = x.Timeout.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskSource) Equal(y *DomainDiskSource) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.File.Equal(y.File) &&
+		!(x.File == nil && y.File.Equal(&DomainDiskSourceFile{})) &&
+		!(y.File == nil && x.File.Equal(&DomainDiskSourceFile{})) {
+		return false
+	}
+	if !x.Block.Equal(y.Block) &&
+		!(x.Block == nil && y.Block.Equal(&DomainDiskSourceBlock{})) &&
+		!(y.Block == nil && x.Block.Equal(&DomainDiskSourceBlock{})) {
+		return false
+	}
+	if !x.Dir.Equal(y.Dir) &&
+		!(x.Dir == nil && y.Dir.Equal(&DomainDiskSourceDir{})) &&
+		!(y.Dir == nil && x.Dir.Equal(&DomainDiskSourceDir{})) {
+		return false
+	}
+	if !x.Network.Equal(y.Network) &&
+		!(x.Network == nil && y.Network.Equal(&DomainDiskSourceNetwork{})) &&
+		!(y.Network == nil && x.Network.Equal(&DomainDiskSourceNetwork{})) {
+		return false
+	}
+	if !x.Volume.Equal(y.Volume) &&
+		!(x.Volume == nil && y.Volume.Equal(&DomainDiskSourceVolume{})) &&
+		!(y.Volume == nil && x.Volume.Equal(&DomainDiskSourceVolume{})) {
+		return false
+	}
+	if !x.NVME.Equal(y.NVME) &&
+		!(x.NVME == nil && y.NVME.Equal(&DomainDiskSourceNVME{})) &&
+		!(y.NVME == nil && x.NVME.Equal(&DomainDiskSourceNVME{})) {
+		return false
+	}
+	if !x.VHostUser.Equal(y.VHostUser) &&
+		!(x.VHostUser == nil && y.VHostUser.Equal(&DomainDiskSourceVHostUser{})) &&
+		!(y.VHostUser == nil && x.VHostUser.Equal(&DomainDiskSourceVHostUser{})) {
+		return false
+	}
+	if x.StartupPolicy != y.StartupPolicy {
+		return false
+	}
+	if x.Index != y.Index {
+		return false
+	}
+	if !x.Encryption.Equal(y.Encryption) {
+		return false
+	}
+	if !x.Reservations.Equal(y.Reservations) {
+		return false
+	}
+	if !x.Slices.Equal(y.Slices) {
+		return false
+	}
+	if !x.SSL.Equal(y.SSL) {
+		return false
+	}
+	if !x.Cookies.Equal(y.Cookies) {
+		return false
+	}
+	if !x.Readahead.Equal(y.Readahead) {
+		return false
+	}
+	if !x.Timeout.Equal(y.Timeout) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskSlices) Clone() *DomainDiskSlices {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Slices != nil {
+		y.Slices = make([]DomainDiskSlice, len(x.Slices))
+		copy(y.Slices, x.Slices)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskSlices) Equal(y *DomainDiskSlices) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Slices) != len(y.Slices) {
+		return false
+	}
+	for i := range x.Slices {
+		if !x.Slices[i].Equal(&y.Slices[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskSlice) Clone() *DomainDiskSlice {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskSlice) Equal(y *DomainDiskSlice) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Offset != y.Offset {
+		return false
+	}
+	if x.Size != y.Size {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskSourceFile) Clone() *DomainDiskSourceFile {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.SecLabel != nil {
+		y.SecLabel = make([]DomainDeviceSecLabel, len(x.SecLabel))
+		copy(y.SecLabel, x.SecLabel)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskSourceFile) Equal(y *DomainDiskSourceFile) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.File != y.File {
+		return false
+	}
+	if len(x.SecLabel) != len(y.SecLabel) {
+		return false
+	}
+	for i := range x.SecLabel {
+		if !x.SecLabel[i].Equal(&y.SecLabel[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskSourceNVME) Clone() *DomainDiskSourceNVME {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.PCI = x.PCI.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskSourceNVME) Equal(y *DomainDiskSourceNVME) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.PCI.Equal(y.PCI) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskSourceNVMEPCI) Clone() *DomainDiskSourceNVMEPCI {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskSourceNVMEPCI) Equal(y *DomainDiskSourceNVMEPCI) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Managed != y.Managed {
+		return false
+	}
+	if x.Namespace != y.Namespace {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddressPCI{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddressPCI{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskSourceBlock) Clone() *DomainDiskSourceBlock {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.SecLabel != nil {
+		y.SecLabel = make([]DomainDeviceSecLabel, len(x.SecLabel))
+		copy(y.SecLabel, x.SecLabel)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskSourceBlock) Equal(y *DomainDiskSourceBlock) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Dev != y.Dev {
+		return false
+	}
+	if len(x.SecLabel) != len(y.SecLabel) {
+		return false
+	}
+	for i := range x.SecLabel {
+		if !x.SecLabel[i].Equal(&y.SecLabel[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskSourceDir) Clone() *DomainDiskSourceDir {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskSourceDir) Equal(y *DomainDiskSourceDir) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Dir != y.Dir {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskSourceNetwork) Clone() *DomainDiskSourceNetwork {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Hosts != nil {
+		y.Hosts = make([]DomainDiskSourceHost, len(x.Hosts))
+		copy(y.Hosts, x.Hosts)
+	}
+	y.Identity = x.Identity.Clone()
+	y.Initiator = x.Initiator.Clone()
+	y.Snapshot = x.Snapshot.Clone()
+	y.Config = x.Config.Clone()
+	y.Auth = x.Auth.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskSourceNetwork) Equal(y *DomainDiskSourceNetwork) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Protocol != y.Protocol {
+		return false
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Query != y.Query {
+		return false
+	}
+	if x.TLS != y.TLS {
+		return false
+	}
+	if len(x.Hosts) != len(y.Hosts) {
+		return false
+	}
+	for i := range x.Hosts {
+		if !x.Hosts[i].Equal(&y.Hosts[i]) {
+			return false
+		}
+	}
+	if !x.Identity.Equal(y.Identity) {
+		return false
+	}
+	if !x.Initiator.Equal(y.Initiator) {
+		return false
+	}
+	if !x.Snapshot.Equal(y.Snapshot) {
+		return false
+	}
+	if !x.Config.Equal(y.Config) {
+		return false
+	}
+	if !x.Auth.Equal(y.Auth) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskSourceNetworkIdentity) Clone() *DomainDiskSourceNetworkIdentity {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskSourceNetworkIdentity) Equal(y *DomainDiskSourceNetworkIdentity) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.User != y.User {
+		return false
+	}
+	if x.Group != y.Group {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskSourceNetworkInitiator) Clone() *DomainDiskSourceNetworkInitiator {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.IQN = x.IQN.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskSourceNetworkInitiator) Equal(y *DomainDiskSourceNetworkInitiator) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.IQN.Equal(y.IQN) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskSourceNetworkIQN) Clone() *DomainDiskSourceNetworkIQN {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskSourceNetworkIQN) Equal(y *DomainDiskSourceNetworkIQN) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskSourceNetworkSnapshot) Clone() *DomainDiskSourceNetworkSnapshot {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskSourceNetworkSnapshot) Equal(y *DomainDiskSourceNetworkSnapshot) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskSourceNetworkConfig) Clone() *DomainDiskSourceNetworkConfig {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskSourceNetworkConfig) Equal(y *DomainDiskSourceNetworkConfig) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.File != y.File {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskSourceVolume) Clone() *DomainDiskSourceVolume {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.SecLabel != nil {
+		y.SecLabel = make([]DomainDeviceSecLabel, len(x.SecLabel))
+		copy(y.SecLabel, x.SecLabel)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskSourceVolume) Equal(y *DomainDiskSourceVolume) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Pool != y.Pool {
+		return false
+	}
+	if x.Volume != y.Volume {
+		return false
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	if len(x.SecLabel) != len(y.SecLabel) {
+		return false
+	}
+	for i := range x.SecLabel {
+		if !x.SecLabel[i].Equal(&y.SecLabel[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskSourceVHostUser) Clone() *DomainDiskSourceVHostUser {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Null = x.Null.Clone()
+	y.VC = x.VC.Clone()
+	y.Pty = x.Pty.Clone()
+	y.Dev = x.Dev.Clone()
+	y.File = x.File.Clone()
+	y.Pipe = x.Pipe.Clone()
+	y.StdIO = x.StdIO.Clone()
+	y.UDP = x.UDP.Clone()
+	y.TCP = x.TCP.Clone()
+	y.UNIX = x.UNIX.Clone()
+	y.SpiceVMC = x.SpiceVMC.Clone()
+	y.SpicePort = x.SpicePort.Clone()
+	y.NMDM = x.NMDM.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskSourceVHostUser) Equal(y *DomainDiskSourceVHostUser) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Null.Equal(y.Null) &&
+		!(x.Null == nil && y.Null.Equal(&DomainChardevSourceNull{})) &&
+		!(y.Null == nil && x.Null.Equal(&DomainChardevSourceNull{})) {
+		return false
+	}
+	if !x.VC.Equal(y.VC) &&
+		!(x.VC == nil && y.VC.Equal(&DomainChardevSourceVC{})) &&
+		!(y.VC == nil && x.VC.Equal(&DomainChardevSourceVC{})) {
+		return false
+	}
+	if !x.Pty.Equal(y.Pty) &&
+		!(x.Pty == nil && y.Pty.Equal(&DomainChardevSourcePty{})) &&
+		!(y.Pty == nil && x.Pty.Equal(&DomainChardevSourcePty{})) {
+		return false
+	}
+	if !x.Dev.Equal(y.Dev) &&
+		!(x.Dev == nil && y.Dev.Equal(&DomainChardevSourceDev{})) &&
+		!(y.Dev == nil && x.Dev.Equal(&DomainChardevSourceDev{})) {
+		return false
+	}
+	if !x.File.Equal(y.File) &&
+		!(x.File == nil && y.File.Equal(&DomainChardevSourceFile{})) &&
+		!(y.File == nil && x.File.Equal(&DomainChardevSourceFile{})) {
+		return false
+	}
+	if !x.Pipe.Equal(y.Pipe) &&
+		!(x.Pipe == nil && y.Pipe.Equal(&DomainChardevSourcePipe{})) &&
+		!(y.Pipe == nil && x.Pipe.Equal(&DomainChardevSourcePipe{})) {
+		return false
+	}
+	if !x.StdIO.Equal(y.StdIO) &&
+		!(x.StdIO == nil && y.StdIO.Equal(&DomainChardevSourceStdIO{})) &&
+		!(y.StdIO == nil && x.StdIO.Equal(&DomainChardevSourceStdIO{})) {
+		return false
+	}
+	if !x.UDP.Equal(y.UDP) &&
+		!(x.UDP == nil && y.UDP.Equal(&DomainChardevSourceUDP{})) &&
+		!(y.UDP == nil && x.UDP.Equal(&DomainChardevSourceUDP{})) {
+		return false
+	}
+	if !x.TCP.Equal(y.TCP) &&
+		!(x.TCP == nil && y.TCP.Equal(&DomainChardevSourceTCP{})) &&
+		!(y.TCP == nil && x.TCP.Equal(&DomainChardevSourceTCP{})) {
+		return false
+	}
+	if !x.UNIX.Equal(y.UNIX) &&
+		!(x.UNIX == nil && y.UNIX.Equal(&DomainChardevSourceUNIX{})) &&
+		!(y.UNIX == nil && x.UNIX.Equal(&DomainChardevSourceUNIX{})) {
+		return false
+	}
+	if !x.SpiceVMC.Equal(y.SpiceVMC) &&
+		!(x.SpiceVMC == nil && y.SpiceVMC.Equal(&DomainChardevSourceSpiceVMC{})) &&
+		!(y.SpiceVMC == nil && x.SpiceVMC.Equal(&DomainChardevSourceSpiceVMC{})) {
+		return false
+	}
+	if !x.SpicePort.Equal(y.SpicePort) &&
+		!(x.SpicePort == nil && y.SpicePort.Equal(&DomainChardevSourceSpicePort{})) &&
+		!(y.SpicePort == nil && x.SpicePort.Equal(&DomainChardevSourceSpicePort{})) {
+		return false
+	}
+	if !x.NMDM.Equal(y.NMDM) &&
+		!(x.NMDM == nil && y.NMDM.Equal(&DomainChardevSourceNMDM{})) &&
+		!(y.NMDM == nil && x.NMDM.Equal(&DomainChardevSourceNMDM{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskMetadataCache) Clone() *DomainDiskMetadataCache {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.MaxSize = x.MaxSize.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskMetadataCache) Equal(y *DomainDiskMetadataCache) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.MaxSize.Equal(y.MaxSize) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskMetadataCacheSize) Clone() *DomainDiskMetadataCacheSize {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskMetadataCacheSize) Equal(y *DomainDiskMetadataCacheSize) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskDriver) Clone() *DomainDiskDriver {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.IOThread != nil {
+		v := *x.IOThread
+		y.IOThread = &v
+	}
+	if x.Queues != nil {
+		v := *x.Queues
+		y.Queues = &v
+	}
+	y.MetadataCache = x.MetadataCache.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskDriver) Equal(y *DomainDiskDriver) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Cache != y.Cache {
+		return false
+	}
+	if x.ErrorPolicy != y.ErrorPolicy {
+		return false
+	}
+	if x.RErrorPolicy != y.RErrorPolicy {
+		return false
+	}
+	if x.IO != y.IO {
+		return false
+	}
+	if x.IOEventFD != y.IOEventFD {
+		return false
+	}
+	if x.EventIDX != y.EventIDX {
+		return false
+	}
+	if x.CopyOnRead != y.CopyOnRead {
+		return false
+	}
+	if x.Discard != y.Discard {
+		return false
+	}
+	if (x.IOThread == nil) != (y.IOThread == nil) {
+		return false
+	}
+	if x.IOThread != nil {
+		if (*x.IOThread) != (*y.IOThread) {
+			return false
+		}
+	}
+	if x.DetectZeros != y.DetectZeros {
+		return false
+	}
+	if (x.Queues == nil) != (y.Queues == nil) {
+		return false
+	}
+	if x.Queues != nil {
+		if (*x.Queues) != (*y.Queues) {
+			return false
+		}
+	}
+	if x.IOMMU != y.IOMMU {
+		return false
+	}
+	if x.ATS != y.ATS {
+		return false
+	}
+	if x.Packed != y.Packed {
+		return false
+	}
+	if !x.MetadataCache.Equal(y.MetadataCache) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskTarget) Clone() *DomainDiskTarget {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskTarget) Equal(y *DomainDiskTarget) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Dev != y.Dev {
+		return false
+	}
+	if x.Bus != y.Bus {
+		return false
+	}
+	if x.Tray != y.Tray {
+		return false
+	}
+	if x.Removable != y.Removable {
+		return false
+	}
+	if x.RotationRate != y.RotationRate {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskEncryption) Clone() *DomainDiskEncryption {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Secret = x.Secret.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskEncryption) Equal(y *DomainDiskEncryption) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Format != y.Format {
+		return false
+	}
+	if !x.Secret.Equal(y.Secret) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskReadOnly) Clone() *DomainDiskReadOnly {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskReadOnly) Equal(y *DomainDiskReadOnly) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskShareable) Clone() *DomainDiskShareable {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskShareable) Equal(y *DomainDiskShareable) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskTransient) Clone() *DomainDiskTransient {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskTransient) Equal(y *DomainDiskTransient) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ShareBacking != y.ShareBacking {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskIOTune) Clone() *DomainDiskIOTune {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskIOTune) Equal(y *DomainDiskIOTune) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.TotalBytesSec != y.TotalBytesSec {
+		return false
+	}
+	if x.ReadBytesSec != y.ReadBytesSec {
+		return false
+	}
+	if x.WriteBytesSec != y.WriteBytesSec {
+		return false
+	}
+	if x.TotalIopsSec != y.TotalIopsSec {
+		return false
+	}
+	if x.ReadIopsSec != y.ReadIopsSec {
+		return false
+	}
+	if x.WriteIopsSec != y.WriteIopsSec {
+		return false
+	}
+	if x.TotalBytesSecMax != y.TotalBytesSecMax {
+		return false
+	}
+	if x.ReadBytesSecMax != y.ReadBytesSecMax {
+		return false
+	}
+	if x.WriteBytesSecMax != y.WriteBytesSecMax {
+		return false
+	}
+	if x.TotalIopsSecMax != y.TotalIopsSecMax {
+		return false
+	}
+	if x.ReadIopsSecMax != y.ReadIopsSecMax {
+		return false
+	}
+	if x.WriteIopsSecMax != y.WriteIopsSecMax {
+		return false
+	}
+	if x.TotalBytesSecMaxLength != y.TotalBytesSecMaxLength {
+		return false
+	}
+	if x.ReadBytesSecMaxLength != y.ReadBytesSecMaxLength {
+		return false
+	}
+	if x.WriteBytesSecMaxLength != y.WriteBytesSecMaxLength {
+		return false
+	}
+	if x.TotalIopsSecMaxLength != y.TotalIopsSecMaxLength {
+		return false
+	}
+	if x.ReadIopsSecMaxLength != y.ReadIopsSecMaxLength {
+		return false
+	}
+	if x.WriteIopsSecMaxLength != y.WriteIopsSecMaxLength {
+		return false
+	}
+	if x.SizeIopsSec != y.SizeIopsSec {
+		return false
+	}
+	if x.GroupName != y.GroupName {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskGeometry) Clone() *DomainDiskGeometry {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskGeometry) Equal(y *DomainDiskGeometry) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Cylinders != y.Cylinders {
+		return false
+	}
+	if x.Headers != y.Headers {
+		return false
+	}
+	if x.Sectors != y.Sectors {
+		return false
+	}
+	if x.Trans != y.Trans {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskBlockIO) Clone() *DomainDiskBlockIO {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskBlockIO) Equal(y *DomainDiskBlockIO) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.LogicalBlockSize != y.LogicalBlockSize {
+		return false
+	}
+	if x.PhysicalBlockSize != y.PhysicalBlockSize {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskFormat) Clone() *DomainDiskFormat {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.MetadataCache = x.MetadataCache.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskFormat) Equal(y *DomainDiskFormat) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if !x.MetadataCache.Equal(y.MetadataCache) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskBackingStore) Clone() *DomainDiskBackingStore {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Format = x.Format.Clone()
+	y.Source = x.Source.Clone()
+	y.BackingStore = x.BackingStore.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskBackingStore) Equal(y *DomainDiskBackingStore) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Index != y.Index {
+		return false
+	}
+	if !x.Format.Equal(y.Format) {
+		return false
+	}
+	if !x.Source.Equal(y.Source) &&
+		!(x.Source == nil && y.Source.Equal(&DomainDiskSource{})) &&
+		!(y.Source == nil && x.Source.Equal(&DomainDiskSource{})) {
+		return false
+	}
+	if !x.BackingStore.Equal(y.BackingStore) &&
+		!(x.BackingStore == nil && y.BackingStore.Equal(&DomainDiskBackingStore{})) &&
+		!(y.BackingStore == nil && x.BackingStore.Equal(&DomainDiskBackingStore{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDiskMirror) Clone() *DomainDiskMirror {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Format = x.Format.Clone()
+	y.Source = x.Source.Clone()
+	y.BackingStore = x.BackingStore.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDiskMirror) Equal(y *DomainDiskMirror) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Job != y.Job {
+		return false
+	}
+	if x.Ready != y.Ready {
+		return false
+	}
+	if !x.Format.Equal(y.Format) {
+		return false
+	}
+	if !x.Source.Equal(y.Source) &&
+		!(x.Source == nil && y.Source.Equal(&DomainDiskSource{})) &&
+		!(y.Source == nil && x.Source.Equal(&DomainDiskSource{})) {
+		return false
+	}
+	if !x.BackingStore.Equal(y.BackingStore) &&
+		!(x.BackingStore == nil && y.BackingStore.Equal(&DomainDiskBackingStore{})) &&
+		!(y.BackingStore == nil && x.BackingStore.Equal(&DomainDiskBackingStore{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainBackendDomain) Clone() *DomainBackendDomain {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainBackendDomain) Equal(y *DomainBackendDomain) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDisk) Clone() *DomainDisk {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Driver = x.Driver.Clone()
+	y.Auth = x.Auth.Clone()
+	y.Source = x.Source.Clone()
+	y.BackingStore = x.BackingStore.Clone()
+	y.BackendDomain = x.BackendDomain.Clone()
+	y.Geometry = x.Geometry.Clone()
+	y.BlockIO = x.BlockIO.Clone()
+	y.Mirror = x.Mirror.Clone()
+	y.Target = x.Target.Clone()
+	y.IOTune = x.IOTune.Clone()
+	y.ReadOnly = x.ReadOnly.Clone()
+	y.Shareable = x.Shareable.Clone()
+	y.Transient = x.Transient.Clone()
+	y.Encryption = x.Encryption.Clone()
+	y.Boot = x.Boot.Clone()
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDisk) Equal(y *DomainDisk) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Device != y.Device {
+		return false
+	}
+	if x.RawIO != y.RawIO {
+		return false
+	}
+	if x.SGIO != y.SGIO {
+		return false
+	}
+	if x.Snapshot != y.Snapshot {
+		return false
+	}
+	if x.Model != y.Model {
+		return false
+	}
+	if !x.Driver.Equal(y.Driver) {
+		return false
+	}
+	if !x.Auth.Equal(y.Auth) {
+		return false
+	}
+	if !x.Source.Equal(y.Source) &&
+		!(x.Source == nil && y.Source.Equal(&DomainDiskSource{})) &&
+		!(y.Source == nil && x.Source.Equal(&DomainDiskSource{})) {
+		return false
+	}
+	if !x.BackingStore.Equal(y.BackingStore) &&
+		!(x.BackingStore == nil && y.BackingStore.Equal(&DomainDiskBackingStore{})) &&
+		!(y.BackingStore == nil && x.BackingStore.Equal(&DomainDiskBackingStore{})) {
+		return false
+	}
+	if !x.BackendDomain.Equal(y.BackendDomain) {
+		return false
+	}
+	if !x.Geometry.Equal(y.Geometry) {
+		return false
+	}
+	if !x.BlockIO.Equal(y.BlockIO) {
+		return false
+	}
+	if !x.Mirror.Equal(y.Mirror) &&
+		!(x.Mirror == nil && y.Mirror.Equal(&DomainDiskMirror{})) &&
+		!(y.Mirror == nil && x.Mirror.Equal(&DomainDiskMirror{})) {
+		return false
+	}
+	if !x.Target.Equal(y.Target) {
+		return false
+	}
+	if !x.IOTune.Equal(y.IOTune) {
+		return false
+	}
+	if !x.ReadOnly.Equal(y.ReadOnly) {
+		return false
+	}
+	if !x.Shareable.Equal(y.Shareable) {
+		return false
+	}
+	if !x.Transient.Equal(y.Transient) {
+		return false
+	}
+	if x.Serial != y.Serial {
+		return false
+	}
+	if x.WWN != y.WWN {
+		return false
+	}
+	if x.Vendor != y.Vendor {
+		return false
+	}
+	if x.Product != y.Product {
+		return false
+	}
+	if !x.Encryption.Equal(y.Encryption) {
+		return false
+	}
+	if !x.Boot.Equal(y.Boot) {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFilesystemDriver) Clone() *DomainFilesystemDriver {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFilesystemDriver) Equal(y *DomainFilesystemDriver) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Format != y.Format {
+		return false
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.WRPolicy != y.WRPolicy {
+		return false
+	}
+	if x.IOMMU != y.IOMMU {
+		return false
+	}
+	if x.ATS != y.ATS {
+		return false
+	}
+	if x.Packed != y.Packed {
+		return false
+	}
+	if x.Queue != y.Queue {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFilesystemSource) Clone() *DomainFilesystemSource {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Mount = x.Mount.Clone()
+	y.Block = x.Block.Clone()
+	y.File = x.File.Clone()
+	y.Template = x.Template.Clone()
+	y.RAM = x.RAM.Clone()
+	y.Bind = x.Bind.Clone()
+	y.Volume = x.Volume.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFilesystemSource) Equal(y *DomainFilesystemSource) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Mount.Equal(y.Mount) &&
+		!(x.Mount == nil && y.Mount.Equal(&DomainFilesystemSourceMount{})) &&
+		!(y.Mount == nil && x.Mount.Equal(&DomainFilesystemSourceMount{})) {
+		return false
+	}
+	if !x.Block.Equal(y.Block) &&
+		!(x.Block == nil && y.Block.Equal(&DomainFilesystemSourceBlock{})) &&
+		!(y.Block == nil && x.Block.Equal(&DomainFilesystemSourceBlock{})) {
+		return false
+	}
+	if !x.File.Equal(y.File) &&
+		!(x.File == nil && y.File.Equal(&DomainFilesystemSourceFile{})) &&
+		!(y.File == nil && x.File.Equal(&DomainFilesystemSourceFile{})) {
+		return false
+	}
+	if !x.Template.Equal(y.Template) &&
+		!(x.Template == nil && y.Template.Equal(&DomainFilesystemSourceTemplate{})) &&
+		!(y.Template == nil && x.Template.Equal(&DomainFilesystemSourceTemplate{})) {
+		return false
+	}
+	if !x.RAM.Equal(y.RAM) &&
+		!(x.RAM == nil && y.RAM.Equal(&DomainFilesystemSourceRAM{})) &&
+		!(y.RAM == nil && x.RAM.Equal(&DomainFilesystemSourceRAM{})) {
+		return false
+	}
+	if !x.Bind.Equal(y.Bind) &&
+		!(x.Bind == nil && y.Bind.Equal(&DomainFilesystemSourceBind{})) &&
+		!(y.Bind == nil && x.Bind.Equal(&DomainFilesystemSourceBind{})) {
+		return false
+	}
+	if !x.Volume.Equal(y.Volume) &&
+		!(x.Volume == nil && y.Volume.Equal(&DomainFilesystemSourceVolume{})) &&
+		!(y.Volume == nil && x.Volume.Equal(&DomainFilesystemSourceVolume{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFilesystemSourceMount) Clone() *DomainFilesystemSourceMount {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFilesystemSourceMount) Equal(y *DomainFilesystemSourceMount) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Dir != y.Dir {
+		return false
+	}
+	if x.Socket != y.Socket {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFilesystemSourceBlock) Clone() *DomainFilesystemSourceBlock {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFilesystemSourceBlock) Equal(y *DomainFilesystemSourceBlock) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Dev != y.Dev {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFilesystemSourceFile) Clone() *DomainFilesystemSourceFile {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFilesystemSourceFile) Equal(y *DomainFilesystemSourceFile) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.File != y.File {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFilesystemSourceTemplate) Clone() *DomainFilesystemSourceTemplate {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFilesystemSourceTemplate) Equal(y *DomainFilesystemSourceTemplate) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFilesystemSourceRAM) Clone() *DomainFilesystemSourceRAM {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFilesystemSourceRAM) Equal(y *DomainFilesystemSourceRAM) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Usage != y.Usage {
+		return false
+	}
+	if x.Units != y.Units {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFilesystemSourceBind) Clone() *DomainFilesystemSourceBind {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFilesystemSourceBind) Equal(y *DomainFilesystemSourceBind) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Dir != y.Dir {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFilesystemSourceVolume) Clone() *DomainFilesystemSourceVolume {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFilesystemSourceVolume) Equal(y *DomainFilesystemSourceVolume) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Pool != y.Pool {
+		return false
+	}
+	if x.Volume != y.Volume {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFilesystemTarget) Clone() *DomainFilesystemTarget {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFilesystemTarget) Equal(y *DomainFilesystemTarget) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Dir != y.Dir {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFilesystemReadOnly) Clone() *DomainFilesystemReadOnly {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFilesystemReadOnly) Equal(y *DomainFilesystemReadOnly) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFilesystemSpaceHardLimit) Clone() *DomainFilesystemSpaceHardLimit {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFilesystemSpaceHardLimit) Equal(y *DomainFilesystemSpaceHardLimit) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFilesystemSpaceSoftLimit) Clone() *DomainFilesystemSpaceSoftLimit {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFilesystemSpaceSoftLimit) Equal(y *DomainFilesystemSpaceSoftLimit) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFilesystemBinaryCache) Clone() *DomainFilesystemBinaryCache {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFilesystemBinaryCache) Equal(y *DomainFilesystemBinaryCache) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFilesystemBinarySandbox) Clone() *DomainFilesystemBinarySandbox {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFilesystemBinarySandbox) Equal(y *DomainFilesystemBinarySandbox) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFilesystemBinaryLock) Clone() *DomainFilesystemBinaryLock {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFilesystemBinaryLock) Equal(y *DomainFilesystemBinaryLock) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.POSIX != y.POSIX {
+		return false
+	}
+	if x.Flock != y.Flock {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFilesystemBinary) Clone() *DomainFilesystemBinary {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Cache = x.Cache.Clone()
+	y.Sandbox = x.Sandbox.Clone()
+	y.Lock = x.Lock.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFilesystemBinary) Equal(y *DomainFilesystemBinary) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	if x.XAttr != y.XAttr {
+		return false
+	}
+	if !x.Cache.Equal(y.Cache) {
+		return false
+	}
+	if !x.Sandbox.Equal(y.Sandbox) {
+		return false
+	}
+	if !x.Lock.Equal(y.Lock) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFilesystem) Clone() *DomainFilesystem {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Driver = x.Driver.Clone()
+	y.Binary = x.Binary.Clone()
+	y.Source = x.Source.Clone()
+	y.Target = x.Target.Clone()
+	y.ReadOnly = x.ReadOnly.Clone()
+	y.SpaceHardLimit = x.SpaceHardLimit.Clone()
+	y.SpaceSoftLimit = x.SpaceSoftLimit.Clone()
+	y.Boot = x.Boot.Clone()
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFilesystem) Equal(y *DomainFilesystem) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.AccessMode != y.AccessMode {
+		return false
+	}
+	if x.Model != y.Model {
+		return false
+	}
+	if x.MultiDevs != y.MultiDevs {
+		return false
+	}
+	if x.FMode != y.FMode {
+		return false
+	}
+	if x.DMode != y.DMode {
+		return false
+	}
+	if !x.Driver.Equal(y.Driver) {
+		return false
+	}
+	if !x.Binary.Equal(y.Binary) {
+		return false
+	}
+	if !x.Source.Equal(y.Source) &&
+		!(x.Source == nil && y.Source.Equal(&DomainFilesystemSource{})) &&
+		!(y.Source == nil && x.Source.Equal(&DomainFilesystemSource{})) {
+		return false
+	}
+	if !x.Target.Equal(y.Target) {
+		return false
+	}
+	if !x.ReadOnly.Equal(y.ReadOnly) {
+		return false
+	}
+	if !x.SpaceHardLimit.Equal(y.SpaceHardLimit) {
+		return false
+	}
+	if !x.SpaceSoftLimit.Equal(y.SpaceSoftLimit) {
+		return false
+	}
+	if !x.Boot.Equal(y.Boot) {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceMAC) Clone() *DomainInterfaceMAC {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceMAC) Equal(y *DomainInterfaceMAC) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Check != y.Check {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceModel) Clone() *DomainInterfaceModel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceModel) Equal(y *DomainInterfaceModel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceSource) Clone() *DomainInterfaceSource {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.User = x.User.Clone()
+	y.Ethernet = x.Ethernet.Clone()
+	y.VHostUser = x.VHostUser.Clone()
+	y.Server = x.Server.Clone()
+	y.Client = x.Client.Clone()
+	y.MCast = x.MCast.Clone()
+	y.Network = x.Network.Clone()
+	y.Bridge = x.Bridge.Clone()
+	y.Internal = x.Internal.Clone()
+	y.Direct = x.Direct.Clone()
+	y.Hostdev = x.Hostdev.Clone()
+	y.UDP = x.UDP.Clone()
+	y.VDPA = x.VDPA.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceSource) Equal(y *DomainInterfaceSource) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.User.Equal(y.User) &&
+		!(x.User == nil && y.User.Equal(&DomainInterfaceSourceUser{})) &&
+		!(y.User == nil && x.User.Equal(&DomainInterfaceSourceUser{})) {
+		return false
+	}
+	if !x.Ethernet.Equal(y.Ethernet) &&
+		!(x.Ethernet == nil && y.Ethernet.Equal(&DomainInterfaceSourceEthernet{})) &&
+		!(y.Ethernet == nil && x.Ethernet.Equal(&DomainInterfaceSourceEthernet{})) {
+		return false
+	}
+	if !x.VHostUser.Equal(y.VHostUser) &&
+		!(x.VHostUser == nil && y.VHostUser.Equal(&DomainChardevSource{})) &&
+		!(y.VHostUser == nil && x.VHostUser.Equal(&DomainChardevSource{})) {
+		return false
+	}
+	if !x.Server.Equal(y.Server) &&
+		!(x.Server == nil && y.Server.Equal(&DomainInterfaceSourceServer{})) &&
+		!(y.Server == nil && x.Server.Equal(&DomainInterfaceSourceServer{})) {
+		return false
+	}
+	if !x.Client.Equal(y.Client) &&
+		!(x.Client == nil && y.Client.Equal(&DomainInterfaceSourceClient{})) &&
+		!(y.Client == nil && x.Client.Equal(&DomainInterfaceSourceClient{})) {
+		return false
+	}
+	if !x.MCast.Equal(y.MCast) &&
+		!(x.MCast == nil && y.MCast.Equal(&DomainInterfaceSourceMCast{})) &&
+		!(y.MCast == nil && x.MCast.Equal(&DomainInterfaceSourceMCast{})) {
+		return false
+	}
+	if !x.Network.Equal(y.Network) &&
+		!(x.Network == nil && y.Network.Equal(&DomainInterfaceSourceNetwork{})) &&
+		!(y.Network == nil && x.Network.Equal(&DomainInterfaceSourceNetwork{})) {
+		return false
+	}
+	if !x.Bridge.Equal(y.Bridge) &&
+		!(x.Bridge == nil && y.Bridge.Equal(&DomainInterfaceSourceBridge{})) &&
+		!(y.Bridge == nil && x.Bridge.Equal(&DomainInterfaceSourceBridge{})) {
+		return false
+	}
+	if !x.Internal.Equal(y.Internal) &&
+		!(x.Internal == nil && y.Internal.Equal(&DomainInterfaceSourceInternal{})) &&
+		!(y.Internal == nil && x.Internal.Equal(&DomainInterfaceSourceInternal{})) {
+		return false
+	}
+	if !x.Direct.Equal(y.Direct) &&
+		!(x.Direct == nil && y.Direct.Equal(&DomainInterfaceSourceDirect{})) &&
+		!(y.Direct == nil && x.Direct.Equal(&DomainInterfaceSourceDirect{})) {
+		return false
+	}
+	if !x.Hostdev.Equal(y.Hostdev) &&
+		!(x.Hostdev == nil && y.Hostdev.Equal(&DomainInterfaceSourceHostdev{})) &&
+		!(y.Hostdev == nil && x.Hostdev.Equal(&DomainInterfaceSourceHostdev{})) {
+		return false
+	}
+	if !x.UDP.Equal(y.UDP) &&
+		!(x.UDP == nil && y.UDP.Equal(&DomainInterfaceSourceUDP{})) &&
+		!(y.UDP == nil && x.UDP.Equal(&DomainInterfaceSourceUDP{})) {
+		return false
+	}
+	if !x.VDPA.Equal(y.VDPA) &&
+		!(x.VDPA == nil && y.VDPA.Equal(&DomainInterfaceSourceVDPA{})) &&
+		!(y.VDPA == nil && x.VDPA.Equal(&DomainInterfaceSourceVDPA{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceSourceUser) Clone() *DomainInterfaceSourceUser {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceSourceUser) Equal(y *DomainInterfaceSourceUser) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceSourceEthernet) Clone() *DomainInterfaceSourceEthernet {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.IP != nil {
+		y.IP = make([]DomainInterfaceIP, len(x.IP))
+		copy(y.IP, x.IP)
+	}
+	if x.Route != nil {
+		y.Route = make([]DomainInterfaceRoute, len(x.Route))
+		copy(y.Route, x.Route)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceSourceEthernet) Equal(y *DomainInterfaceSourceEthernet) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.IP) != len(y.IP) {
+		return false
+	}
+	for i := range x.IP {
+		if !x.IP[i].Equal(&y.IP[i]) {
+			return false
+		}
+	}
+	if len(x.Route) != len(y.Route) {
+		return false
+	}
+	for i := range x.Route {
+		if !x.Route[i].Equal(&y.Route[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceSourceServer) Clone() *DomainInterfaceSourceServer {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Local = x.Local.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceSourceServer) Equal(y *DomainInterfaceSourceServer) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	if x.Port != y.Port {
+		return false
+	}
+	if !x.Local.Equal(y.Local) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceSourceClient) Clone() *DomainInterfaceSourceClient {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Local = x.Local.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceSourceClient) Equal(y *DomainInterfaceSourceClient) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	if x.Port != y.Port {
+		return false
+	}
+	if !x.Local.Equal(y.Local) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceSourceMCast) Clone() *DomainInterfaceSourceMCast {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Local = x.Local.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceSourceMCast) Equal(y *DomainInterfaceSourceMCast) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	if x.Port != y.Port {
+		return false
+	}
+	if !x.Local.Equal(y.Local) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceSourceNetwork) Clone() *DomainInterfaceSourceNetwork {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceSourceNetwork) Equal(y *DomainInterfaceSourceNetwork) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Network != y.Network {
+		return false
+	}
+	if x.PortGroup != y.PortGroup {
+		return false
+	}
+	if x.Bridge != y.Bridge {
+		return false
+	}
+	if x.PortID != y.PortID {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceSourceBridge) Clone() *DomainInterfaceSourceBridge {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceSourceBridge) Equal(y *DomainInterfaceSourceBridge) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Bridge != y.Bridge {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceSourceInternal) Clone() *DomainInterfaceSourceInternal {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceSourceInternal) Equal(y *DomainInterfaceSourceInternal) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceSourceDirect) Clone() *DomainInterfaceSourceDirect {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceSourceDirect) Equal(y *DomainInterfaceSourceDirect) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Dev != y.Dev {
+		return false
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceSourceHostdev) Clone() *DomainInterfaceSourceHostdev {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.PCI = x.PCI.Clone()
+	y.USB = x.USB.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceSourceHostdev) Equal(y *DomainInterfaceSourceHostdev) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.PCI.Equal(y.PCI) &&
+		!(x.PCI == nil && y.PCI.Equal(&DomainHostdevSubsysPCISource{})) &&
+		!(y.PCI == nil && x.PCI.Equal(&DomainHostdevSubsysPCISource{})) {
+		return false
+	}
+	if !x.USB.Equal(y.USB) &&
+		!(x.USB == nil && y.USB.Equal(&DomainHostdevSubsysUSBSource{})) &&
+		!(y.USB == nil && x.USB.Equal(&DomainHostdevSubsysUSBSource{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceSourceUDP) Clone() *DomainInterfaceSourceUDP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Local = x.Local.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceSourceUDP) Equal(y *DomainInterfaceSourceUDP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	if x.Port != y.Port {
+		return false
+	}
+	if !x.Local.Equal(y.Local) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceSourceVDPA) Clone() *DomainInterfaceSourceVDPA {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceSourceVDPA) Equal(y *DomainInterfaceSourceVDPA) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Device != y.Device {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceSourceLocal) Clone() *DomainInterfaceSourceLocal {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceSourceLocal) Equal(y *DomainInterfaceSourceLocal) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	if x.Port != y.Port {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceTarget) Clone() *DomainInterfaceTarget {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceTarget) Equal(y *DomainInterfaceTarget) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Dev != y.Dev {
+		return false
+	}
+	if x.Managed != y.Managed {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceLink) Clone() *DomainInterfaceLink {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceLink) Equal(y *DomainInterfaceLink) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.State != y.State {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDeviceBoot) Clone() *DomainDeviceBoot {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDeviceBoot) Equal(y *DomainDeviceBoot) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Order != y.Order {
+		return false
+	}
+	if x.LoadParm != y.LoadParm {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceScript) Clone() *DomainInterfaceScript {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceScript) Equal(y *DomainInterfaceScript) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceDriver) Clone() *DomainInterfaceDriver {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Host = x.Host.Clone()
+	y.Guest = x.Guest.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceDriver) Equal(y *DomainInterfaceDriver) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.TXMode != y.TXMode {
+		return false
+	}
+	if x.IOEventFD != y.IOEventFD {
+		return false
+	}
+	if x.EventIDX != y.EventIDX {
+		return false
+	}
+	if x.Queues != y.Queues {
+		return false
+	}
+	if x.RXQueueSize != y.RXQueueSize {
+		return false
+	}
+	if x.TXQueueSize != y.TXQueueSize {
+		return false
+	}
+	if x.IOMMU != y.IOMMU {
+		return false
+	}
+	if x.ATS != y.ATS {
+		return false
+	}
+	if x.Packed != y.Packed {
+		return false
+	}
+	if !x.Host.Equal(y.Host) {
+		return false
+	}
+	if !x.Guest.Equal(y.Guest) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceDriverHost) Clone() *DomainInterfaceDriverHost {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceDriverHost) Equal(y *DomainInterfaceDriverHost) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.CSum != y.CSum {
+		return false
+	}
+	if x.GSO != y.GSO {
+		return false
+	}
+	if x.TSO4 != y.TSO4 {
+		return false
+	}
+	if x.TSO6 != y.TSO6 {
+		return false
+	}
+	if x.ECN != y.ECN {
+		return false
+	}
+	if x.UFO != y.UFO {
+		return false
+	}
+	if x.MrgRXBuf != y.MrgRXBuf {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceDriverGuest) Clone() *DomainInterfaceDriverGuest {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceDriverGuest) Equal(y *DomainInterfaceDriverGuest) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.CSum != y.CSum {
+		return false
+	}
+	if x.TSO4 != y.TSO4 {
+		return false
+	}
+	if x.TSO6 != y.TSO6 {
+		return false
+	}
+	if x.ECN != y.ECN {
+		return false
+	}
+	if x.UFO != y.UFO {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceVirtualPort) Clone() *DomainInterfaceVirtualPort {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Params = x.Params.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceVirtualPort) Equal(y *DomainInterfaceVirtualPort) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Params.Equal(y.Params) &&
+		!(x.Params == nil && y.Params.Equal(&DomainInterfaceVirtualPortParams{})) &&
+		!(y.Params == nil && x.Params.Equal(&DomainInterfaceVirtualPortParams{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceVirtualPortParams) Clone() *DomainInterfaceVirtualPortParams {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Any = x.Any.Clone()
+	y.VEPA8021QBG = x.VEPA8021QBG.Clone()
+	y.VNTag8011QBH = x.VNTag8011QBH.Clone()
+	y.OpenVSwitch = x.OpenVSwitch.Clone()
+	y.MidoNet = x.MidoNet.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceVirtualPortParams) Equal(y *DomainInterfaceVirtualPortParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Any.Equal(y.Any) &&
+		!(x.Any == nil && y.Any.Equal(&DomainInterfaceVirtualPortParamsAny{})) &&
+		!(y.Any == nil && x.Any.Equal(&DomainInterfaceVirtualPortParamsAny{})) {
+		return false
+	}
+	if !x.VEPA8021QBG.Equal(y.VEPA8021QBG) &&
+		!(x.VEPA8021QBG == nil && y.VEPA8021QBG.Equal(&DomainInterfaceVirtualPortParamsVEPA8021QBG{})) &&
+		!(y.VEPA8021QBG == nil && x.VEPA8021QBG.Equal(&DomainInterfaceVirtualPortParamsVEPA8021QBG{})) {
+		return false
+	}
+	if !x.VNTag8011QBH.Equal(y.VNTag8011QBH) &&
+		!(x.VNTag8011QBH == nil && y.VNTag8011QBH.Equal(&DomainInterfaceVirtualPortParamsVNTag8021QBH{})) &&
+		!(y.VNTag8011QBH == nil && x.VNTag8011QBH.Equal(&DomainInterfaceVirtualPortParamsVNTag8021QBH{})) {
+		return false
+	}
+	if !x.OpenVSwitch.Equal(y.OpenVSwitch) &&
+		!(x.OpenVSwitch == nil && y.OpenVSwitch.Equal(&DomainInterfaceVirtualPortParamsOpenVSwitch{})) &&
+		!(y.OpenVSwitch == nil && x.OpenVSwitch.Equal(&DomainInterfaceVirtualPortParamsOpenVSwitch{})) {
+		return false
+	}
+	if !x.MidoNet.Equal(y.MidoNet) &&
+		!(x.MidoNet == nil && y.MidoNet.Equal(&DomainInterfaceVirtualPortParamsMidoNet{})) &&
+		!(y.MidoNet == nil && x.MidoNet.Equal(&DomainInterfaceVirtualPortParamsMidoNet{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceVirtualPortParamsAny) Clone() *DomainInterfaceVirtualPortParamsAny {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.ManagerID != nil {
+		v := *x.ManagerID
+		y.ManagerID = &v
+	}
+	if x.TypeID != nil {
+		v := *x.TypeID
+		y.TypeID = &v
+	}
+	if x.TypeIDVersion != nil {
+		v := *x.TypeIDVersion
+		y.TypeIDVersion = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceVirtualPortParamsAny) Equal(y *DomainInterfaceVirtualPortParamsAny) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.ManagerID == nil) != (y.ManagerID == nil) {
+		return false
+	}
+	if x.ManagerID != nil {
+		if (*x.ManagerID) != (*y.ManagerID) {
+			return false
+		}
+	}
+	if (x.TypeID == nil) != (y.TypeID == nil) {
+		return false
+	}
+	if x.TypeID != nil {
+		if (*x.TypeID) != (*y.TypeID) {
+			return false
+		}
+	}
+	if (x.TypeIDVersion == nil) != (y.TypeIDVersion == nil) {
+		return false
+	}
+	if x.TypeIDVersion != nil {
+		if (*x.TypeIDVersion) != (*y.TypeIDVersion) {
+			return false
+		}
+	}
+	if x.InstanceID != y.InstanceID {
+		return false
+	}
+	if x.ProfileID != y.ProfileID {
+		return false
+	}
+	if x.InterfaceID != y.InterfaceID {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceVirtualPortParamsVEPA8021QBG) Clone() *DomainInterfaceVirtualPortParamsVEPA8021QBG {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.ManagerID != nil {
+		v := *x.ManagerID
+		y.ManagerID = &v
+	}
+	if x.TypeID != nil {
+		v := *x.TypeID
+		y.TypeID = &v
+	}
+	if x.TypeIDVersion != nil {
+		v := *x.TypeIDVersion
+		y.TypeIDVersion = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceVirtualPortParamsVEPA8021QBG) Equal(y *DomainInterfaceVirtualPortParamsVEPA8021QBG) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.ManagerID == nil) != (y.ManagerID == nil) {
+		return false
+	}
+	if x.ManagerID != nil {
+		if (*x.ManagerID) != (*y.ManagerID) {
+			return false
+		}
+	}
+	if (x.TypeID == nil) != (y.TypeID == nil) {
+		return false
+	}
+	if x.TypeID != nil {
+		if (*x.TypeID) != (*y.TypeID) {
+			return false
+		}
+	}
+	if (x.TypeIDVersion == nil) != (y.TypeIDVersion == nil) {
+		return false
+	}
+	if x.TypeIDVersion != nil {
+		if (*x.TypeIDVersion) != (*y.TypeIDVersion) {
+			return false
+		}
+	}
+	if x.InstanceID != y.InstanceID {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceVirtualPortParamsVNTag8021QBH) Clone() *DomainInterfaceVirtualPortParamsVNTag8021QBH {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceVirtualPortParamsVNTag8021QBH) Equal(y *DomainInterfaceVirtualPortParamsVNTag8021QBH) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ProfileID != y.ProfileID {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceVirtualPortParamsOpenVSwitch) Clone() *DomainInterfaceVirtualPortParamsOpenVSwitch {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceVirtualPortParamsOpenVSwitch) Equal(y *DomainInterfaceVirtualPortParamsOpenVSwitch) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.InterfaceID != y.InterfaceID {
+		return false
+	}
+	if x.ProfileID != y.ProfileID {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceVirtualPortParamsMidoNet) Clone() *DomainInterfaceVirtualPortParamsMidoNet {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceVirtualPortParamsMidoNet) Equal(y *DomainInterfaceVirtualPortParamsMidoNet) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.InterfaceID != y.InterfaceID {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceBandwidthParams) Clone() *DomainInterfaceBandwidthParams {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Average != nil {
+		v := *x.Average
+		y.Average = &v
+	}
+	if x.Peak != nil {
+		v := *x.Peak
+		y.Peak = &v
+	}
+	if x.Burst != nil {
+		v := *x.Burst
+		y.Burst = &v
+	}
+	if x.Floor != nil {
+		v := *x.Floor
+		y.Floor = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceBandwidthParams) Equal(y *DomainInterfaceBandwidthParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.Average == nil) != (y.Average == nil) {
+		return false
+	}
+	if x.Average != nil {
+		if (*x.Average) != (*y.Average) {
+			return false
+		}
+	}
+	if (x.Peak == nil) != (y.Peak == nil) {
+		return false
+	}
+	if x.Peak != nil {
+		if (*x.Peak) != (*y.Peak) {
+			return false
+		}
+	}
+	if (x.Burst == nil) != (y.Burst == nil) {
+		return false
+	}
+	if x.Burst != nil {
+		if (*x.Burst) != (*y.Burst) {
+			return false
+		}
+	}
+	if (x.Floor == nil) != (y.Floor == nil) {
+		return false
+	}
+	if x.Floor != nil {
+		if (*x.Floor) != (*y.Floor) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceBandwidth) Clone() *DomainInterfaceBandwidth {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Inbound = x.Inbound.Clone()
+	y.Outbound = x.Outbound.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceBandwidth) Equal(y *DomainInterfaceBandwidth) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Inbound.Equal(y.Inbound) {
+		return false
+	}
+	if !x.Outbound.Equal(y.Outbound) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceVLan) Clone() *DomainInterfaceVLan {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Tags != nil {
+		y.Tags = make([]DomainInterfaceVLanTag, len(x.Tags))
+		copy(y.Tags, x.Tags)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceVLan) Equal(y *DomainInterfaceVLan) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Trunk != y.Trunk {
+		return false
+	}
+	if len(x.Tags) != len(y.Tags) {
+		return false
+	}
+	for i := range x.Tags {
+		if !x.Tags[i].Equal(&y.Tags[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceVLanTag) Clone() *DomainInterfaceVLanTag {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceVLanTag) Equal(y *DomainInterfaceVLanTag) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ID != y.ID {
+		return false
+	}
+	if x.NativeMode != y.NativeMode {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceGuest) Clone() *DomainInterfaceGuest {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceGuest) Equal(y *DomainInterfaceGuest) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Dev != y.Dev {
+		return false
+	}
+	if x.Actual != y.Actual {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceFilterRef) Clone() *DomainInterfaceFilterRef {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Parameters != nil {
+		y.Parameters = make([]DomainInterfaceFilterParam, len(x.Parameters))
+		copy(y.Parameters, x.Parameters)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceFilterRef) Equal(y *DomainInterfaceFilterRef) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Filter != y.Filter {
+		return false
+	}
+	if len(x.Parameters) != len(y.Parameters) {
+		return false
+	}
+	for i := range x.Parameters {
+		if !x.Parameters[i].Equal(&y.Parameters[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceFilterParam) Clone() *DomainInterfaceFilterParam {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceFilterParam) Equal(y *DomainInterfaceFilterParam) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceBackend) Clone() *DomainInterfaceBackend {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceBackend) Equal(y *DomainInterfaceBackend) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Tap != y.Tap {
+		return false
+	}
+	if x.VHost != y.VHost {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceTune) Clone() *DomainInterfaceTune {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceTune) Equal(y *DomainInterfaceTune) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.SndBuf != y.SndBuf {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceMTU) Clone() *DomainInterfaceMTU {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceMTU) Equal(y *DomainInterfaceMTU) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Size != y.Size {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceCoalesce) Clone() *DomainInterfaceCoalesce {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.RX = x.RX.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceCoalesce) Equal(y *DomainInterfaceCoalesce) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.RX.Equal(y.RX) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceCoalesceRX) Clone() *DomainInterfaceCoalesceRX {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Frames = x.Frames.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceCoalesceRX) Equal(y *DomainInterfaceCoalesceRX) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Frames.Equal(y.Frames) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceCoalesceRXFrames) Clone() *DomainInterfaceCoalesceRXFrames {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Max != nil {
+		v := *x.Max
+		y.Max = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceCoalesceRXFrames) Equal(y *DomainInterfaceCoalesceRXFrames) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.Max == nil) != (y.Max == nil) {
+		return false
+	}
+	if x.Max != nil {
+		if (*x.Max) != (*y.Max) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainROM) Clone() *DomainROM {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainROM) Equal(y *DomainROM) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Bar != y.Bar {
+		return false
+	}
+	if x.File != y.File {
+		return false
+	}
+	if x.Enabled != y.Enabled {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceIP) Clone() *DomainInterfaceIP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceIP) Equal(y *DomainInterfaceIP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	if x.Family != y.Family {
+		return false
+	}
+	if x.Prefix != y.Prefix {
+		return false
+	}
+	if x.Peer != y.Peer {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceRoute) Clone() *DomainInterfaceRoute {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceRoute) Equal(y *DomainInterfaceRoute) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Family != y.Family {
+		return false
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	if x.Netmask != y.Netmask {
+		return false
+	}
+	if x.Prefix != y.Prefix {
+		return false
+	}
+	if x.Gateway != y.Gateway {
+		return false
+	}
+	if x.Metric != y.Metric {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfaceTeaming) Clone() *DomainInterfaceTeaming {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfaceTeaming) Equal(y *DomainInterfaceTeaming) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Persistent != y.Persistent {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterfacePortOptions) Clone() *DomainInterfacePortOptions {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterfacePortOptions) Equal(y *DomainInterfacePortOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Isolated != y.Isolated {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInterface) Clone() *DomainInterface {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.MAC = x.MAC.Clone()
+	y.Source = x.Source.Clone()
+	y.Boot = x.Boot.Clone()
+	y.VLan = x.VLan.Clone()
+	y.VirtualPort = x.VirtualPort.Clone()
+	if x.IP != nil {
+		y.IP = make([]DomainInterfaceIP, len(x.IP))
+		copy(y.IP, x.IP)
+	}
+	if x.Route != nil {
+		y.Route = make([]DomainInterfaceRoute, len(x.Route))
+		copy(y.Route, x.Route)
+	}
+	y.Script = x.Script.Clone()
+	y.DownScript = x.DownScript.Clone()
+	y.BackendDomain = x.BackendDomain.Clone()
+	y.Target = x.Target.Clone()
+	y.Guest = x.Guest.Clone()
+	y.Model = x.Model.Clone()
+	y.Driver = x.Driver.Clone()
+	y.Backend = x.Backend.Clone()
+	y.FilterRef = x.FilterRef.Clone()
+	y.Tune = x.Tune.Clone()
+	y.Teaming = x.Teaming.Clone()
+	y.Link = x.Link.Clone()
+	y.MTU = x.MTU.Clone()
+	y.Bandwidth = x.Bandwidth.Clone()
+	y.PortOptions = x.PortOptions.Clone()
+	y.Coalesce = x.Coalesce.Clone()
+	y.ROM = x.ROM.Clone()
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInterface) Equal(y *DomainInterface) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Managed != y.Managed {
+		return false
+	}
+	if x.TrustGuestRXFilters != y.TrustGuestRXFilters {
+		return false
+	}
+	if !x.MAC.Equal(y.MAC) {
+		return false
+	}
+	if !x.Source.Equal(y.Source) &&
+		!(x.Source == nil && y.Source.Equal(&DomainInterfaceSource{})) &&
+		!(y.Source == nil && x.Source.Equal(&DomainInterfaceSource{})) {
+		return false
+	}
+	if !x.Boot.Equal(y.Boot) {
+		return false
+	}
+	if !x.VLan.Equal(y.VLan) {
+		return false
+	}
+	if !x.VirtualPort.Equal(y.VirtualPort) &&
+		!(x.VirtualPort == nil && y.VirtualPort.Equal(&DomainInterfaceVirtualPort{})) &&
+		!(y.VirtualPort == nil && x.VirtualPort.Equal(&DomainInterfaceVirtualPort{})) {
+		return false
+	}
+	if len(x.IP) != len(y.IP) {
+		return false
+	}
+	for i := range x.IP {
+		if !x.IP[i].Equal(&y.IP[i]) {
+			return false
+		}
+	}
+	if len(x.Route) != len(y.Route) {
+		return false
+	}
+	for i := range x.Route {
+		if !x.Route[i].Equal(&y.Route[i]) {
+			return false
+		}
+	}
+	if !x.Script.Equal(y.Script) {
+		return false
+	}
+	if !x.DownScript.Equal(y.DownScript) {
+		return false
+	}
+	if !x.BackendDomain.Equal(y.BackendDomain) {
+		return false
+	}
+	if !x.Target.Equal(y.Target) {
+		return false
+	}
+	if !x.Guest.Equal(y.Guest) {
+		return false
+	}
+	if !x.Model.Equal(y.Model) {
+		return false
+	}
+	if !x.Driver.Equal(y.Driver) {
+		return false
+	}
+	if !x.Backend.Equal(y.Backend) {
+		return false
+	}
+	if !x.FilterRef.Equal(y.FilterRef) {
+		return false
+	}
+	if !x.Tune.Equal(y.Tune) {
+		return false
+	}
+	if !x.Teaming.Equal(y.Teaming) {
+		return false
+	}
+	if !x.Link.Equal(y.Link) {
+		return false
+	}
+	if !x.MTU.Equal(y.MTU) {
+		return false
+	}
+	if !x.Bandwidth.Equal(y.Bandwidth) {
+		return false
+	}
+	if !x.PortOptions.Equal(y.PortOptions) {
+		return false
+	}
+	if !x.Coalesce.Equal(y.Coalesce) {
+		return false
+	}
+	if !x.ROM.Equal(y.ROM) {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChardevSource) Clone() *DomainChardevSource {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Null = x.Null.Clone()
+	y.VC = x.VC.Clone()
+	y.Pty = x.Pty.Clone()
+	y.Dev = x.Dev.Clone()
+	y.File = x.File.Clone()
+	y.Pipe = x.Pipe.Clone()
+	y.StdIO = x.StdIO.Clone()
+	y.UDP = x.UDP.Clone()
+	y.TCP = x.TCP.Clone()
+	y.UNIX = x.UNIX.Clone()
+	y.SpiceVMC = x.SpiceVMC.Clone()
+	y.SpicePort = x.SpicePort.Clone()
+	y.NMDM = x.NMDM.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChardevSource) Equal(y *DomainChardevSource) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Null.Equal(y.Null) &&
+		!(x.Null == nil && y.Null.Equal(&DomainChardevSourceNull{})) &&
+		!(y.Null == nil && x.Null.Equal(&DomainChardevSourceNull{})) {
+		return false
+	}
+	if !x.VC.Equal(y.VC) &&
+		!(x.VC == nil && y.VC.Equal(&DomainChardevSourceVC{})) &&
+		!(y.VC == nil && x.VC.Equal(&DomainChardevSourceVC{})) {
+		return false
+	}
+	if !x.Pty.Equal(y.Pty) &&
+		!(x.Pty == nil && y.Pty.Equal(&DomainChardevSourcePty{})) &&
+		!(y.Pty == nil && x.Pty.Equal(&DomainChardevSourcePty{})) {
+		return false
+	}
+	if !x.Dev.Equal(y.Dev) &&
+		!(x.Dev == nil && y.Dev.Equal(&DomainChardevSourceDev{})) &&
+		!(y.Dev == nil && x.Dev.Equal(&DomainChardevSourceDev{})) {
+		return false
+	}
+	if !x.File.Equal(y.File) &&
+		!(x.File == nil && y.File.Equal(&DomainChardevSourceFile{})) &&
+		!(y.File == nil && x.File.Equal(&DomainChardevSourceFile{})) {
+		return false
+	}
+	if !x.Pipe.Equal(y.Pipe) &&
+		!(x.Pipe == nil && y.Pipe.Equal(&DomainChardevSourcePipe{})) &&
+		!(y.Pipe == nil && x.Pipe.Equal(&DomainChardevSourcePipe{})) {
+		return false
+	}
+	if !x.StdIO.Equal(y.StdIO) &&
+		!(x.StdIO == nil && y.StdIO.Equal(&DomainChardevSourceStdIO{})) &&
+		!(y.StdIO == nil && x.StdIO.Equal(&DomainChardevSourceStdIO{})) {
+		return false
+	}
+	if !x.UDP.Equal(y.UDP) &&
+		!(x.UDP == nil && y.UDP.Equal(&DomainChardevSourceUDP{})) &&
+		!(y.UDP == nil && x.UDP.Equal(&DomainChardevSourceUDP{})) {
+		return false
+	}
+	if !x.TCP.Equal(y.TCP) &&
+		!(x.TCP == nil && y.TCP.Equal(&DomainChardevSourceTCP{})) &&
+		!(y.TCP == nil && x.TCP.Equal(&DomainChardevSourceTCP{})) {
+		return false
+	}
+	if !x.UNIX.Equal(y.UNIX) &&
+		!(x.UNIX == nil && y.UNIX.Equal(&DomainChardevSourceUNIX{})) &&
+		!(y.UNIX == nil && x.UNIX.Equal(&DomainChardevSourceUNIX{})) {
+		return false
+	}
+	if !x.SpiceVMC.Equal(y.SpiceVMC) &&
+		!(x.SpiceVMC == nil && y.SpiceVMC.Equal(&DomainChardevSourceSpiceVMC{})) &&
+		!(y.SpiceVMC == nil && x.SpiceVMC.Equal(&DomainChardevSourceSpiceVMC{})) {
+		return false
+	}
+	if !x.SpicePort.Equal(y.SpicePort) &&
+		!(x.SpicePort == nil && y.SpicePort.Equal(&DomainChardevSourceSpicePort{})) &&
+		!(y.SpicePort == nil && x.SpicePort.Equal(&DomainChardevSourceSpicePort{})) {
+		return false
+	}
+	if !x.NMDM.Equal(y.NMDM) &&
+		!(x.NMDM == nil && y.NMDM.Equal(&DomainChardevSourceNMDM{})) &&
+		!(y.NMDM == nil && x.NMDM.Equal(&DomainChardevSourceNMDM{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChardevSourceNull) Clone() *DomainChardevSourceNull {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChardevSourceNull) Equal(y *DomainChardevSourceNull) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChardevSourceVC) Clone() *DomainChardevSourceVC {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChardevSourceVC) Equal(y *DomainChardevSourceVC) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChardevSourcePty) Clone() *DomainChardevSourcePty {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.SecLabel != nil {
+		y.SecLabel = make([]DomainDeviceSecLabel, len(x.SecLabel))
+		copy(y.SecLabel, x.SecLabel)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChardevSourcePty) Equal(y *DomainChardevSourcePty) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	if len(x.SecLabel) != len(y.SecLabel) {
+		return false
+	}
+	for i := range x.SecLabel {
+		if !x.SecLabel[i].Equal(&y.SecLabel[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChardevSourceDev) Clone() *DomainChardevSourceDev {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.SecLabel != nil {
+		y.SecLabel = make([]DomainDeviceSecLabel, len(x.SecLabel))
+		copy(y.SecLabel, x.SecLabel)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChardevSourceDev) Equal(y *DomainChardevSourceDev) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	if len(x.SecLabel) != len(y.SecLabel) {
+		return false
+	}
+	for i := range x.SecLabel {
+		if !x.SecLabel[i].Equal(&y.SecLabel[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChardevSourceFile) Clone() *DomainChardevSourceFile {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.SecLabel != nil {
+		y.SecLabel = make([]DomainDeviceSecLabel, len(x.SecLabel))
+		copy(y.SecLabel, x.SecLabel)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChardevSourceFile) Equal(y *DomainChardevSourceFile) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	if x.Append != y.Append {
+		return false
+	}
+	if len(x.SecLabel) != len(y.SecLabel) {
+		return false
+	}
+	for i := range x.SecLabel {
+		if !x.SecLabel[i].Equal(&y.SecLabel[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChardevSourcePipe) Clone() *DomainChardevSourcePipe {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.SecLabel != nil {
+		y.SecLabel = make([]DomainDeviceSecLabel, len(x.SecLabel))
+		copy(y.SecLabel, x.SecLabel)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChardevSourcePipe) Equal(y *DomainChardevSourcePipe) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	if len(x.SecLabel) != len(y.SecLabel) {
+		return false
+	}
+	for i := range x.SecLabel {
+		if !x.SecLabel[i].Equal(&y.SecLabel[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChardevSourceStdIO) Clone() *DomainChardevSourceStdIO {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChardevSourceStdIO) Equal(y *DomainChardevSourceStdIO) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChardevSourceUDP) Clone() *DomainChardevSourceUDP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChardevSourceUDP) Equal(y *DomainChardevSourceUDP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.BindHost != y.BindHost {
+		return false
+	}
+	if x.BindService != y.BindService {
+		return false
+	}
+	if x.ConnectHost != y.ConnectHost {
+		return false
+	}
+	if x.ConnectService != y.ConnectService {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChardevSourceReconnect) Clone() *DomainChardevSourceReconnect {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Timeout != nil {
+		v := *x.Timeout
+		y.Timeout = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChardevSourceReconnect) Equal(y *DomainChardevSourceReconnect) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Enabled != y.Enabled {
+		return false
+	}
+	if (x.Timeout == nil) != (y.Timeout == nil) {
+		return false
+	}
+	if x.Timeout != nil {
+		if (*x.Timeout) != (*y.Timeout) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChardevSourceTCP) Clone() *DomainChardevSourceTCP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Reconnect = x.Reconnect.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChardevSourceTCP) Equal(y *DomainChardevSourceTCP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	if x.Host != y.Host {
+		return false
+	}
+	if x.Service != y.Service {
+		return false
+	}
+	if x.TLS != y.TLS {
+		return false
+	}
+	if !x.Reconnect.Equal(y.Reconnect) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChardevSourceUNIX) Clone() *DomainChardevSourceUNIX {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Reconnect = x.Reconnect.Clone()
+	if x.SecLabel != nil {
+		y.SecLabel = make([]DomainDeviceSecLabel, len(x.SecLabel))
+		copy(y.SecLabel, x.SecLabel)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChardevSourceUNIX) Equal(y *DomainChardevSourceUNIX) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	if !x.Reconnect.Equal(y.Reconnect) {
+		return false
+	}
+	if len(x.SecLabel) != len(y.SecLabel) {
+		return false
+	}
+	for i := range x.SecLabel {
+		if !x.SecLabel[i].Equal(&y.SecLabel[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChardevSourceSpiceVMC) Clone() *DomainChardevSourceSpiceVMC {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChardevSourceSpiceVMC) Equal(y *DomainChardevSourceSpiceVMC) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChardevSourceSpicePort) Clone() *DomainChardevSourceSpicePort {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChardevSourceSpicePort) Equal(y *DomainChardevSourceSpicePort) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Channel != y.Channel {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChardevSourceNMDM) Clone() *DomainChardevSourceNMDM {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChardevSourceNMDM) Equal(y *DomainChardevSourceNMDM) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Master != y.Master {
+		return false
+	}
+	if x.Slave != y.Slave {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainConsoleTarget) Clone() *DomainConsoleTarget {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Port != nil {
+		v := *x.Port
+		y.Port = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainConsoleTarget) Equal(y *DomainConsoleTarget) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if (x.Port == nil) != (y.Port == nil) {
+		return false
+	}
+	if x.Port != nil {
+		if (*x.Port) != (*y.Port) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSerialTarget) Clone() *DomainSerialTarget {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Port != nil {
+		v := *x.Port
+		y.Port = &v
+	}
+	y.Model = x.Model.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSerialTarget) Equal(y *DomainSerialTarget) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if (x.Port == nil) != (y.Port == nil) {
+		return false
+	}
+	if x.Port != nil {
+		if (*x.Port) != (*y.Port) {
+			return false
+		}
+	}
+	if !x.Model.Equal(y.Model) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSerialTargetModel) Clone() *DomainSerialTargetModel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSerialTargetModel) Equal(y *DomainSerialTargetModel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainParallelTarget) Clone() *DomainParallelTarget {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Port != nil {
+		v := *x.Port
+		y.Port = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainParallelTarget) Equal(y *DomainParallelTarget) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if (x.Port == nil) != (y.Port == nil) {
+		return false
+	}
+	if x.Port != nil {
+		if (*x.Port) != (*y.Port) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChannelTarget) Clone() *DomainChannelTarget {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.VirtIO = x.VirtIO.Clone()
+	y.Xen = x.Xen.Clone()
+	y.GuestFWD = x.GuestFWD.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChannelTarget) Equal(y *DomainChannelTarget) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.VirtIO.Equal(y.VirtIO) &&
+		!(x.VirtIO == nil && y.VirtIO.Equal(&DomainChannelTargetVirtIO{})) &&
+		!(y.VirtIO == nil && x.VirtIO.Equal(&DomainChannelTargetVirtIO{})) {
+		return false
+	}
+	if !x.Xen.Equal(y.Xen) &&
+		!(x.Xen == nil && y.Xen.Equal(&DomainChannelTargetXen{})) &&
+		!(y.Xen == nil && x.Xen.Equal(&DomainChannelTargetXen{})) {
+		return false
+	}
+	if !x.GuestFWD.Equal(y.GuestFWD) &&
+		!(x.GuestFWD == nil && y.GuestFWD.Equal(&DomainChannelTargetGuestFWD{})) &&
+		!(y.GuestFWD == nil && x.GuestFWD.Equal(&DomainChannelTargetGuestFWD{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChannelTargetVirtIO) Clone() *DomainChannelTargetVirtIO {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChannelTargetVirtIO) Equal(y *DomainChannelTargetVirtIO) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.State != y.State {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChannelTargetXen) Clone() *DomainChannelTargetXen {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChannelTargetXen) Equal(y *DomainChannelTargetXen) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.State != y.State {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChannelTargetGuestFWD) Clone() *DomainChannelTargetGuestFWD {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChannelTargetGuestFWD) Equal(y *DomainChannelTargetGuestFWD) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	if x.Port != y.Port {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAlias) Clone() *DomainAlias {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAlias) Equal(y *DomainAlias) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDeviceACPI) Clone() *DomainDeviceACPI {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDeviceACPI) Equal(y *DomainDeviceACPI) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Index != y.Index {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAddressPCI) Clone() *DomainAddressPCI {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Domain != nil {
+		v := *x.Domain
+		y.Domain = &v
+	}
+	if x.Bus != nil {
+		v := *x.Bus
+		y.Bus = &v
+	}
+	if x.Slot != nil {
+		v := *x.Slot
+		y.Slot = &v
+	}
+	if x.Function != nil {
+		v := *x.Function
+		y.Function = &v
+	}
+	y.ZPCI = x.ZPCI.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAddressPCI) Equal(y *DomainAddressPCI) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.Domain == nil) != (y.Domain == nil) {
+		return false
+	}
+	if x.Domain != nil {
+		if (*x.Domain) != (*y.Domain) {
+			return false
+		}
+	}
+	if (x.Bus == nil) != (y.Bus == nil) {
+		return false
+	}
+	if x.Bus != nil {
+		if (*x.Bus) != (*y.Bus) {
+			return false
+		}
+	}
+	if (x.Slot == nil) != (y.Slot == nil) {
+		return false
+	}
+	if x.Slot != nil {
+		if (*x.Slot) != (*y.Slot) {
+			return false
+		}
+	}
+	if (x.Function == nil) != (y.Function == nil) {
+		return false
+	}
+	if x.Function != nil {
+		if (*x.Function) != (*y.Function) {
+			return false
+		}
+	}
+	if x.MultiFunction != y.MultiFunction {
+		return false
+	}
+	if !x.ZPCI.Equal(y.ZPCI) &&
+		!(x.ZPCI == nil && y.ZPCI.Equal(&DomainAddressZPCI{})) &&
+		!(y.ZPCI == nil && x.ZPCI.Equal(&DomainAddressZPCI{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAddressZPCI) Clone() *DomainAddressZPCI {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.UID != nil {
+		v := *x.UID
+		y.UID = &v
+	}
+	if x.FID != nil {
+		v := *x.FID
+		y.FID = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAddressZPCI) Equal(y *DomainAddressZPCI) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.UID == nil) != (y.UID == nil) {
+		return false
+	}
+	if x.UID != nil {
+		if (*x.UID) != (*y.UID) {
+			return false
+		}
+	}
+	if (x.FID == nil) != (y.FID == nil) {
+		return false
+	}
+	if x.FID != nil {
+		if (*x.FID) != (*y.FID) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAddressUSB) Clone() *DomainAddressUSB {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Bus != nil {
+		v := *x.Bus
+		y.Bus = &v
+	}
+	if x.Device != nil {
+		v := *x.Device
+		y.Device = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAddressUSB) Equal(y *DomainAddressUSB) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.Bus == nil) != (y.Bus == nil) {
+		return false
+	}
+	if x.Bus != nil {
+		if (*x.Bus) != (*y.Bus) {
+			return false
+		}
+	}
+	if x.Port != y.Port {
+		return false
+	}
+	if (x.Device == nil) != (y.Device == nil) {
+		return false
+	}
+	if x.Device != nil {
+		if (*x.Device) != (*y.Device) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAddressDrive) Clone() *DomainAddressDrive {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Controller != nil {
+		v := *x.Controller
+		y.Controller = &v
+	}
+	if x.Bus != nil {
+		v := *x.Bus
+		y.Bus = &v
+	}
+	if x.Target != nil {
+		v := *x.Target
+		y.Target = &v
+	}
+	if x.Unit != nil {
+		v := *x.Unit
+		y.Unit = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAddressDrive) Equal(y *DomainAddressDrive) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.Controller == nil) != (y.Controller == nil) {
+		return false
+	}
+	if x.Controller != nil {
+		if (*x.Controller) != (*y.Controller) {
+			return false
+		}
+	}
+	if (x.Bus == nil) != (y.Bus == nil) {
+		return false
+	}
+	if x.Bus != nil {
+		if (*x.Bus) != (*y.Bus) {
+			return false
+		}
+	}
+	if (x.Target == nil) != (y.Target == nil) {
+		return false
+	}
+	if x.Target != nil {
+		if (*x.Target) != (*y.Target) {
+			return false
+		}
+	}
+	if (x.Unit == nil) != (y.Unit == nil) {
+		return false
+	}
+	if x.Unit != nil {
+		if (*x.Unit) != (*y.Unit) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAddressDIMM) Clone() *DomainAddressDIMM {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Slot != nil {
+		v := *x.Slot
+		y.Slot = &v
+	}
+	if x.Base != nil {
+		v := *x.Base
+		y.Base = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAddressDIMM) Equal(y *DomainAddressDIMM) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.Slot == nil) != (y.Slot == nil) {
+		return false
+	}
+	if x.Slot != nil {
+		if (*x.Slot) != (*y.Slot) {
+			return false
+		}
+	}
+	if (x.Base == nil) != (y.Base == nil) {
+		return false
+	}
+	if x.Base != nil {
+		if (*x.Base) != (*y.Base) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAddressISA) Clone() *DomainAddressISA {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.IOBase != nil {
+		v := *x.IOBase
+		y.IOBase = &v
+	}
+	if x.IRQ != nil {
+		v := *x.IRQ
+		y.IRQ = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAddressISA) Equal(y *DomainAddressISA) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.IOBase == nil) != (y.IOBase == nil) {
+		return false
+	}
+	if x.IOBase != nil {
+		if (*x.IOBase) != (*y.IOBase) {
+			return false
+		}
+	}
+	if (x.IRQ == nil) != (y.IRQ == nil) {
+		return false
+	}
+	if x.IRQ != nil {
+		if (*x.IRQ) != (*y.IRQ) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAddressVirtioMMIO) Clone() *DomainAddressVirtioMMIO {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAddressVirtioMMIO) Equal(y *DomainAddressVirtioMMIO) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAddressCCW) Clone() *DomainAddressCCW {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.CSSID != nil {
+		v := *x.CSSID
+		y.CSSID = &v
+	}
+	if x.SSID != nil {
+		v := *x.SSID
+		y.SSID = &v
+	}
+	if x.DevNo != nil {
+		v := *x.DevNo
+		y.DevNo = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAddressCCW) Equal(y *DomainAddressCCW) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.CSSID == nil) != (y.CSSID == nil) {
+		return false
+	}
+	if x.CSSID != nil {
+		if (*x.CSSID) != (*y.CSSID) {
+			return false
+		}
+	}
+	if (x.SSID == nil) != (y.SSID == nil) {
+		return false
+	}
+	if x.SSID != nil {
+		if (*x.SSID) != (*y.SSID) {
+			return false
+		}
+	}
+	if (x.DevNo == nil) != (y.DevNo == nil) {
+		return false
+	}
+	if x.DevNo != nil {
+		if (*x.DevNo) != (*y.DevNo) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAddressVirtioSerial) Clone() *DomainAddressVirtioSerial {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Controller != nil {
+		v := *x.Controller
+		y.Controller = &v
+	}
+	if x.Bus != nil {
+		v := *x.Bus
+		y.Bus = &v
+	}
+	if x.Port != nil {
+		v := *x.Port
+		y.Port = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAddressVirtioSerial) Equal(y *DomainAddressVirtioSerial) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.Controller == nil) != (y.Controller == nil) {
+		return false
+	}
+	if x.Controller != nil {
+		if (*x.Controller) != (*y.Controller) {
+			return false
+		}
+	}
+	if (x.Bus == nil) != (y.Bus == nil) {
+		return false
+	}
+	if x.Bus != nil {
+		if (*x.Bus) != (*y.Bus) {
+			return false
+		}
+	}
+	if (x.Port == nil) != (y.Port == nil) {
+		return false
+	}
+	if x.Port != nil {
+		if (*x.Port) != (*y.Port) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAddressSpaprVIO) Clone() *DomainAddressSpaprVIO {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Reg != nil {
+		v := *x.Reg
+		y.Reg = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAddressSpaprVIO) Equal(y *DomainAddressSpaprVIO) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.Reg == nil) != (y.Reg == nil) {
+		return false
+	}
+	if x.Reg != nil {
+		if (*x.Reg) != (*y.Reg) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAddressCCID) Clone() *DomainAddressCCID {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Controller != nil {
+		v := *x.Controller
+		y.Controller = &v
+	}
+	if x.Slot != nil {
+		v := *x.Slot
+		y.Slot = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAddressCCID) Equal(y *DomainAddressCCID) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.Controller == nil) != (y.Controller == nil) {
+		return false
+	}
+	if x.Controller != nil {
+		if (*x.Controller) != (*y.Controller) {
+			return false
+		}
+	}
+	if (x.Slot == nil) != (y.Slot == nil) {
+		return false
+	}
+	if x.Slot != nil {
+		if (*x.Slot) != (*y.Slot) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAddressVirtioS390) Clone() *DomainAddressVirtioS390 {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAddressVirtioS390) Equal(y *DomainAddressVirtioS390) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAddressUnassigned) Clone() *DomainAddressUnassigned {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAddressUnassigned) Equal(y *DomainAddressUnassigned) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAddress) Clone() *DomainAddress {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.PCI = x.PCI.Clone()
+	y.Drive = x.Drive.Clone()
+	y.VirtioSerial = x.VirtioSerial.Clone()
+	y.CCID = x.CCID.Clone()
+	y.USB = x.USB.Clone()
+	y.SpaprVIO = x.SpaprVIO.Clone()
+	y.VirtioS390 = x.VirtioS390.Clone()
+	y.CCW = x.CCW.Clone()
+	y.VirtioMMIO = x.VirtioMMIO.Clone()
+	y.ISA = x.ISA.Clone()
+	y.DIMM = x.DIMM.Clone()
+	y.Unassigned = x.Unassigned.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAddress) Equal(y *DomainAddress) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.PCI.Equal(y.PCI) &&
+		!(x.PCI == nil && y.PCI.Equal(&DomainAddressPCI{})) &&
+		!(y.PCI == nil && x.PCI.Equal(&DomainAddressPCI{})) {
+		return false
+	}
+	if !x.Drive.Equal(y.Drive) &&
+		!(x.Drive == nil && y.Drive.Equal(&DomainAddressDrive{})) &&
+		!(y.Drive == nil && x.Drive.Equal(&DomainAddressDrive{})) {
+		return false
+	}
+	if !x.VirtioSerial.Equal(y.VirtioSerial) &&
+		!(x.VirtioSerial == nil && y.VirtioSerial.Equal(&DomainAddressVirtioSerial{})) &&
+		!(y.VirtioSerial == nil && x.VirtioSerial.Equal(&DomainAddressVirtioSerial{})) {
+		return false
+	}
+	if !x.CCID.Equal(y.CCID) &&
+		!(x.CCID == nil && y.CCID.Equal(&DomainAddressCCID{})) &&
+		!(y.CCID == nil && x.CCID.Equal(&DomainAddressCCID{})) {
+		return false
+	}
+	if !x.USB.Equal(y.USB) &&
+		!(x.USB == nil && y.USB.Equal(&DomainAddressUSB{})) &&
+		!(y.USB == nil && x.USB.Equal(&DomainAddressUSB{})) {
+		return false
+	}
+	if !x.SpaprVIO.Equal(y.SpaprVIO) &&
+		!(x.SpaprVIO == nil && y.SpaprVIO.Equal(&DomainAddressSpaprVIO{})) &&
+		!(y.SpaprVIO == nil && x.SpaprVIO.Equal(&DomainAddressSpaprVIO{})) {
+		return false
+	}
+	if !x.VirtioS390.Equal(y.VirtioS390) &&
+		!(x.VirtioS390 == nil && y.VirtioS390.Equal(&DomainAddressVirtioS390{})) &&
+		!(y.VirtioS390 == nil && x.VirtioS390.Equal(&DomainAddressVirtioS390{})) {
+		return false
+	}
+	if !x.CCW.Equal(y.CCW) &&
+		!(x.CCW == nil && y.CCW.Equal(&DomainAddressCCW{})) &&
+		!(y.CCW == nil && x.CCW.Equal(&DomainAddressCCW{})) {
+		return false
+	}
+	if !x.VirtioMMIO.Equal(y.VirtioMMIO) &&
+		!(x.VirtioMMIO == nil && y.VirtioMMIO.Equal(&DomainAddressVirtioMMIO{})) &&
+		!(y.VirtioMMIO == nil && x.VirtioMMIO.Equal(&DomainAddressVirtioMMIO{})) {
+		return false
+	}
+	if !x.ISA.Equal(y.ISA) &&
+		!(x.ISA == nil && y.ISA.Equal(&DomainAddressISA{})) &&
+		!(y.ISA == nil && x.ISA.Equal(&DomainAddressISA{})) {
+		return false
+	}
+	if !x.DIMM.Equal(y.DIMM) &&
+		!(x.DIMM == nil && y.DIMM.Equal(&DomainAddressDIMM{})) &&
+		!(y.DIMM == nil && x.DIMM.Equal(&DomainAddressDIMM{})) {
+		return false
+	}
+	if !x.Unassigned.Equal(y.Unassigned) &&
+		!(x.Unassigned == nil && y.Unassigned.Equal(&DomainAddressUnassigned{})) &&
+		!(y.Unassigned == nil && x.Unassigned.Equal(&DomainAddressUnassigned{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChardevLog) Clone() *DomainChardevLog {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChardevLog) Equal(y *DomainChardevLog) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.File != y.File {
+		return false
+	}
+	if x.Append != y.Append {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainConsole) Clone() *DomainConsole {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Source = x.Source.Clone()
+	y.Protocol = x.Protocol.Clone()
+	y.Target = x.Target.Clone()
+	y.Log = x.Log.Clone()
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainConsole) Equal(y *DomainConsole) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.TTY != y.TTY {
+		return false
+	}
+	if !x.Source.Equal(y.Source) &&
+		!(x.Source == nil && y.Source.Equal(&DomainChardevSource{})) &&
+		!(y.Source == nil && x.Source.Equal(&DomainChardevSource{})) {
+		return false
+	}
+	if !x.Protocol.Equal(y.Protocol) {
+		return false
+	}
+	if !x.Target.Equal(y.Target) {
+		return false
+	}
+	if !x.Log.Equal(y.Log) {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSerial) Clone() *DomainSerial {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Source = x.Source.Clone()
+	y.Protocol = x.Protocol.Clone()
+	y.Target = x.Target.Clone()
+	y.Log = x.Log.Clone()
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSerial) Equal(y *DomainSerial) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Source.Equal(y.Source) &&
+		!(x.Source == nil && y.Source.Equal(&DomainChardevSource{})) &&
+		!(y.Source == nil && x.Source.Equal(&DomainChardevSource{})) {
+		return false
+	}
+	if !x.Protocol.Equal(y.Protocol) {
+		return false
+	}
+	if !x.Target.Equal(y.Target) {
+		return false
+	}
+	if !x.Log.Equal(y.Log) {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainParallel) Clone() *DomainParallel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Source = x.Source.Clone()
+	y.Protocol = x.Protocol.Clone()
+	y.Target = x.Target.Clone()
+	y.Log = x.Log.Clone()
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainParallel) Equal(y *DomainParallel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Source.Equal(y.Source) &&
+		!(x.Source == nil && y.Source.Equal(&DomainChardevSource{})) &&
+		!(y.Source == nil && x.Source.Equal(&DomainChardevSource{})) {
+		return false
+	}
+	if !x.Protocol.Equal(y.Protocol) {
+		return false
+	}
+	if !x.Target.Equal(y.Target) {
+		return false
+	}
+	if !x.Log.Equal(y.Log) {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChardevProtocol) Clone() *DomainChardevProtocol {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChardevProtocol) Equal(y *DomainChardevProtocol) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainChannel) Clone() *DomainChannel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Source = x.Source.Clone()
+	y.Protocol = x.Protocol.Clone()
+	y.Target = x.Target.Clone()
+	y.Log = x.Log.Clone()
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainChannel) Equal(y *DomainChannel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Source.Equal(y.Source) &&
+		!(x.Source == nil && y.Source.Equal(&DomainChardevSource{})) &&
+		!(y.Source == nil && x.Source.Equal(&DomainChardevSource{})) {
+		return false
+	}
+	if !x.Protocol.Equal(y.Protocol) {
+		return false
+	}
+	if !x.Target.Equal(y.Target) &&
+		!(x.Target == nil && y.Target.Equal(&DomainChannelTarget{})) &&
+		!(y.Target == nil && x.Target.Equal(&DomainChannelTarget{})) {
+		return false
+	}
+	if !x.Log.Equal(y.Log) {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainRedirDev) Clone() *DomainRedirDev {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Source = x.Source.Clone()
+	y.Protocol = x.Protocol.Clone()
+	y.Boot = x.Boot.Clone()
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainRedirDev) Equal(y *DomainRedirDev) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Bus != y.Bus {
+		return false
+	}
+	if !x.Source.Equal(y.Source) &&
+		!(x.Source == nil && y.Source.Equal(&DomainChardevSource{})) &&
+		!(y.Source == nil && x.Source.Equal(&DomainChardevSource{})) {
+		return false
+	}
+	if !x.Protocol.Equal(y.Protocol) {
+		return false
+	}
+	if !x.Boot.Equal(y.Boot) {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainRedirFilter) Clone() *DomainRedirFilter {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.USB != nil {
+		y.USB = make([]DomainRedirFilterUSB, len(x.USB))
+		copy(y.USB, x.USB)
+		for i := range x.USB {
+			y.USB[i] = *x.USB[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainRedirFilter) Equal(y *DomainRedirFilter) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.USB) != len(y.USB) {
+		return false
+	}
+	for i := range x.USB {
+		if !x.USB[i].Equal(&y.USB[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainRedirFilterUSB) Clone() *DomainRedirFilterUSB {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Class != nil {
+		v := *x.Class
+		y.Class = &v
+	}
+	if x.Vendor != nil {
+		v := *x.Vendor
+		y.Vendor = &v
+	}
+	if x.Product != nil {
+		v := *x.Product
+		y.Product = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainRedirFilterUSB) Equal(y *DomainRedirFilterUSB) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.Class == nil) != (y.Class == nil) {
+		return false
+	}
+	if x.Class != nil {
+		if (*x.Class) != (*y.Class) {
+			return false
+		}
+	}
+	if (x.Vendor == nil) != (y.Vendor == nil) {
+		return false
+	}
+	if x.Vendor != nil {
+		if (*x.Vendor) != (*y.Vendor) {
+			return false
+		}
+	}
+	if (x.Product == nil) != (y.Product == nil) {
+		return false
+	}
+	if x.Product != nil {
+		if (*x.Product) != (*y.Product) {
+			return false
+		}
+	}
+	if x.Version != y.Version {
+		return false
+	}
+	if x.Allow != y.Allow {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInput) Clone() *DomainInput {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Driver = x.Driver.Clone()
+	y.Source = x.Source.Clone()
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInput) Equal(y *DomainInput) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Bus != y.Bus {
+		return false
+	}
+	if x.Model != y.Model {
+		return false
+	}
+	if !x.Driver.Equal(y.Driver) {
+		return false
+	}
+	if !x.Source.Equal(y.Source) &&
+		!(x.Source == nil && y.Source.Equal(&DomainInputSource{})) &&
+		!(y.Source == nil && x.Source.Equal(&DomainInputSource{})) {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInputDriver) Clone() *DomainInputDriver {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInputDriver) Equal(y *DomainInputDriver) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.IOMMU != y.IOMMU {
+		return false
+	}
+	if x.ATS != y.ATS {
+		return false
+	}
+	if x.Packed != y.Packed {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInputSource) Clone() *DomainInputSource {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Passthrough = x.Passthrough.Clone()
+	y.EVDev = x.EVDev.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInputSource) Equal(y *DomainInputSource) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Passthrough.Equal(y.Passthrough) &&
+		!(x.Passthrough == nil && y.Passthrough.Equal(&DomainInputSourcePassthrough{})) &&
+		!(y.Passthrough == nil && x.Passthrough.Equal(&DomainInputSourcePassthrough{})) {
+		return false
+	}
+	if !x.EVDev.Equal(y.EVDev) &&
+		!(x.EVDev == nil && y.EVDev.Equal(&DomainInputSourceEVDev{})) &&
+		!(y.EVDev == nil && x.EVDev.Equal(&DomainInputSourceEVDev{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInputSourcePassthrough) Clone() *DomainInputSourcePassthrough {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInputSourcePassthrough) Equal(y *DomainInputSourcePassthrough) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.EVDev != y.EVDev {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainInputSourceEVDev) Clone() *DomainInputSourceEVDev {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainInputSourceEVDev) Equal(y *DomainInputSourceEVDev) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Dev != y.Dev {
+		return false
+	}
+	if x.Grab != y.Grab {
+		return false
+	}
+	if x.Repeat != y.Repeat {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicListenerAddress) Clone() *DomainGraphicListenerAddress {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicListenerAddress) Equal(y *DomainGraphicListenerAddress) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicListenerNetwork) Clone() *DomainGraphicListenerNetwork {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicListenerNetwork) Equal(y *DomainGraphicListenerNetwork) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	if x.Network != y.Network {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicListenerSocket) Clone() *DomainGraphicListenerSocket {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicListenerSocket) Equal(y *DomainGraphicListenerSocket) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Socket != y.Socket {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicListener) Clone() *DomainGraphicListener {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Address = x.Address.Clone()
+	y.Network = x.Network.Clone()
+	y.Socket = x.Socket.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicListener) Equal(y *DomainGraphicListener) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainGraphicListenerAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainGraphicListenerAddress{})) {
+		return false
+	}
+	if !x.Network.Equal(y.Network) &&
+		!(x.Network == nil && y.Network.Equal(&DomainGraphicListenerNetwork{})) &&
+		!(y.Network == nil && x.Network.Equal(&DomainGraphicListenerNetwork{})) {
+		return false
+	}
+	if !x.Socket.Equal(y.Socket) &&
+		!(x.Socket == nil && y.Socket.Equal(&DomainGraphicListenerSocket{})) &&
+		!(y.Socket == nil && x.Socket.Equal(&DomainGraphicListenerSocket{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicsSDLGL) Clone() *DomainGraphicsSDLGL {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicsSDLGL) Equal(y *DomainGraphicsSDLGL) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Enable != y.Enable {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicSDL) Clone() *DomainGraphicSDL {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.GL = x.GL.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicSDL) Equal(y *DomainGraphicSDL) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Display != y.Display {
+		return false
+	}
+	if x.XAuth != y.XAuth {
+		return false
+	}
+	if x.FullScreen != y.FullScreen {
+		return false
+	}
+	if !x.GL.Equal(y.GL) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicVNC) Clone() *DomainGraphicVNC {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Listeners != nil {
+		y.Listeners = make([]DomainGraphicListener, len(x.Listeners))
+		copy(y.Listeners, x.Listeners)
+		for i := range x.Listeners {
+			y.Listeners[i] = *x.Listeners[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicVNC) Equal(y *DomainGraphicVNC) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Socket != y.Socket {
+		return false
+	}
+	if x.Port != y.Port {
+		return false
+	}
+	if x.AutoPort != y.AutoPort {
+		return false
+	}
+	if x.WebSocket != y.WebSocket {
+		return false
+	}
+	if x.Keymap != y.Keymap {
+		return false
+	}
+	if x.SharePolicy != y.SharePolicy {
+		return false
+	}
+	if x.Passwd != y.Passwd {
+		return false
+	}
+	if x.PasswdValidTo != y.PasswdValidTo {
+		return false
+	}
+	if x.Connected != y.Connected {
+		return false
+	}
+	if x.PowerControl != y.PowerControl {
+		return false
+	}
+	if x.Listen != y.Listen {
+		return false
+	}
+	if len(x.Listeners) != len(y.Listeners) {
+		return false
+	}
+	for i := range x.Listeners {
+		if !x.Listeners[i].Equal(&y.Listeners[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicRDP) Clone() *DomainGraphicRDP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Listeners != nil {
+		y.Listeners = make([]DomainGraphicListener, len(x.Listeners))
+		copy(y.Listeners, x.Listeners)
+		for i := range x.Listeners {
+			y.Listeners[i] = *x.Listeners[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicRDP) Equal(y *DomainGraphicRDP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Port != y.Port {
+		return false
+	}
+	if x.AutoPort != y.AutoPort {
+		return false
+	}
+	if x.ReplaceUser != y.ReplaceUser {
+		return false
+	}
+	if x.MultiUser != y.MultiUser {
+		return false
+	}
+	if x.Listen != y.Listen {
+		return false
+	}
+	if len(x.Listeners) != len(y.Listeners) {
+		return false
+	}
+	for i := range x.Listeners {
+		if !x.Listeners[i].Equal(&y.Listeners[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicDesktop) Clone() *DomainGraphicDesktop {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicDesktop) Equal(y *DomainGraphicDesktop) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Display != y.Display {
+		return false
+	}
+	if x.FullScreen != y.FullScreen {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicSpiceChannel) Clone() *DomainGraphicSpiceChannel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicSpiceChannel) Equal(y *DomainGraphicSpiceChannel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicSpiceImage) Clone() *DomainGraphicSpiceImage {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicSpiceImage) Equal(y *DomainGraphicSpiceImage) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Compression != y.Compression {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicSpiceJPEG) Clone() *DomainGraphicSpiceJPEG {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicSpiceJPEG) Equal(y *DomainGraphicSpiceJPEG) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Compression != y.Compression {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicSpiceZLib) Clone() *DomainGraphicSpiceZLib {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicSpiceZLib) Equal(y *DomainGraphicSpiceZLib) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Compression != y.Compression {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicSpicePlayback) Clone() *DomainGraphicSpicePlayback {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicSpicePlayback) Equal(y *DomainGraphicSpicePlayback) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Compression != y.Compression {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicSpiceStreaming) Clone() *DomainGraphicSpiceStreaming {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicSpiceStreaming) Equal(y *DomainGraphicSpiceStreaming) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicSpiceMouse) Clone() *DomainGraphicSpiceMouse {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicSpiceMouse) Equal(y *DomainGraphicSpiceMouse) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicSpiceClipBoard) Clone() *DomainGraphicSpiceClipBoard {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicSpiceClipBoard) Equal(y *DomainGraphicSpiceClipBoard) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.CopyPaste != y.CopyPaste {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicSpiceFileTransfer) Clone() *DomainGraphicSpiceFileTransfer {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicSpiceFileTransfer) Equal(y *DomainGraphicSpiceFileTransfer) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Enable != y.Enable {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicSpiceGL) Clone() *DomainGraphicSpiceGL {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicSpiceGL) Equal(y *DomainGraphicSpiceGL) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Enable != y.Enable {
+		return false
+	}
+	if x.RenderNode != y.RenderNode {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicSpice) Clone() *DomainGraphicSpice {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Listeners != nil {
+		y.Listeners = make([]DomainGraphicListener, len(x.Listeners))
+		copy(y.Listeners, x.Listeners)
+		for i := range x.Listeners {
+			y.Listeners[i] = *x.Listeners[i].Clone()
+		}
+	}
+	if x.Channel != nil {
+		y.Channel = make([]DomainGraphicSpiceChannel, len(x.Channel))
+		copy(y.Channel, x.Channel)
+	}
+	y.Image = x.Image.Clone()
+	y.JPEG = x.JPEG.Clone()
+	y.ZLib = x.ZLib.Clone()
+	y.Playback = x.Playback.Clone()
+	y.Streaming = x.Streaming.Clone()
+	y.Mouse = x.Mouse.Clone()
+	y.ClipBoard = x.ClipBoard.Clone()
+	y.FileTransfer = x.FileTransfer.Clone()
+	y.GL = x.GL.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicSpice) Equal(y *DomainGraphicSpice) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Port != y.Port {
+		return false
+	}
+	if x.TLSPort != y.TLSPort {
+		return false
+	}
+	if x.AutoPort != y.AutoPort {
+		return false
+	}
+	if x.Listen != y.Listen {
+		return false
+	}
+	if x.Keymap != y.Keymap {
+		return false
+	}
+	if x.DefaultMode != y.DefaultMode {
+		return false
+	}
+	if x.Passwd != y.Passwd {
+		return false
+	}
+	if x.PasswdValidTo != y.PasswdValidTo {
+		return false
+	}
+	if x.Connected != y.Connected {
+		return false
+	}
+	if len(x.Listeners) != len(y.Listeners) {
+		return false
+	}
+	for i := range x.Listeners {
+		if !x.Listeners[i].Equal(&y.Listeners[i]) {
+			return false
+		}
+	}
+	if len(x.Channel) != len(y.Channel) {
+		return false
+	}
+	for i := range x.Channel {
+		if !x.Channel[i].Equal(&y.Channel[i]) {
+			return false
+		}
+	}
+	if !x.Image.Equal(y.Image) {
+		return false
+	}
+	if !x.JPEG.Equal(y.JPEG) {
+		return false
+	}
+	if !x.ZLib.Equal(y.ZLib) {
+		return false
+	}
+	if !x.Playback.Equal(y.Playback) {
+		return false
+	}
+	if !x.Streaming.Equal(y.Streaming) {
+		return false
+	}
+	if !x.Mouse.Equal(y.Mouse) {
+		return false
+	}
+	if !x.ClipBoard.Equal(y.ClipBoard) {
+		return false
+	}
+	if !x.FileTransfer.Equal(y.FileTransfer) {
+		return false
+	}
+	if !x.GL.Equal(y.GL) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicEGLHeadlessGL) Clone() *DomainGraphicEGLHeadlessGL {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicEGLHeadlessGL) Equal(y *DomainGraphicEGLHeadlessGL) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.RenderNode != y.RenderNode {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicEGLHeadless) Clone() *DomainGraphicEGLHeadless {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.GL = x.GL.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicEGLHeadless) Equal(y *DomainGraphicEGLHeadless) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.GL.Equal(y.GL) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphicAudio) Clone() *DomainGraphicAudio {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphicAudio) Equal(y *DomainGraphicAudio) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ID != y.ID {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGraphic) Clone() *DomainGraphic {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.SDL = x.SDL.Clone()
+	y.VNC = x.VNC.Clone()
+	y.RDP = x.RDP.Clone()
+	y.Desktop = x.Desktop.Clone()
+	y.Spice = x.Spice.Clone()
+	y.EGLHeadless = x.EGLHeadless.Clone()
+	y.Audio = x.Audio.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGraphic) Equal(y *DomainGraphic) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.SDL.Equal(y.SDL) &&
+		!(x.SDL == nil && y.SDL.Equal(&DomainGraphicSDL{})) &&
+		!(y.SDL == nil && x.SDL.Equal(&DomainGraphicSDL{})) {
+		return false
+	}
+	if !x.VNC.Equal(y.VNC) &&
+		!(x.VNC == nil && y.VNC.Equal(&DomainGraphicVNC{})) &&
+		!(y.VNC == nil && x.VNC.Equal(&DomainGraphicVNC{})) {
+		return false
+	}
+	if !x.RDP.Equal(y.RDP) &&
+		!(x.RDP == nil && y.RDP.Equal(&DomainGraphicRDP{})) &&
+		!(y.RDP == nil && x.RDP.Equal(&DomainGraphicRDP{})) {
+		return false
+	}
+	if !x.Desktop.Equal(y.Desktop) &&
+		!(x.Desktop == nil && y.Desktop.Equal(&DomainGraphicDesktop{})) &&
+		!(y.Desktop == nil && x.Desktop.Equal(&DomainGraphicDesktop{})) {
+		return false
+	}
+	if !x.Spice.Equal(y.Spice) &&
+		!(x.Spice == nil && y.Spice.Equal(&DomainGraphicSpice{})) &&
+		!(y.Spice == nil && x.Spice.Equal(&DomainGraphicSpice{})) {
+		return false
+	}
+	if !x.EGLHeadless.Equal(y.EGLHeadless) &&
+		!(x.EGLHeadless == nil && y.EGLHeadless.Equal(&DomainGraphicEGLHeadless{})) &&
+		!(y.EGLHeadless == nil && x.EGLHeadless.Equal(&DomainGraphicEGLHeadless{})) {
+		return false
+	}
+	if !x.Audio.Equal(y.Audio) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainVideoAccel) Clone() *DomainVideoAccel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainVideoAccel) Equal(y *DomainVideoAccel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Accel3D != y.Accel3D {
+		return false
+	}
+	if x.Accel2D != y.Accel2D {
+		return false
+	}
+	if x.RenderNode != y.RenderNode {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainVideoResolution) Clone() *DomainVideoResolution {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainVideoResolution) Equal(y *DomainVideoResolution) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.X != y.X {
+		return false
+	}
+	if x.Y != y.Y {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainVideoModel) Clone() *DomainVideoModel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Accel = x.Accel.Clone()
+	y.Resolution = x.Resolution.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainVideoModel) Equal(y *DomainVideoModel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Heads != y.Heads {
+		return false
+	}
+	if x.Ram != y.Ram {
+		return false
+	}
+	if x.VRam != y.VRam {
+		return false
+	}
+	if x.VRam64 != y.VRam64 {
+		return false
+	}
+	if x.VGAMem != y.VGAMem {
+		return false
+	}
+	if x.Primary != y.Primary {
+		return false
+	}
+	if !x.Accel.Equal(y.Accel) {
+		return false
+	}
+	if !x.Resolution.Equal(y.Resolution) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainVideo) Clone() *DomainVideo {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Model = *x.Model.Clone()
+	y.Driver = x.Driver.Clone()
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainVideo) Equal(y *DomainVideo) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Model.Equal(&y.Model) {
+		return false
+	}
+	if !x.Driver.Equal(y.Driver) {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainVideoDriver) Clone() *DomainVideoDriver {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainVideoDriver) Equal(y *DomainVideoDriver) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.VGAConf != y.VGAConf {
+		return false
+	}
+	if x.IOMMU != y.IOMMU {
+		return false
+	}
+	if x.ATS != y.ATS {
+		return false
+	}
+	if x.Packed != y.Packed {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemBalloonStats) Clone() *DomainMemBalloonStats {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemBalloonStats) Equal(y *DomainMemBalloonStats) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Period != y.Period {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemBalloon) Clone() *DomainMemBalloon {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Driver = x.Driver.Clone()
+	y.Stats = x.Stats.Clone()
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemBalloon) Equal(y *DomainMemBalloon) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Model != y.Model {
+		return false
+	}
+	if x.AutoDeflate != y.AutoDeflate {
+		return false
+	}
+	if x.FreePageReporting != y.FreePageReporting {
+		return false
+	}
+	if !x.Driver.Equal(y.Driver) {
+		return false
+	}
+	if !x.Stats.Equal(y.Stats) {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainVSockCID) Clone() *DomainVSockCID {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainVSockCID) Equal(y *DomainVSockCID) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Auto != y.Auto {
+		return false
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainVSockDriver) Clone() *DomainVSockDriver {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainVSockDriver) Equal(y *DomainVSockDriver) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.IOMMU != y.IOMMU {
+		return false
+	}
+	if x.ATS != y.ATS {
+		return false
+	}
+	if x.Packed != y.Packed {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainVSock) Clone() *DomainVSock {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.CID = x.CID.Clone()
+	y.Driver = x.Driver.Clone()
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainVSock) Equal(y *DomainVSock) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Model != y.Model {
+		return false
+	}
+	if !x.CID.Equal(y.CID) {
+		return false
+	}
+	if !x.Driver.Equal(y.Driver) {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemBalloonDriver) Clone() *DomainMemBalloonDriver {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemBalloonDriver) Equal(y *DomainMemBalloonDriver) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.IOMMU != y.IOMMU {
+		return false
+	}
+	if x.ATS != y.ATS {
+		return false
+	}
+	if x.Packed != y.Packed {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainPanic) Clone() *DomainPanic {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainPanic) Equal(y *DomainPanic) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Model != y.Model {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSoundCodec) Clone() *DomainSoundCodec {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSoundCodec) Equal(y *DomainSoundCodec) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSound) Clone() *DomainSound {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Codec != nil {
+		y.Codec = make([]DomainSoundCodec, len(x.Codec))
+		copy(y.Codec, x.Codec)
+	}
+	y.Audio = x.Audio.Clone()
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSound) Equal(y *DomainSound) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Model != y.Model {
+		return false
+	}
+	if len(x.Codec) != len(y.Codec) {
+		return false
+	}
+	for i := range x.Codec {
+		if !x.Codec[i].Equal(&y.Codec[i]) {
+			return false
+		}
+	}
+	if !x.Audio.Equal(y.Audio) {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSoundAudio) Clone() *DomainSoundAudio {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSoundAudio) Equal(y *DomainSoundAudio) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ID != y.ID {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAudio) Clone() *DomainAudio {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.None = x.None.Clone()
+	y.ALSA = x.ALSA.Clone()
+	y.CoreAudio = x.CoreAudio.Clone()
+	y.Jack = x.Jack.Clone()
+	y.OSS = x.OSS.Clone()
+	y.PulseAudio = x.PulseAudio.Clone()
+	y.SDL = x.SDL.Clone()
+	y.SPICE = x.SPICE.Clone()
+	y.File = x.File.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAudio) Equal(y *DomainAudio) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ID != y.ID {
+		return false
+	}
+	if !x.None.Equal(y.None) &&
+		!(x.None == nil && y.None.Equal(&DomainAudioNone{})) &&
+		!(y.None == nil && x.None.Equal(&DomainAudioNone{})) {
+		return false
+	}
+	if !x.ALSA.Equal(y.ALSA) &&
+		!(x.ALSA == nil && y.ALSA.Equal(&DomainAudioALSA{})) &&
+		!(y.ALSA == nil && x.ALSA.Equal(&DomainAudioALSA{})) {
+		return false
+	}
+	if !x.CoreAudio.Equal(y.CoreAudio) &&
+		!(x.CoreAudio == nil && y.CoreAudio.Equal(&DomainAudioCoreAudio{})) &&
+		!(y.CoreAudio == nil && x.CoreAudio.Equal(&DomainAudioCoreAudio{})) {
+		return false
+	}
+	if !x.Jack.Equal(y.Jack) &&
+		!(x.Jack == nil && y.Jack.Equal(&DomainAudioJack{})) &&
+		!(y.Jack == nil && x.Jack.Equal(&DomainAudioJack{})) {
+		return false
+	}
+	if !x.OSS.Equal(y.OSS) &&
+		!(x.OSS == nil && y.OSS.Equal(&DomainAudioOSS{})) &&
+		!(y.OSS == nil && x.OSS.Equal(&DomainAudioOSS{})) {
+		return false
+	}
+	if !x.PulseAudio.Equal(y.PulseAudio) &&
+		!(x.PulseAudio == nil && y.PulseAudio.Equal(&DomainAudioPulseAudio{})) &&
+		!(y.PulseAudio == nil && x.PulseAudio.Equal(&DomainAudioPulseAudio{})) {
+		return false
+	}
+	if !x.SDL.Equal(y.SDL) &&
+		!(x.SDL == nil && y.SDL.Equal(&DomainAudioSDL{})) &&
+		!(y.SDL == nil && x.SDL.Equal(&DomainAudioSDL{})) {
+		return false
+	}
+	if !x.SPICE.Equal(y.SPICE) &&
+		!(x.SPICE == nil && y.SPICE.Equal(&DomainAudioSPICE{})) &&
+		!(y.SPICE == nil && x.SPICE.Equal(&DomainAudioSPICE{})) {
+		return false
+	}
+	if !x.File.Equal(y.File) &&
+		!(x.File == nil && y.File.Equal(&DomainAudioFile{})) &&
+		!(y.File == nil && x.File.Equal(&DomainAudioFile{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAudioChannel) Clone() *DomainAudioChannel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Settings = x.Settings.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAudioChannel) Equal(y *DomainAudioChannel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.MixingEngine != y.MixingEngine {
+		return false
+	}
+	if x.FixedSettings != y.FixedSettings {
+		return false
+	}
+	if x.Voices != y.Voices {
+		return false
+	}
+	if !x.Settings.Equal(y.Settings) {
+		return false
+	}
+	if x.BufferLength != y.BufferLength {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAudioChannelSettings) Clone() *DomainAudioChannelSettings {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAudioChannelSettings) Equal(y *DomainAudioChannelSettings) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Frequency != y.Frequency {
+		return false
+	}
+	if x.Channels != y.Channels {
+		return false
+	}
+	if x.Format != y.Format {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAudioNone) Clone() *DomainAudioNone {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Input = x.Input.Clone()
+	y.Output = x.Output.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAudioNone) Equal(y *DomainAudioNone) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Input.Equal(y.Input) {
+		return false
+	}
+	if !x.Output.Equal(y.Output) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAudioNoneChannel) Clone() *DomainAudioNoneChannel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.DomainAudioChannel = *x.DomainAudioChannel.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAudioNoneChannel) Equal(y *DomainAudioNoneChannel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.DomainAudioChannel.Equal(&y.DomainAudioChannel) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAudioALSA) Clone() *DomainAudioALSA {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Input = x.Input.Clone()
+	y.Output = x.Output.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAudioALSA) Equal(y *DomainAudioALSA) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Input.Equal(y.Input) {
+		return false
+	}
+	if !x.Output.Equal(y.Output) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAudioALSAChannel) Clone() *DomainAudioALSAChannel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.DomainAudioChannel = *x.DomainAudioChannel.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAudioALSAChannel) Equal(y *DomainAudioALSAChannel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.DomainAudioChannel.Equal(&y.DomainAudioChannel) {
+		return false
+	}
+	if x.Dev != y.Dev {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAudioCoreAudio) Clone() *DomainAudioCoreAudio {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Input = x.Input.Clone()
+	y.Output = x.Output.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAudioCoreAudio) Equal(y *DomainAudioCoreAudio) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Input.Equal(y.Input) {
+		return false
+	}
+	if !x.Output.Equal(y.Output) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAudioCoreAudioChannel) Clone() *DomainAudioCoreAudioChannel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.DomainAudioChannel = *x.DomainAudioChannel.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAudioCoreAudioChannel) Equal(y *DomainAudioCoreAudioChannel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.DomainAudioChannel.Equal(&y.DomainAudioChannel) {
+		return false
+	}
+	if x.BufferCount != y.BufferCount {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAudioJack) Clone() *DomainAudioJack {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Input = x.Input.Clone()
+	y.Output = x.Output.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAudioJack) Equal(y *DomainAudioJack) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Input.Equal(y.Input) {
+		return false
+	}
+	if !x.Output.Equal(y.Output) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAudioJackChannel) Clone() *DomainAudioJackChannel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.DomainAudioChannel = *x.DomainAudioChannel.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAudioJackChannel) Equal(y *DomainAudioJackChannel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.DomainAudioChannel.Equal(&y.DomainAudioChannel) {
+		return false
+	}
+	if x.ServerName != y.ServerName {
+		return false
+	}
+	if x.ClientName != y.ClientName {
+		return false
+	}
+	if x.ConnectPorts != y.ConnectPorts {
+		return false
+	}
+	if x.ExactName != y.ExactName {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAudioOSS) Clone() *DomainAudioOSS {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.DSPPolicy != nil {
+		v := *x.DSPPolicy
+		y.DSPPolicy = &v
+	}
+	y.Input = x.Input.Clone()
+	y.Output = x.Output.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAudioOSS) Equal(y *DomainAudioOSS) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.TryMMap != y.TryMMap {
+		return false
+	}
+	if x.Exclusive != y.Exclusive {
+		return false
+	}
+	if (x.DSPPolicy == nil) != (y.DSPPolicy == nil) {
+		return false
+	}
+	if x.DSPPolicy != nil {
+		if (*x.DSPPolicy) != (*y.DSPPolicy) {
+			return false
+		}
+	}
+	if !x.Input.Equal(y.Input) {
+		return false
+	}
+	if !x.Output.Equal(y.Output) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAudioOSSChannel) Clone() *DomainAudioOSSChannel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.DomainAudioChannel = *x.DomainAudioChannel.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAudioOSSChannel) Equal(y *DomainAudioOSSChannel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.DomainAudioChannel.Equal(&y.DomainAudioChannel) {
+		return false
+	}
+	if x.Dev != y.Dev {
+		return false
+	}
+	if x.BufferCount != y.BufferCount {
+		return false
+	}
+	if x.TryPoll != y.TryPoll {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAudioPulseAudio) Clone() *DomainAudioPulseAudio {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Input = x.Input.Clone()
+	y.Output = x.Output.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAudioPulseAudio) Equal(y *DomainAudioPulseAudio) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ServerName != y.ServerName {
+		return false
+	}
+	if !x.Input.Equal(y.Input) {
+		return false
+	}
+	if !x.Output.Equal(y.Output) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAudioPulseAudioChannel) Clone() *DomainAudioPulseAudioChannel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.DomainAudioChannel = *x.DomainAudioChannel.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAudioPulseAudioChannel) Equal(y *DomainAudioPulseAudioChannel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.DomainAudioChannel.Equal(&y.DomainAudioChannel) {
+		return false
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.StreamName != y.StreamName {
+		return false
+	}
+	if x.Latency != y.Latency {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAudioSDL) Clone() *DomainAudioSDL {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Input = x.Input.Clone()
+	y.Output = x.Output.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAudioSDL) Equal(y *DomainAudioSDL) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Driver != y.Driver {
+		return false
+	}
+	if !x.Input.Equal(y.Input) {
+		return false
+	}
+	if !x.Output.Equal(y.Output) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAudioSDLChannel) Clone() *DomainAudioSDLChannel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.DomainAudioChannel = *x.DomainAudioChannel.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAudioSDLChannel) Equal(y *DomainAudioSDLChannel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.DomainAudioChannel.Equal(&y.DomainAudioChannel) {
+		return false
+	}
+	if x.BufferCount != y.BufferCount {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAudioSPICE) Clone() *DomainAudioSPICE {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Input = x.Input.Clone()
+	y.Output = x.Output.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAudioSPICE) Equal(y *DomainAudioSPICE) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Input.Equal(y.Input) {
+		return false
+	}
+	if !x.Output.Equal(y.Output) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAudioSPICEChannel) Clone() *DomainAudioSPICEChannel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.DomainAudioChannel = *x.DomainAudioChannel.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAudioSPICEChannel) Equal(y *DomainAudioSPICEChannel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.DomainAudioChannel.Equal(&y.DomainAudioChannel) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAudioFile) Clone() *DomainAudioFile {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Input = x.Input.Clone()
+	y.Output = x.Output.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAudioFile) Equal(y *DomainAudioFile) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	if !x.Input.Equal(y.Input) {
+		return false
+	}
+	if !x.Output.Equal(y.Output) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAudioFileChannel) Clone() *DomainAudioFileChannel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.DomainAudioChannel = *x.DomainAudioChannel.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAudioFileChannel) Equal(y *DomainAudioFileChannel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.DomainAudioChannel.Equal(&y.DomainAudioChannel) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainRNGRate) Clone() *DomainRNGRate {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainRNGRate) Equal(y *DomainRNGRate) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Bytes != y.Bytes {
+		return false
+	}
+	if x.Period != y.Period {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainRNGBackend) Clone() *DomainRNGBackend {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Random = x.Random.Clone()
+	y.EGD = x.EGD.Clone()
+	y.BuiltIn = x.BuiltIn.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainRNGBackend) Equal(y *DomainRNGBackend) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Random.Equal(y.Random) &&
+		!(x.Random == nil && y.Random.Equal(&DomainRNGBackendRandom{})) &&
+		!(y.Random == nil && x.Random.Equal(&DomainRNGBackendRandom{})) {
+		return false
+	}
+	if !x.EGD.Equal(y.EGD) &&
+		!(x.EGD == nil && y.EGD.Equal(&DomainRNGBackendEGD{})) &&
+		!(y.EGD == nil && x.EGD.Equal(&DomainRNGBackendEGD{})) {
+		return false
+	}
+	if !x.BuiltIn.Equal(y.BuiltIn) &&
+		!(x.BuiltIn == nil && y.BuiltIn.Equal(&DomainRNGBackendBuiltIn{})) &&
+		!(y.BuiltIn == nil && x.BuiltIn.Equal(&DomainRNGBackendBuiltIn{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainRNGBackendEGD) Clone() *DomainRNGBackendEGD {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Source = x.Source.Clone()
+	y.Protocol = x.Protocol.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainRNGBackendEGD) Equal(y *DomainRNGBackendEGD) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Source.Equal(y.Source) &&
+		!(x.Source == nil && y.Source.Equal(&DomainChardevSource{})) &&
+		!(y.Source == nil && x.Source.Equal(&DomainChardevSource{})) {
+		return false
+	}
+	if !x.Protocol.Equal(y.Protocol) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainRNGBackendRandom) Clone() *DomainRNGBackendRandom {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainRNGBackendRandom) Equal(y *DomainRNGBackendRandom) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Device != y.Device {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainRNGBackendBuiltIn) Clone() *DomainRNGBackendBuiltIn {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainRNGBackendBuiltIn) Equal(y *DomainRNGBackendBuiltIn) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainRNG) Clone() *DomainRNG {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Driver = x.Driver.Clone()
+	y.Rate = x.Rate.Clone()
+	y.Backend = x.Backend.Clone()
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainRNG) Equal(y *DomainRNG) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Model != y.Model {
+		return false
+	}
+	if !x.Driver.Equal(y.Driver) {
+		return false
+	}
+	if !x.Rate.Equal(y.Rate) {
+		return false
+	}
+	if !x.Backend.Equal(y.Backend) &&
+		!(x.Backend == nil && y.Backend.Equal(&DomainRNGBackend{})) &&
+		!(y.Backend == nil && x.Backend.Equal(&DomainRNGBackend{})) {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainRNGDriver) Clone() *DomainRNGDriver {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainRNGDriver) Equal(y *DomainRNGDriver) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.IOMMU != y.IOMMU {
+		return false
+	}
+	if x.ATS != y.ATS {
+		return false
+	}
+	if x.Packed != y.Packed {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevSubsysUSB) Clone() *DomainHostdevSubsysUSB {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Source = x.Source.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevSubsysUSB) Equal(y *DomainHostdevSubsysUSB) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Source.Equal(y.Source) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevSubsysUSBSource) Clone() *DomainHostdevSubsysUSBSource {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevSubsysUSBSource) Equal(y *DomainHostdevSubsysUSBSource) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddressUSB{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddressUSB{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevSubsysSCSI) Clone() *DomainHostdevSubsysSCSI {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Source = x.Source.Clone()
+	y.ReadOnly = x.ReadOnly.Clone()
+	y.Shareable = x.Shareable.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevSubsysSCSI) Equal(y *DomainHostdevSubsysSCSI) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.SGIO != y.SGIO {
+		return false
+	}
+	if x.RawIO != y.RawIO {
+		return false
+	}
+	if !x.Source.Equal(y.Source) &&
+		!(x.Source == nil && y.Source.Equal(&DomainHostdevSubsysSCSISource{})) &&
+		!(y.Source == nil && x.Source.Equal(&DomainHostdevSubsysSCSISource{})) {
+		return false
+	}
+	if !x.ReadOnly.Equal(y.ReadOnly) {
+		return false
+	}
+	if !x.Shareable.Equal(y.Shareable) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevSubsysSCSISource) Clone() *DomainHostdevSubsysSCSISource {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Host = x.Host.Clone()
+	y.ISCSI = x.ISCSI.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevSubsysSCSISource) Equal(y *DomainHostdevSubsysSCSISource) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Host.Equal(y.Host) &&
+		!(x.Host == nil && y.Host.Equal(&DomainHostdevSubsysSCSISourceHost{})) &&
+		!(y.Host == nil && x.Host.Equal(&DomainHostdevSubsysSCSISourceHost{})) {
+		return false
+	}
+	if !x.ISCSI.Equal(y.ISCSI) &&
+		!(x.ISCSI == nil && y.ISCSI.Equal(&DomainHostdevSubsysSCSISourceISCSI{})) &&
+		!(y.ISCSI == nil && x.ISCSI.Equal(&DomainHostdevSubsysSCSISourceISCSI{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevSubsysSCSIAdapter) Clone() *DomainHostdevSubsysSCSIAdapter {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevSubsysSCSIAdapter) Equal(y *DomainHostdevSubsysSCSIAdapter) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevSubsysSCSISourceHost) Clone() *DomainHostdevSubsysSCSISourceHost {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Adapter = x.Adapter.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevSubsysSCSISourceHost) Equal(y *DomainHostdevSubsysSCSISourceHost) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Adapter.Equal(y.Adapter) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddressDrive{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddressDrive{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevSubsysSCSISourceISCSI) Clone() *DomainHostdevSubsysSCSISourceISCSI {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Host != nil {
+		y.Host = make([]DomainDiskSourceHost, len(x.Host))
+		copy(y.Host, x.Host)
+	}
+	y.Auth = x.Auth.Clone()
+	y.Initiator = x.Initiator.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevSubsysSCSISourceISCSI) Equal(y *DomainHostdevSubsysSCSISourceISCSI) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if len(x.Host) != len(y.Host) {
+		return false
+	}
+	for i := range x.Host {
+		if !x.Host[i].Equal(&y.Host[i]) {
+			return false
+		}
+	}
+	if !x.Auth.Equal(y.Auth) {
+		return false
+	}
+	if !x.Initiator.Equal(y.Initiator) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevSubsysSCSISourceInitiator) Clone() *DomainHostdevSubsysSCSISourceInitiator {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevSubsysSCSISourceInitiator) Equal(y *DomainHostdevSubsysSCSISourceInitiator) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.IQN.Equal(&y.IQN) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevSubsysSCSISourceIQN) Clone() *DomainHostdevSubsysSCSISourceIQN {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevSubsysSCSISourceIQN) Equal(y *DomainHostdevSubsysSCSISourceIQN) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevSubsysSCSIHost) Clone() *DomainHostdevSubsysSCSIHost {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Source = x.Source.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevSubsysSCSIHost) Equal(y *DomainHostdevSubsysSCSIHost) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Model != y.Model {
+		return false
+	}
+	if !x.Source.Equal(y.Source) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevSubsysSCSIHostSource) Clone() *DomainHostdevSubsysSCSIHostSource {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevSubsysSCSIHostSource) Equal(y *DomainHostdevSubsysSCSIHostSource) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Protocol != y.Protocol {
+		return false
+	}
+	if x.WWPN != y.WWPN {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevSubsysPCISource) Clone() *DomainHostdevSubsysPCISource {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevSubsysPCISource) Equal(y *DomainHostdevSubsysPCISource) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.WriteFiltering != y.WriteFiltering {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddressPCI{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddressPCI{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevSubsysPCIDriver) Clone() *DomainHostdevSubsysPCIDriver {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevSubsysPCIDriver) Equal(y *DomainHostdevSubsysPCIDriver) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevSubsysPCI) Clone() *DomainHostdevSubsysPCI {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Driver = x.Driver.Clone()
+	y.Source = x.Source.Clone()
+	y.Teaming = x.Teaming.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevSubsysPCI) Equal(y *DomainHostdevSubsysPCI) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Driver.Equal(y.Driver) {
+		return false
+	}
+	if !x.Source.Equal(y.Source) {
+		return false
+	}
+	if !x.Teaming.Equal(y.Teaming) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainAddressMDev) Clone() *DomainAddressMDev {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainAddressMDev) Equal(y *DomainAddressMDev) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.UUID != y.UUID {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevSubsysMDevSource) Clone() *DomainHostdevSubsysMDevSource {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevSubsysMDevSource) Equal(y *DomainHostdevSubsysMDevSource) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Address.Equal(y.Address) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevSubsysMDev) Clone() *DomainHostdevSubsysMDev {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Source = x.Source.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevSubsysMDev) Equal(y *DomainHostdevSubsysMDev) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Model != y.Model {
+		return false
+	}
+	if x.Display != y.Display {
+		return false
+	}
+	if x.RamFB != y.RamFB {
+		return false
+	}
+	if !x.Source.Equal(y.Source) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevCapsStorage) Clone() *DomainHostdevCapsStorage {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Source = x.Source.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevCapsStorage) Equal(y *DomainHostdevCapsStorage) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Source.Equal(y.Source) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevCapsStorageSource) Clone() *DomainHostdevCapsStorageSource {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevCapsStorageSource) Equal(y *DomainHostdevCapsStorageSource) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Block != y.Block {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevCapsMisc) Clone() *DomainHostdevCapsMisc {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Source = x.Source.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevCapsMisc) Equal(y *DomainHostdevCapsMisc) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Source.Equal(y.Source) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevCapsMiscSource) Clone() *DomainHostdevCapsMiscSource {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevCapsMiscSource) Equal(y *DomainHostdevCapsMiscSource) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Char != y.Char {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainIP) Clone() *DomainIP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Prefix != nil {
+		v := *x.Prefix
+		y.Prefix = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainIP) Equal(y *DomainIP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	if x.Family != y.Family {
+		return false
+	}
+	if (x.Prefix == nil) != (y.Prefix == nil) {
+		return false
+	}
+	if x.Prefix != nil {
+		if (*x.Prefix) != (*y.Prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainRoute) Clone() *DomainRoute {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainRoute) Equal(y *DomainRoute) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Family != y.Family {
+		return false
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	if x.Gateway != y.Gateway {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevCapsNet) Clone() *DomainHostdevCapsNet {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Source = x.Source.Clone()
+	if x.IP != nil {
+		y.IP = make([]DomainIP, len(x.IP))
+		copy(y.IP, x.IP)
+		for i := range x.IP {
+			y.IP[i] = *x.IP[i].Clone()
+		}
+	}
+	if x.Route != nil {
+		y.Route = make([]DomainRoute, len(x.Route))
+		copy(y.Route, x.Route)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevCapsNet) Equal(y *DomainHostdevCapsNet) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Source.Equal(y.Source) {
+		return false
+	}
+	if len(x.IP) != len(y.IP) {
+		return false
+	}
+	for i := range x.IP {
+		if !x.IP[i].Equal(&y.IP[i]) {
+			return false
+		}
+	}
+	if len(x.Route) != len(y.Route) {
+		return false
+	}
+	for i := range x.Route {
+		if !x.Route[i].Equal(&y.Route[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdevCapsNetSource) Clone() *DomainHostdevCapsNetSource {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdevCapsNetSource) Equal(y *DomainHostdevCapsNetSource) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Interface != y.Interface {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHostdev) Clone() *DomainHostdev {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.SubsysUSB = x.SubsysUSB.Clone()
+	y.SubsysSCSI = x.SubsysSCSI.Clone()
+	y.SubsysSCSIHost = x.SubsysSCSIHost.Clone()
+	y.SubsysPCI = x.SubsysPCI.Clone()
+	y.SubsysMDev = x.SubsysMDev.Clone()
+	y.CapsStorage = x.CapsStorage.Clone()
+	y.CapsMisc = x.CapsMisc.Clone()
+	y.CapsNet = x.CapsNet.Clone()
+	y.Boot = x.Boot.Clone()
+	y.ROM = x.ROM.Clone()
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHostdev) Equal(y *DomainHostdev) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Managed != y.Managed {
+		return false
+	}
+	if !x.SubsysUSB.Equal(y.SubsysUSB) &&
+		!(x.SubsysUSB == nil && y.SubsysUSB.Equal(&DomainHostdevSubsysUSB{})) &&
+		!(y.SubsysUSB == nil && x.SubsysUSB.Equal(&DomainHostdevSubsysUSB{})) {
+		return false
+	}
+	if !x.SubsysSCSI.Equal(y.SubsysSCSI) &&
+		!(x.SubsysSCSI == nil && y.SubsysSCSI.Equal(&DomainHostdevSubsysSCSI{})) &&
+		!(y.SubsysSCSI == nil && x.SubsysSCSI.Equal(&DomainHostdevSubsysSCSI{})) {
+		return false
+	}
+	if !x.SubsysSCSIHost.Equal(y.SubsysSCSIHost) &&
+		!(x.SubsysSCSIHost == nil && y.SubsysSCSIHost.Equal(&DomainHostdevSubsysSCSIHost{})) &&
+		!(y.SubsysSCSIHost == nil && x.SubsysSCSIHost.Equal(&DomainHostdevSubsysSCSIHost{})) {
+		return false
+	}
+	if !x.SubsysPCI.Equal(y.SubsysPCI) &&
+		!(x.SubsysPCI == nil && y.SubsysPCI.Equal(&DomainHostdevSubsysPCI{})) &&
+		!(y.SubsysPCI == nil && x.SubsysPCI.Equal(&DomainHostdevSubsysPCI{})) {
+		return false
+	}
+	if !x.SubsysMDev.Equal(y.SubsysMDev) &&
+		!(x.SubsysMDev == nil && y.SubsysMDev.Equal(&DomainHostdevSubsysMDev{})) &&
+		!(y.SubsysMDev == nil && x.SubsysMDev.Equal(&DomainHostdevSubsysMDev{})) {
+		return false
+	}
+	if !x.CapsStorage.Equal(y.CapsStorage) &&
+		!(x.CapsStorage == nil && y.CapsStorage.Equal(&DomainHostdevCapsStorage{})) &&
+		!(y.CapsStorage == nil && x.CapsStorage.Equal(&DomainHostdevCapsStorage{})) {
+		return false
+	}
+	if !x.CapsMisc.Equal(y.CapsMisc) &&
+		!(x.CapsMisc == nil && y.CapsMisc.Equal(&DomainHostdevCapsMisc{})) &&
+		!(y.CapsMisc == nil && x.CapsMisc.Equal(&DomainHostdevCapsMisc{})) {
+		return false
+	}
+	if !x.CapsNet.Equal(y.CapsNet) &&
+		!(x.CapsNet == nil && y.CapsNet.Equal(&DomainHostdevCapsNet{})) &&
+		!(y.CapsNet == nil && x.CapsNet.Equal(&DomainHostdevCapsNet{})) {
+		return false
+	}
+	if !x.Boot.Equal(y.Boot) {
+		return false
+	}
+	if !x.ROM.Equal(y.ROM) {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemorydevSource) Clone() *DomainMemorydevSource {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.PageSize = x.PageSize.Clone()
+	y.AlignSize = x.AlignSize.Clone()
+	y.PMem = x.PMem.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemorydevSource) Equal(y *DomainMemorydevSource) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.NodeMask != y.NodeMask {
+		return false
+	}
+	if !x.PageSize.Equal(y.PageSize) {
+		return false
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	if !x.AlignSize.Equal(y.AlignSize) {
+		return false
+	}
+	if !x.PMem.Equal(y.PMem) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemorydevSourcePMem) Clone() *DomainMemorydevSourcePMem {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemorydevSourcePMem) Equal(y *DomainMemorydevSourcePMem) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemorydevSourcePagesize) Clone() *DomainMemorydevSourcePagesize {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemorydevSourcePagesize) Equal(y *DomainMemorydevSourcePagesize) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemorydevSourceAlignsize) Clone() *DomainMemorydevSourceAlignsize {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemorydevSourceAlignsize) Equal(y *DomainMemorydevSourceAlignsize) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemorydevTargetNode) Clone() *DomainMemorydevTargetNode {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemorydevTargetNode) Equal(y *DomainMemorydevTargetNode) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemorydevTargetReadOnly) Clone() *DomainMemorydevTargetReadOnly {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemorydevTargetReadOnly) Equal(y *DomainMemorydevTargetReadOnly) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemorydevTargetSize) Clone() *DomainMemorydevTargetSize {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemorydevTargetSize) Equal(y *DomainMemorydevTargetSize) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemorydevTargetLabel) Clone() *DomainMemorydevTargetLabel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Size = x.Size.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemorydevTargetLabel) Equal(y *DomainMemorydevTargetLabel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Size.Equal(y.Size) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemorydevTarget) Clone() *DomainMemorydevTarget {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Size = x.Size.Clone()
+	y.Node = x.Node.Clone()
+	y.Label = x.Label.Clone()
+	y.ReadOnly = x.ReadOnly.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemorydevTarget) Equal(y *DomainMemorydevTarget) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Size.Equal(y.Size) {
+		return false
+	}
+	if !x.Node.Equal(y.Node) {
+		return false
+	}
+	if !x.Label.Equal(y.Label) {
+		return false
+	}
+	if !x.ReadOnly.Equal(y.ReadOnly) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemorydev) Clone() *DomainMemorydev {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Source = x.Source.Clone()
+	y.Target = x.Target.Clone()
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemorydev) Equal(y *DomainMemorydev) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Model != y.Model {
+		return false
+	}
+	if x.Access != y.Access {
+		return false
+	}
+	if x.Discard != y.Discard {
+		return false
+	}
+	if x.UUID != y.UUID {
+		return false
+	}
+	if !x.Source.Equal(y.Source) {
+		return false
+	}
+	if !x.Target.Equal(y.Target) {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainWatchdog) Clone() *DomainWatchdog {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainWatchdog) Equal(y *DomainWatchdog) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Model != y.Model {
+		return false
+	}
+	if x.Action != y.Action {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainHub) Clone() *DomainHub {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainHub) Equal(y *DomainHub) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainIOMMU) Clone() *DomainIOMMU {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Driver = x.Driver.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainIOMMU) Equal(y *DomainIOMMU) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Model != y.Model {
+		return false
+	}
+	if !x.Driver.Equal(y.Driver) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainIOMMUDriver) Clone() *DomainIOMMUDriver {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainIOMMUDriver) Equal(y *DomainIOMMUDriver) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.IntRemap != y.IntRemap {
+		return false
+	}
+	if x.CachingMode != y.CachingMode {
+		return false
+	}
+	if x.EIM != y.EIM {
+		return false
+	}
+	if x.IOTLB != y.IOTLB {
+		return false
+	}
+	if x.AWBits != y.AWBits {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainNVRAM) Clone() *DomainNVRAM {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainNVRAM) Equal(y *DomainNVRAM) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainLease) Clone() *DomainLease {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Target = x.Target.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainLease) Equal(y *DomainLease) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Lockspace != y.Lockspace {
+		return false
+	}
+	if x.Key != y.Key {
+		return false
+	}
+	if !x.Target.Equal(y.Target) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainLeaseTarget) Clone() *DomainLeaseTarget {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainLeaseTarget) Equal(y *DomainLeaseTarget) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	if x.Offset != y.Offset {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSmartcard) Clone() *DomainSmartcard {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Passthrough = x.Passthrough.Clone()
+	y.Protocol = x.Protocol.Clone()
+	y.Host = x.Host.Clone()
+	if x.HostCerts != nil {
+		y.HostCerts = make([]DomainSmartcardHostCert, len(x.HostCerts))
+		copy(y.HostCerts, x.HostCerts)
+	}
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSmartcard) Equal(y *DomainSmartcard) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Passthrough.Equal(y.Passthrough) &&
+		!(x.Passthrough == nil && y.Passthrough.Equal(&DomainChardevSource{})) &&
+		!(y.Passthrough == nil && x.Passthrough.Equal(&DomainChardevSource{})) {
+		return false
+	}
+	if !x.Protocol.Equal(y.Protocol) {
+		return false
+	}
+	if !x.Host.Equal(y.Host) &&
+		!(x.Host == nil && y.Host.Equal(&DomainSmartcardHost{})) &&
+		!(y.Host == nil && x.Host.Equal(&DomainSmartcardHost{})) {
+		return false
+	}
+	if len(x.HostCerts) != len(y.HostCerts) {
+		return false
+	}
+	for i := range x.HostCerts {
+		if !x.HostCerts[i].Equal(&y.HostCerts[i]) {
+			return false
+		}
+	}
+	if x.Database != y.Database {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSmartcardHost) Clone() *DomainSmartcardHost {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSmartcardHost) Equal(y *DomainSmartcardHost) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSmartcardHostCert) Clone() *DomainSmartcardHostCert {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSmartcardHostCert) Equal(y *DomainSmartcardHostCert) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.File != y.File {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainTPM) Clone() *DomainTPM {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Backend = x.Backend.Clone()
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainTPM) Equal(y *DomainTPM) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Model != y.Model {
+		return false
+	}
+	if !x.Backend.Equal(y.Backend) &&
+		!(x.Backend == nil && y.Backend.Equal(&DomainTPMBackend{})) &&
+		!(y.Backend == nil && x.Backend.Equal(&DomainTPMBackend{})) {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainTPMBackend) Clone() *DomainTPMBackend {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Passthrough = x.Passthrough.Clone()
+	y.Emulator = x.Emulator.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainTPMBackend) Equal(y *DomainTPMBackend) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Passthrough.Equal(y.Passthrough) &&
+		!(x.Passthrough == nil && y.Passthrough.Equal(&DomainTPMBackendPassthrough{})) &&
+		!(y.Passthrough == nil && x.Passthrough.Equal(&DomainTPMBackendPassthrough{})) {
+		return false
+	}
+	if !x.Emulator.Equal(y.Emulator) &&
+		!(x.Emulator == nil && y.Emulator.Equal(&DomainTPMBackendEmulator{})) &&
+		!(y.Emulator == nil && x.Emulator.Equal(&DomainTPMBackendEmulator{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainTPMBackendPassthrough) Clone() *DomainTPMBackendPassthrough {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Device = x.Device.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainTPMBackendPassthrough) Equal(y *DomainTPMBackendPassthrough) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Device.Equal(y.Device) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainTPMBackendEmulator) Clone() *DomainTPMBackendEmulator {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Encryption = x.Encryption.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainTPMBackendEmulator) Equal(y *DomainTPMBackendEmulator) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Version != y.Version {
+		return false
+	}
+	if !x.Encryption.Equal(y.Encryption) {
+		return false
+	}
+	if x.PersistentState != y.PersistentState {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainTPMBackendEncryption) Clone() *DomainTPMBackendEncryption {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainTPMBackendEncryption) Equal(y *DomainTPMBackendEncryption) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Secret != y.Secret {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainTPMBackendDevice) Clone() *DomainTPMBackendDevice {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainTPMBackendDevice) Equal(y *DomainTPMBackendDevice) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainShmem) Clone() *DomainShmem {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Size = x.Size.Clone()
+	y.Model = x.Model.Clone()
+	y.Server = x.Server.Clone()
+	y.MSI = x.MSI.Clone()
+	y.ACPI = x.ACPI.Clone()
+	y.Alias = x.Alias.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainShmem) Equal(y *DomainShmem) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Role != y.Role {
+		return false
+	}
+	if !x.Size.Equal(y.Size) {
+		return false
+	}
+	if !x.Model.Equal(y.Model) {
+		return false
+	}
+	if !x.Server.Equal(y.Server) {
+		return false
+	}
+	if !x.MSI.Equal(y.MSI) {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.Alias.Equal(y.Alias) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&DomainAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&DomainAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainShmemSize) Clone() *DomainShmemSize {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainShmemSize) Equal(y *DomainShmemSize) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainShmemModel) Clone() *DomainShmemModel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainShmemModel) Equal(y *DomainShmemModel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainShmemServer) Clone() *DomainShmemServer {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainShmemServer) Equal(y *DomainShmemServer) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainShmemMSI) Clone() *DomainShmemMSI {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainShmemMSI) Equal(y *DomainShmemMSI) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Enabled != y.Enabled {
+		return false
+	}
+	if x.Vectors != y.Vectors {
+		return false
+	}
+	if x.IOEventFD != y.IOEventFD {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDeviceList) Clone() *DomainDeviceList {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Disks != nil {
+		y.Disks = make([]DomainDisk, len(x.Disks))
+		copy(y.Disks, x.Disks)
+		for i := range x.Disks {
+			y.Disks[i] = *x.Disks[i].Clone()
+		}
+	}
+	if x.Controllers != nil {
+		y.Controllers = make([]DomainController, len(x.Controllers))
+		copy(y.Controllers, x.Controllers)
+		for i := range x.Controllers {
+			y.Controllers[i] = *x.Controllers[i].Clone()
+		}
+	}
+	if x.Leases != nil {
+		y.Leases = make([]DomainLease, len(x.Leases))
+		copy(y.Leases, x.Leases)
+		for i := range x.Leases {
+			y.Leases[i] = *x.Leases[i].Clone()
+		}
+	}
+	if x.Filesystems != nil {
+		y.Filesystems = make([]DomainFilesystem, len(x.Filesystems))
+		copy(y.Filesystems, x.Filesystems)
+		for i := range x.Filesystems {
+			y.Filesystems[i] = *x.Filesystems[i].Clone()
+		}
+	}
+	if x.Interfaces != nil {
+		y.Interfaces = make([]DomainInterface, len(x.Interfaces))
+		copy(y.Interfaces, x.Interfaces)
+		for i := range x.Interfaces {
+			y.Interfaces[i] = *x.Interfaces[i].Clone()
+		}
+	}
+	if x.Smartcards != nil {
+		y.Smartcards = make([]DomainSmartcard, len(x.Smartcards))
+		copy(y.Smartcards, x.Smartcards)
+		for i := range x.Smartcards {
+			y.Smartcards[i] = *x.Smartcards[i].Clone()
+		}
+	}
+	if x.Serials != nil {
+		y.Serials = make([]DomainSerial, len(x.Serials))
+		copy(y.Serials, x.Serials)
+		for i := range x.Serials {
+			y.Serials[i] = *x.Serials[i].Clone()
+		}
+	}
+	if x.Parallels != nil {
+		y.Parallels = make([]DomainParallel, len(x.Parallels))
+		copy(y.Parallels, x.Parallels)
+		for i := range x.Parallels {
+			y.Parallels[i] = *x.Parallels[i].Clone()
+		}
+	}
+	if x.Consoles != nil {
+		y.Consoles = make([]DomainConsole, len(x.Consoles))
+		copy(y.Consoles, x.Consoles)
+		for i := range x.Consoles {
+			y.Consoles[i] = *x.Consoles[i].Clone()
+		}
+	}
+	if x.Channels != nil {
+		y.Channels = make([]DomainChannel, len(x.Channels))
+		copy(y.Channels, x.Channels)
+		for i := range x.Channels {
+			y.Channels[i] = *x.Channels[i].Clone()
+		}
+	}
+	if x.Inputs != nil {
+		y.Inputs = make([]DomainInput, len(x.Inputs))
+		copy(y.Inputs, x.Inputs)
+		for i := range x.Inputs {
+			y.Inputs[i] = *x.Inputs[i].Clone()
+		}
+	}
+	if x.TPMs != nil {
+		y.TPMs = make([]DomainTPM, len(x.TPMs))
+		copy(y.TPMs, x.TPMs)
+		for i := range x.TPMs {
+			y.TPMs[i] = *x.TPMs[i].Clone()
+		}
+	}
+	if x.Graphics != nil {
+		y.Graphics = make([]DomainGraphic, len(x.Graphics))
+		copy(y.Graphics, x.Graphics)
+		for i := range x.Graphics {
+			y.Graphics[i] = *x.Graphics[i].Clone()
+		}
+	}
+	if x.Sounds != nil {
+		y.Sounds = make([]DomainSound, len(x.Sounds))
+		copy(y.Sounds, x.Sounds)
+		for i := range x.Sounds {
+			y.Sounds[i] = *x.Sounds[i].Clone()
+		}
+	}
+	if x.Audios != nil {
+		y.Audios = make([]DomainAudio, len(x.Audios))
+		copy(y.Audios, x.Audios)
+		for i := range x.Audios {
+			y.Audios[i] = *x.Audios[i].Clone()
+		}
+	}
+	if x.Videos != nil {
+		y.Videos = make([]DomainVideo, len(x.Videos))
+		copy(y.Videos, x.Videos)
+		for i := range x.Videos {
+			y.Videos[i] = *x.Videos[i].Clone()
+		}
+	}
+	if x.Hostdevs != nil {
+		y.Hostdevs = make([]DomainHostdev, len(x.Hostdevs))
+		copy(y.Hostdevs, x.Hostdevs)
+		for i := range x.Hostdevs {
+			y.Hostdevs[i] = *x.Hostdevs[i].Clone()
+		}
+	}
+	if x.RedirDevs != nil {
+		y.RedirDevs = make([]DomainRedirDev, len(x.RedirDevs))
+		copy(y.RedirDevs, x.RedirDevs)
+		for i := range x.RedirDevs {
+			y.RedirDevs[i] = *x.RedirDevs[i].Clone()
+		}
+	}
+	if x.RedirFilters != nil {
+		y.RedirFilters = make([]DomainRedirFilter, len(x.RedirFilters))
+		copy(y.RedirFilters, x.RedirFilters)
+		for i := range x.RedirFilters {
+			y.RedirFilters[i] = *x.RedirFilters[i].Clone()
+		}
+	}
+	if x.Hubs != nil {
+		y.Hubs = make([]DomainHub, len(x.Hubs))
+		copy(y.Hubs, x.Hubs)
+		for i := range x.Hubs {
+			y.Hubs[i] = *x.Hubs[i].Clone()
+		}
+	}
+	y.Watchdog = x.Watchdog.Clone()
+	y.MemBalloon = x.MemBalloon.Clone()
+	if x.RNGs != nil {
+		y.RNGs = make([]DomainRNG, len(x.RNGs))
+		copy(y.RNGs, x.RNGs)
+		for i := range x.RNGs {
+			y.RNGs[i] = *x.RNGs[i].Clone()
+		}
+	}
+	y.NVRAM = x.NVRAM.Clone()
+	if x.Panics != nil {
+		y.Panics = make([]DomainPanic, len(x.Panics))
+		copy(y.Panics, x.Panics)
+		for i := range x.Panics {
+			y.Panics[i] = *x.Panics[i].Clone()
+		}
+	}
+	if x.Shmems != nil {
+		y.Shmems = make([]DomainShmem, len(x.Shmems))
+		copy(y.Shmems, x.Shmems)
+		for i := range x.Shmems {
+			y.Shmems[i] = *x.Shmems[i].Clone()
+		}
+	}
+	if x.Memorydevs != nil {
+		y.Memorydevs = make([]DomainMemorydev, len(x.Memorydevs))
+		copy(y.Memorydevs, x.Memorydevs)
+		for i := range x.Memorydevs {
+			y.Memorydevs[i] = *x.Memorydevs[i].Clone()
+		}
+	}
+	y.IOMMU = x.IOMMU.Clone()
+	y.VSock = x.VSock.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDeviceList) Equal(y *DomainDeviceList) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Emulator != y.Emulator {
+		return false
+	}
+	if len(x.Disks) != len(y.Disks) {
+		return false
+	}
+	for i := range x.Disks {
+		if !x.Disks[i].Equal(&y.Disks[i]) {
+			return false
+		}
+	}
+	if len(x.Controllers) != len(y.Controllers) {
+		return false
+	}
+	for i := range x.Controllers {
+		if !x.Controllers[i].Equal(&y.Controllers[i]) {
+			return false
+		}
+	}
+	if len(x.Leases) != len(y.Leases) {
+		return false
+	}
+	for i := range x.Leases {
+		if !x.Leases[i].Equal(&y.Leases[i]) {
+			return false
+		}
+	}
+	if len(x.Filesystems) != len(y.Filesystems) {
+		return false
+	}
+	for i := range x.Filesystems {
+		if !x.Filesystems[i].Equal(&y.Filesystems[i]) {
+			return false
+		}
+	}
+	if len(x.Interfaces) != len(y.Interfaces) {
+		return false
+	}
+	for i := range x.Interfaces {
+		if !x.Interfaces[i].Equal(&y.Interfaces[i]) {
+			return false
+		}
+	}
+	if len(x.Smartcards) != len(y.Smartcards) {
+		return false
+	}
+	for i := range x.Smartcards {
+		if !x.Smartcards[i].Equal(&y.Smartcards[i]) {
+			return false
+		}
+	}
+	if len(x.Serials) != len(y.Serials) {
+		return false
+	}
+	for i := range x.Serials {
+		if !x.Serials[i].Equal(&y.Serials[i]) {
+			return false
+		}
+	}
+	if len(x.Parallels) != len(y.Parallels) {
+		return false
+	}
+	for i := range x.Parallels {
+		if !x.Parallels[i].Equal(&y.Parallels[i]) {
+			return false
+		}
+	}
+	if len(x.Consoles) != len(y.Consoles) {
+		return false
+	}
+	for i := range x.Consoles {
+		if !x.Consoles[i].Equal(&y.Consoles[i]) {
+			return false
+		}
+	}
+	if len(x.Channels) != len(y.Channels) {
+		return false
+	}
+	for i := range x.Channels {
+		if !x.Channels[i].Equal(&y.Channels[i]) {
+			return false
+		}
+	}
+	if len(x.Inputs) != len(y.Inputs) {
+		return false
+	}
+	for i := range x.Inputs {
+		if !x.Inputs[i].Equal(&y.Inputs[i]) {
+			return false
+		}
+	}
+	if len(x.TPMs) != len(y.TPMs) {
+		return false
+	}
+	for i := range x.TPMs {
+		if !x.TPMs[i].Equal(&y.TPMs[i]) {
+			return false
+		}
+	}
+	if len(x.Graphics) != len(y.Graphics) {
+		return false
+	}
+	for i := range x.Graphics {
+		if !x.Graphics[i].Equal(&y.Graphics[i]) {
+			return false
+		}
+	}
+	if len(x.Sounds) != len(y.Sounds) {
+		return false
+	}
+	for i := range x.Sounds {
+		if !x.Sounds[i].Equal(&y.Sounds[i]) {
+			return false
+		}
+	}
+	if len(x.Audios) != len(y.Audios) {
+		return false
+	}
+	for i := range x.Audios {
+		if !x.Audios[i].Equal(&y.Audios[i]) {
+			return false
+		}
+	}
+	if len(x.Videos) != len(y.Videos) {
+		return false
+	}
+	for i := range x.Videos {
+		if !x.Videos[i].Equal(&y.Videos[i]) {
+			return false
+		}
+	}
+	if len(x.Hostdevs) != len(y.Hostdevs) {
+		return false
+	}
+	for i := range x.Hostdevs {
+		if !x.Hostdevs[i].Equal(&y.Hostdevs[i]) {
+			return false
+		}
+	}
+	if len(x.RedirDevs) != len(y.RedirDevs) {
+		return false
+	}
+	for i := range x.RedirDevs {
+		if !x.RedirDevs[i].Equal(&y.RedirDevs[i]) {
+			return false
+		}
+	}
+	if len(x.RedirFilters) != len(y.RedirFilters) {
+		return false
+	}
+	for i := range x.RedirFilters {
+		if !x.RedirFilters[i].Equal(&y.RedirFilters[i]) {
+			return false
+		}
+	}
+	if len(x.Hubs) != len(y.Hubs) {
+		return false
+	}
+	for i := range x.Hubs {
+		if !x.Hubs[i].Equal(&y.Hubs[i]) {
+			return false
+		}
+	}
+	if !x.Watchdog.Equal(y.Watchdog) {
+		return false
+	}
+	if !x.MemBalloon.Equal(y.MemBalloon) {
+		return false
+	}
+	if len(x.RNGs) != len(y.RNGs) {
+		return false
+	}
+	for i := range x.RNGs {
+		if !x.RNGs[i].Equal(&y.RNGs[i]) {
+			return false
+		}
+	}
+	if !x.NVRAM.Equal(y.NVRAM) {
+		return false
+	}
+	if len(x.Panics) != len(y.Panics) {
+		return false
+	}
+	for i := range x.Panics {
+		if !x.Panics[i].Equal(&y.Panics[i]) {
+			return false
+		}
+	}
+	if len(x.Shmems) != len(y.Shmems) {
+		return false
+	}
+	for i := range x.Shmems {
+		if !x.Shmems[i].Equal(&y.Shmems[i]) {
+			return false
+		}
+	}
+	if len(x.Memorydevs) != len(y.Memorydevs) {
+		return false
+	}
+	for i := range x.Memorydevs {
+		if !x.Memorydevs[i].Equal(&y.Memorydevs[i]) {
+			return false
+		}
+	}
+	if !x.IOMMU.Equal(y.IOMMU) {
+		return false
+	}
+	if !x.VSock.Equal(y.VSock) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemory) Clone() *DomainMemory {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemory) Equal(y *DomainMemory) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	if x.DumpCore != y.DumpCore {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCurrentMemory) Clone() *DomainCurrentMemory {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCurrentMemory) Equal(y *DomainCurrentMemory) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMaxMemory) Clone() *DomainMaxMemory {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMaxMemory) Equal(y *DomainMaxMemory) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	if x.Slots != y.Slots {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemoryHugepage) Clone() *DomainMemoryHugepage {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemoryHugepage) Equal(y *DomainMemoryHugepage) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Size != y.Size {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	if x.Nodeset != y.Nodeset {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemoryHugepages) Clone() *DomainMemoryHugepages {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Hugepages != nil {
+		y.Hugepages = make([]DomainMemoryHugepage, len(x.Hugepages))
+		copy(y.Hugepages, x.Hugepages)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemoryHugepages) Equal(y *DomainMemoryHugepages) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Hugepages) != len(y.Hugepages) {
+		return false
+	}
+	for i := range x.Hugepages {
+		if !x.Hugepages[i].Equal(&y.Hugepages[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemoryNosharepages) Clone() *DomainMemoryNosharepages {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemoryNosharepages) Equal(y *DomainMemoryNosharepages) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemoryLocked) Clone() *DomainMemoryLocked {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemoryLocked) Equal(y *DomainMemoryLocked) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemorySource) Clone() *DomainMemorySource {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemorySource) Equal(y *DomainMemorySource) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemoryAccess) Clone() *DomainMemoryAccess {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemoryAccess) Equal(y *DomainMemoryAccess) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemoryAllocation) Clone() *DomainMemoryAllocation {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemoryAllocation) Equal(y *DomainMemoryAllocation) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemoryDiscard) Clone() *DomainMemoryDiscard {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemoryDiscard) Equal(y *DomainMemoryDiscard) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemoryBacking) Clone() *DomainMemoryBacking {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.MemoryHugePages = x.MemoryHugePages.Clone()
+	y.MemoryNosharepages = x.MemoryNosharepages.Clone()
+	y.MemoryLocked = x.MemoryLocked.Clone()
+	y.MemorySource = x.MemorySource.Clone()
+	y.MemoryAccess = x.MemoryAccess.Clone()
+	y.MemoryAllocation = x.MemoryAllocation.Clone()
+	y.MemoryDiscard = x.MemoryDiscard.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemoryBacking) Equal(y *DomainMemoryBacking) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.MemoryHugePages.Equal(y.MemoryHugePages) {
+		return false
+	}
+	if !x.MemoryNosharepages.Equal(y.MemoryNosharepages) {
+		return false
+	}
+	if !x.MemoryLocked.Equal(y.MemoryLocked) {
+		return false
+	}
+	if !x.MemorySource.Equal(y.MemorySource) {
+		return false
+	}
+	if !x.MemoryAccess.Equal(y.MemoryAccess) {
+		return false
+	}
+	if !x.MemoryAllocation.Equal(y.MemoryAllocation) {
+		return false
+	}
+	if !x.MemoryDiscard.Equal(y.MemoryDiscard) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainOSType) Clone() *DomainOSType {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainOSType) Equal(y *DomainOSType) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Arch != y.Arch {
+		return false
+	}
+	if x.Machine != y.Machine {
+		return false
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSMBios) Clone() *DomainSMBios {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSMBios) Equal(y *DomainSMBios) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainNVRam) Clone() *DomainNVRam {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainNVRam) Equal(y *DomainNVRam) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.NVRam != y.NVRam {
+		return false
+	}
+	if x.Template != y.Template {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainBootDevice) Clone() *DomainBootDevice {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainBootDevice) Equal(y *DomainBootDevice) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Dev != y.Dev {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainBootMenu) Clone() *DomainBootMenu {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainBootMenu) Equal(y *DomainBootMenu) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Enable != y.Enable {
+		return false
+	}
+	if x.Timeout != y.Timeout {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSysInfoBIOS) Clone() *DomainSysInfoBIOS {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Entry != nil {
+		y.Entry = make([]DomainSysInfoEntry, len(x.Entry))
+		copy(y.Entry, x.Entry)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSysInfoBIOS) Equal(y *DomainSysInfoBIOS) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Entry) != len(y.Entry) {
+		return false
+	}
+	for i := range x.Entry {
+		if !x.Entry[i].Equal(&y.Entry[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSysInfoSystem) Clone() *DomainSysInfoSystem {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Entry != nil {
+		y.Entry = make([]DomainSysInfoEntry, len(x.Entry))
+		copy(y.Entry, x.Entry)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSysInfoSystem) Equal(y *DomainSysInfoSystem) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Entry) != len(y.Entry) {
+		return false
+	}
+	for i := range x.Entry {
+		if !x.Entry[i].Equal(&y.Entry[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSysInfoBaseBoard) Clone() *DomainSysInfoBaseBoard {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Entry != nil {
+		y.Entry = make([]DomainSysInfoEntry, len(x.Entry))
+		copy(y.Entry, x.Entry)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSysInfoBaseBoard) Equal(y *DomainSysInfoBaseBoard) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Entry) != len(y.Entry) {
+		return false
+	}
+	for i := range x.Entry {
+		if !x.Entry[i].Equal(&y.Entry[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSysInfoProcessor) Clone() *DomainSysInfoProcessor {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Entry != nil {
+		y.Entry = make([]DomainSysInfoEntry, len(x.Entry))
+		copy(y.Entry, x.Entry)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSysInfoProcessor) Equal(y *DomainSysInfoProcessor) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Entry) != len(y.Entry) {
+		return false
+	}
+	for i := range x.Entry {
+		if !x.Entry[i].Equal(&y.Entry[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSysInfoMemory) Clone() *DomainSysInfoMemory {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Entry != nil {
+		y.Entry = make([]DomainSysInfoEntry, len(x.Entry))
+		copy(y.Entry, x.Entry)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSysInfoMemory) Equal(y *DomainSysInfoMemory) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Entry) != len(y.Entry) {
+		return false
+	}
+	for i := range x.Entry {
+		if !x.Entry[i].Equal(&y.Entry[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSysInfoChassis) Clone() *DomainSysInfoChassis {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Entry != nil {
+		y.Entry = make([]DomainSysInfoEntry, len(x.Entry))
+		copy(y.Entry, x.Entry)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSysInfoChassis) Equal(y *DomainSysInfoChassis) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Entry) != len(y.Entry) {
+		return false
+	}
+	for i := range x.Entry {
+		if !x.Entry[i].Equal(&y.Entry[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSysInfoOEMStrings) Clone() *DomainSysInfoOEMStrings {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Entry != nil {
+		y.Entry = make([]string, len(x.Entry))
+		copy(y.Entry, x.Entry)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSysInfoOEMStrings) Equal(y *DomainSysInfoOEMStrings) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Entry) != len(y.Entry) {
+		return false
+	}
+	for i := range x.Entry {
+		if x.Entry[i] != y.Entry[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSysInfoSMBIOS) Clone() *DomainSysInfoSMBIOS {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.BIOS = x.BIOS.Clone()
+	y.System = x.System.Clone()
+	if x.BaseBoard != nil {
+		y.BaseBoard = make([]DomainSysInfoBaseBoard, len(x.BaseBoard))
+		copy(y.BaseBoard, x.BaseBoard)
+		for i := range x.BaseBoard {
+			y.BaseBoard[i] = *x.BaseBoard[i].Clone()
+		}
+	}
+	y.Chassis = x.Chassis.Clone()
+	if x.Processor != nil {
+		y.Processor = make([]DomainSysInfoProcessor, len(x.Processor))
+		copy(y.Processor, x.Processor)
+		for i := range x.Processor {
+			y.Processor[i] = *x.Processor[i].Clone()
+		}
+	}
+	if x.Memory != nil {
+		y.Memory = make([]DomainSysInfoMemory, len(x.Memory))
+		copy(y.Memory, x.Memory)
+		for i := range x.Memory {
+			y.Memory[i] = *x.Memory[i].Clone()
+		}
+	}
+	y.OEMStrings = x.OEMStrings.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSysInfoSMBIOS) Equal(y *DomainSysInfoSMBIOS) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.BIOS.Equal(y.BIOS) {
+		return false
+	}
+	if !x.System.Equal(y.System) {
+		return false
+	}
+	if len(x.BaseBoard) != len(y.BaseBoard) {
+		return false
+	}
+	for i := range x.BaseBoard {
+		if !x.BaseBoard[i].Equal(&y.BaseBoard[i]) {
+			return false
+		}
+	}
+	if !x.Chassis.Equal(y.Chassis) {
+		return false
+	}
+	if len(x.Processor) != len(y.Processor) {
+		return false
+	}
+	for i := range x.Processor {
+		if !x.Processor[i].Equal(&y.Processor[i]) {
+			return false
+		}
+	}
+	if len(x.Memory) != len(y.Memory) {
+		return false
+	}
+	for i := range x.Memory {
+		if !x.Memory[i].Equal(&y.Memory[i]) {
+			return false
+		}
+	}
+	if !x.OEMStrings.Equal(y.OEMStrings) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSysInfoFWCfg) Clone() *DomainSysInfoFWCfg {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Entry != nil {
+		y.Entry = make([]DomainSysInfoEntry, len(x.Entry))
+		copy(y.Entry, x.Entry)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSysInfoFWCfg) Equal(y *DomainSysInfoFWCfg) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Entry) != len(y.Entry) {
+		return false
+	}
+	for i := range x.Entry {
+		if !x.Entry[i].Equal(&y.Entry[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSysInfo) Clone() *DomainSysInfo {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.SMBIOS = x.SMBIOS.Clone()
+	y.FWCfg = x.FWCfg.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSysInfo) Equal(y *DomainSysInfo) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.SMBIOS.Equal(y.SMBIOS) &&
+		!(x.SMBIOS == nil && y.SMBIOS.Equal(&DomainSysInfoSMBIOS{})) &&
+		!(y.SMBIOS == nil && x.SMBIOS.Equal(&DomainSysInfoSMBIOS{})) {
+		return false
+	}
+	if !x.FWCfg.Equal(y.FWCfg) &&
+		!(x.FWCfg == nil && y.FWCfg.Equal(&DomainSysInfoFWCfg{})) &&
+		!(y.FWCfg == nil && x.FWCfg.Equal(&DomainSysInfoFWCfg{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSysInfoEntry) Clone() *DomainSysInfoEntry {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSysInfoEntry) Equal(y *DomainSysInfoEntry) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.File != y.File {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainBIOS) Clone() *DomainBIOS {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.RebootTimeout != nil {
+		v := *x.RebootTimeout
+		y.RebootTimeout = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainBIOS) Equal(y *DomainBIOS) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.UseSerial != y.UseSerial {
+		return false
+	}
+	if (x.RebootTimeout == nil) != (y.RebootTimeout == nil) {
+		return false
+	}
+	if x.RebootTimeout != nil {
+		if (*x.RebootTimeout) != (*y.RebootTimeout) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainLoader) Clone() *DomainLoader {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainLoader) Equal(y *DomainLoader) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	if x.Readonly != y.Readonly {
+		return false
+	}
+	if x.Secure != y.Secure {
+		return false
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainACPI) Clone() *DomainACPI {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Tables != nil {
+		y.Tables = make([]DomainACPITable, len(x.Tables))
+		copy(y.Tables, x.Tables)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainACPI) Equal(y *DomainACPI) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Tables) != len(y.Tables) {
+		return false
+	}
+	for i := range x.Tables {
+		if !x.Tables[i].Equal(&y.Tables[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainACPITable) Clone() *DomainACPITable {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainACPITable) Equal(y *DomainACPITable) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainOSInitEnv) Clone() *DomainOSInitEnv {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainOSInitEnv) Equal(y *DomainOSInitEnv) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainOSFirmwareInfo) Clone() *DomainOSFirmwareInfo {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Features != nil {
+		y.Features = make([]DomainOSFirmwareFeature, len(x.Features))
+		copy(y.Features, x.Features)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainOSFirmwareInfo) Equal(y *DomainOSFirmwareInfo) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Features) != len(y.Features) {
+		return false
+	}
+	for i := range x.Features {
+		if !x.Features[i].Equal(&y.Features[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainOSFirmwareFeature) Clone() *DomainOSFirmwareFeature {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainOSFirmwareFeature) Equal(y *DomainOSFirmwareFeature) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Enabled != y.Enabled {
+		return false
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainOS) Clone() *DomainOS {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Type = x.Type.Clone()
+	y.FirmwareInfo = x.FirmwareInfo.Clone()
+	if x.InitArgs != nil {
+		y.InitArgs = make([]string, len(x.InitArgs))
+		copy(y.InitArgs, x.InitArgs)
+	}
+	if x.InitEnv != nil {
+		y.InitEnv = make([]DomainOSInitEnv, len(x.InitEnv))
+		copy(y.InitEnv, x.InitEnv)
+	}
+	y.Loader = x.Loader.Clone()
+	y.NVRam = x.NVRam.Clone()
+	y.ACPI = x.ACPI.Clone()
+	if x.BootDevices != nil {
+		y.BootDevices = make([]DomainBootDevice, len(x.BootDevices))
+		copy(y.BootDevices, x.BootDevices)
+	}
+	y.BootMenu = x.BootMenu.Clone()
+	y.BIOS = x.BIOS.Clone()
+	y.SMBios = x.SMBios.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainOS) Equal(y *DomainOS) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Type.Equal(y.Type) {
+		return false
+	}
+	if x.Firmware != y.Firmware {
+		return false
+	}
+	if !x.FirmwareInfo.Equal(y.FirmwareInfo) {
+		return false
+	}
+	if x.Init != y.Init {
+		return false
+	}
+	if len(x.InitArgs) != len(y.InitArgs) {
+		return false
+	}
+	for i := range x.InitArgs {
+		if x.InitArgs[i] != y.InitArgs[i] {
+			return false
+		}
+	}
+	if len(x.InitEnv) != len(y.InitEnv) {
+		return false
+	}
+	for i := range x.InitEnv {
+		if !x.InitEnv[i].Equal(&y.InitEnv[i]) {
+			return false
+		}
+	}
+	if x.InitDir != y.InitDir {
+		return false
+	}
+	if x.InitUser != y.InitUser {
+		return false
+	}
+	if x.InitGroup != y.InitGroup {
+		return false
+	}
+	if !x.Loader.Equal(y.Loader) {
+		return false
+	}
+	if !x.NVRam.Equal(y.NVRam) {
+		return false
+	}
+	if x.Kernel != y.Kernel {
+		return false
+	}
+	if x.Initrd != y.Initrd {
+		return false
+	}
+	if x.Cmdline != y.Cmdline {
+		return false
+	}
+	if x.DTB != y.DTB {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if len(x.BootDevices) != len(y.BootDevices) {
+		return false
+	}
+	for i := range x.BootDevices {
+		if !x.BootDevices[i].Equal(&y.BootDevices[i]) {
+			return false
+		}
+	}
+	if !x.BootMenu.Equal(y.BootMenu) {
+		return false
+	}
+	if !x.BIOS.Equal(y.BIOS) {
+		return false
+	}
+	if !x.SMBios.Equal(y.SMBios) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainResource) Clone() *DomainResource {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainResource) Equal(y *DomainResource) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Partition != y.Partition {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainVCPU) Clone() *DomainVCPU {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainVCPU) Equal(y *DomainVCPU) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Placement != y.Placement {
+		return false
+	}
+	if x.CPUSet != y.CPUSet {
+		return false
+	}
+	if x.Current != y.Current {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainVCPUsVCPU) Clone() *DomainVCPUsVCPU {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Id != nil {
+		v := *x.Id
+		y.Id = &v
+	}
+	if x.Order != nil {
+		v := *x.Order
+		y.Order = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainVCPUsVCPU) Equal(y *DomainVCPUsVCPU) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.Id == nil) != (y.Id == nil) {
+		return false
+	}
+	if x.Id != nil {
+		if (*x.Id) != (*y.Id) {
+			return false
+		}
+	}
+	if x.Enabled != y.Enabled {
+		return false
+	}
+	if x.Hotpluggable != y.Hotpluggable {
+		return false
+	}
+	if (x.Order == nil) != (y.Order == nil) {
+		return false
+	}
+	if x.Order != nil {
+		if (*x.Order) != (*y.Order) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainVCPUs) Clone() *DomainVCPUs {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.VCPU != nil {
+		y.VCPU = make([]DomainVCPUsVCPU, len(x.VCPU))
+		copy(y.VCPU, x.VCPU)
+		for i := range x.VCPU {
+			y.VCPU[i] = *x.VCPU[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainVCPUs) Equal(y *DomainVCPUs) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.VCPU) != len(y.VCPU) {
+		return false
+	}
+	for i := range x.VCPU {
+		if !x.VCPU[i].Equal(&y.VCPU[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCPUModel) Clone() *DomainCPUModel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCPUModel) Equal(y *DomainCPUModel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Fallback != y.Fallback {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	if x.VendorID != y.VendorID {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCPUTopology) Clone() *DomainCPUTopology {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCPUTopology) Equal(y *DomainCPUTopology) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Sockets != y.Sockets {
+		return false
+	}
+	if x.Dies != y.Dies {
+		return false
+	}
+	if x.Cores != y.Cores {
+		return false
+	}
+	if x.Threads != y.Threads {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCPUFeature) Clone() *DomainCPUFeature {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCPUFeature) Equal(y *DomainCPUFeature) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Policy != y.Policy {
+		return false
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCPUCache) Clone() *DomainCPUCache {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCPUCache) Equal(y *DomainCPUCache) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Level != y.Level {
+		return false
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCPU) Clone() *DomainCPU {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Model = x.Model.Clone()
+	y.Topology = x.Topology.Clone()
+	y.Cache = x.Cache.Clone()
+	if x.Features != nil {
+		y.Features = make([]DomainCPUFeature, len(x.Features))
+		copy(y.Features, x.Features)
+	}
+	y.Numa = x.Numa.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCPU) Equal(y *DomainCPU) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	if x.Check != y.Check {
+		return false
+	}
+	if x.Migratable != y.Migratable {
+		return false
+	}
+	if !x.Model.Equal(y.Model) {
+		return false
+	}
+	if x.Vendor != y.Vendor {
+		return false
+	}
+	if !x.Topology.Equal(y.Topology) {
+		return false
+	}
+	if !x.Cache.Equal(y.Cache) {
+		return false
+	}
+	if len(x.Features) != len(y.Features) {
+		return false
+	}
+	for i := range x.Features {
+		if !x.Features[i].Equal(&y.Features[i]) {
+			return false
+		}
+	}
+	if !x.Numa.Equal(y.Numa) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainNuma) Clone() *DomainNuma {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Cell != nil {
+		y.Cell = make([]DomainCell, len(x.Cell))
+		copy(y.Cell, x.Cell)
+		for i := range x.Cell {
+			y.Cell[i] = *x.Cell[i].Clone()
+		}
+	}
+	y.Interconnects = x.Interconnects.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainNuma) Equal(y *DomainNuma) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Cell) != len(y.Cell) {
+		return false
+	}
+	for i := range x.Cell {
+		if !x.Cell[i].Equal(&y.Cell[i]) {
+			return false
+		}
+	}
+	if !x.Interconnects.Equal(y.Interconnects) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCell) Clone() *DomainCell {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.ID != nil {
+		v := *x.ID
+		y.ID = &v
+	}
+	y.Distances = x.Distances.Clone()
+	if x.Caches != nil {
+		y.Caches = make([]DomainCellCache, len(x.Caches))
+		copy(y.Caches, x.Caches)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCell) Equal(y *DomainCell) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.ID == nil) != (y.ID == nil) {
+		return false
+	}
+	if x.ID != nil {
+		if (*x.ID) != (*y.ID) {
+			return false
+		}
+	}
+	if x.CPUs != y.CPUs {
+		return false
+	}
+	if x.Memory != y.Memory {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	if x.MemAccess != y.MemAccess {
+		return false
+	}
+	if x.Discard != y.Discard {
+		return false
+	}
+	if !x.Distances.Equal(y.Distances) {
+		return false
+	}
+	if len(x.Caches) != len(y.Caches) {
+		return false
+	}
+	for i := range x.Caches {
+		if !x.Caches[i].Equal(&y.Caches[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCellDistances) Clone() *DomainCellDistances {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Siblings != nil {
+		y.Siblings = make([]DomainCellSibling, len(x.Siblings))
+		copy(y.Siblings, x.Siblings)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCellDistances) Equal(y *DomainCellDistances) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Siblings) != len(y.Siblings) {
+		return false
+	}
+	for i := range x.Siblings {
+		if !x.Siblings[i].Equal(&y.Siblings[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCellSibling) Clone() *DomainCellSibling {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCellSibling) Equal(y *DomainCellSibling) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ID != y.ID {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCellCache) Clone() *DomainCellCache {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCellCache) Equal(y *DomainCellCache) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Level != y.Level {
+		return false
+	}
+	if x.Associativity != y.Associativity {
+		return false
+	}
+	if x.Policy != y.Policy {
+		return false
+	}
+	if !x.Size.Equal(&y.Size) {
+		return false
+	}
+	if !x.Line.Equal(&y.Line) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCellCacheSize) Clone() *DomainCellCacheSize {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCellCacheSize) Equal(y *DomainCellCacheSize) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCellCacheLine) Clone() *DomainCellCacheLine {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCellCacheLine) Equal(y *DomainCellCacheLine) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainNUMAInterconnects) Clone() *DomainNUMAInterconnects {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Latencies != nil {
+		y.Latencies = make([]DomainNUMAInterconnectLatency, len(x.Latencies))
+		copy(y.Latencies, x.Latencies)
+	}
+	if x.Bandwidths != nil {
+		y.Bandwidths = make([]DomainNUMAInterconnectBandwidth, len(x.Bandwidths))
+		copy(y.Bandwidths, x.Bandwidths)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainNUMAInterconnects) Equal(y *DomainNUMAInterconnects) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Latencies) != len(y.Latencies) {
+		return false
+	}
+	for i := range x.Latencies {
+		if !x.Latencies[i].Equal(&y.Latencies[i]) {
+			return false
+		}
+	}
+	if len(x.Bandwidths) != len(y.Bandwidths) {
+		return false
+	}
+	for i := range x.Bandwidths {
+		if !x.Bandwidths[i].Equal(&y.Bandwidths[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainNUMAInterconnectLatency) Clone() *DomainNUMAInterconnectLatency {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainNUMAInterconnectLatency) Equal(y *DomainNUMAInterconnectLatency) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Initiator != y.Initiator {
+		return false
+	}
+	if x.Target != y.Target {
+		return false
+	}
+	if x.Cache != y.Cache {
+		return false
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainNUMAInterconnectBandwidth) Clone() *DomainNUMAInterconnectBandwidth {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainNUMAInterconnectBandwidth) Equal(y *DomainNUMAInterconnectBandwidth) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Initiator != y.Initiator {
+		return false
+	}
+	if x.Target != y.Target {
+		return false
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainClock) Clone() *DomainClock {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Timer != nil {
+		y.Timer = make([]DomainTimer, len(x.Timer))
+		copy(y.Timer, x.Timer)
+		for i := range x.Timer {
+			y.Timer[i] = *x.Timer[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainClock) Equal(y *DomainClock) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Offset != y.Offset {
+		return false
+	}
+	if x.Basis != y.Basis {
+		return false
+	}
+	if x.Adjustment != y.Adjustment {
+		return false
+	}
+	if x.TimeZone != y.TimeZone {
+		return false
+	}
+	if len(x.Timer) != len(y.Timer) {
+		return false
+	}
+	for i := range x.Timer {
+		if !x.Timer[i].Equal(&y.Timer[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainTimer) Clone() *DomainTimer {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.CatchUp = x.CatchUp.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainTimer) Equal(y *DomainTimer) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Track != y.Track {
+		return false
+	}
+	if x.TickPolicy != y.TickPolicy {
+		return false
+	}
+	if !x.CatchUp.Equal(y.CatchUp) {
+		return false
+	}
+	if x.Frequency != y.Frequency {
+		return false
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	if x.Present != y.Present {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainTimerCatchUp) Clone() *DomainTimerCatchUp {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainTimerCatchUp) Equal(y *DomainTimerCatchUp) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Threshold != y.Threshold {
+		return false
+	}
+	if x.Slew != y.Slew {
+		return false
+	}
+	if x.Limit != y.Limit {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeature) Clone() *DomainFeature {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeature) Equal(y *DomainFeature) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureState) Clone() *DomainFeatureState {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureState) Equal(y *DomainFeatureState) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.State != y.State {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureAPIC) Clone() *DomainFeatureAPIC {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureAPIC) Equal(y *DomainFeatureAPIC) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.EOI != y.EOI {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureHyperVVendorId) Clone() *DomainFeatureHyperVVendorId {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureHyperVVendorId) Equal(y *DomainFeatureHyperVVendorId) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.DomainFeatureState.Equal(&y.DomainFeatureState) {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureHyperVSpinlocks) Clone() *DomainFeatureHyperVSpinlocks {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureHyperVSpinlocks) Equal(y *DomainFeatureHyperVSpinlocks) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.DomainFeatureState.Equal(&y.DomainFeatureState) {
+		return false
+	}
+	if x.Retries != y.Retries {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureHyperVSTimer) Clone() *DomainFeatureHyperVSTimer {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Direct = x.Direct.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureHyperVSTimer) Equal(y *DomainFeatureHyperVSTimer) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.DomainFeatureState.Equal(&y.DomainFeatureState) {
+		return false
+	}
+	if !x.Direct.Equal(y.Direct) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureHyperV) Clone() *DomainFeatureHyperV {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Relaxed = x.Relaxed.Clone()
+	y.VAPIC = x.VAPIC.Clone()
+	y.Spinlocks = x.Spinlocks.Clone()
+	y.VPIndex = x.VPIndex.Clone()
+	y.Runtime = x.Runtime.Clone()
+	y.Synic = x.Synic.Clone()
+	y.STimer = x.STimer.Clone()
+	y.Reset = x.Reset.Clone()
+	y.VendorId = x.VendorId.Clone()
+	y.Frequencies = x.Frequencies.Clone()
+	y.ReEnlightenment = x.ReEnlightenment.Clone()
+	y.TLBFlush = x.TLBFlush.Clone()
+	y.IPI = x.IPI.Clone()
+	y.EVMCS = x.EVMCS.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureHyperV) Equal(y *DomainFeatureHyperV) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.DomainFeature.Equal(&y.DomainFeature) {
+		return false
+	}
+	if !x.Relaxed.Equal(y.Relaxed) {
+		return false
+	}
+	if !x.VAPIC.Equal(y.VAPIC) {
+		return false
+	}
+	if !x.Spinlocks.Equal(y.Spinlocks) {
+		return false
+	}
+	if !x.VPIndex.Equal(y.VPIndex) {
+		return false
+	}
+	if !x.Runtime.Equal(y.Runtime) {
+		return false
+	}
+	if !x.Synic.Equal(y.Synic) {
+		return false
+	}
+	if !x.STimer.Equal(y.STimer) {
+		return false
+	}
+	if !x.Reset.Equal(y.Reset) {
+		return false
+	}
+	if !x.VendorId.Equal(y.VendorId) {
+		return false
+	}
+	if !x.Frequencies.Equal(y.Frequencies) {
+		return false
+	}
+	if !x.ReEnlightenment.Equal(y.ReEnlightenment) {
+		return false
+	}
+	if !x.TLBFlush.Equal(y.TLBFlush) {
+		return false
+	}
+	if !x.IPI.Equal(y.IPI) {
+		return false
+	}
+	if !x.EVMCS.Equal(y.EVMCS) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureKVM) Clone() *DomainFeatureKVM {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Hidden = x.Hidden.Clone()
+	y.HintDedicated = x.HintDedicated.Clone()
+	y.PollControl = x.PollControl.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureKVM) Equal(y *DomainFeatureKVM) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Hidden.Equal(y.Hidden) {
+		return false
+	}
+	if !x.HintDedicated.Equal(y.HintDedicated) {
+		return false
+	}
+	if !x.PollControl.Equal(y.PollControl) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureXenPassthrough) Clone() *DomainFeatureXenPassthrough {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureXenPassthrough) Equal(y *DomainFeatureXenPassthrough) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.State != y.State {
+		return false
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureXenE820Host) Clone() *DomainFeatureXenE820Host {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureXenE820Host) Equal(y *DomainFeatureXenE820Host) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.State != y.State {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureXen) Clone() *DomainFeatureXen {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.E820Host = x.E820Host.Clone()
+	y.Passthrough = x.Passthrough.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureXen) Equal(y *DomainFeatureXen) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.E820Host.Equal(y.E820Host) {
+		return false
+	}
+	if !x.Passthrough.Equal(y.Passthrough) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureGIC) Clone() *DomainFeatureGIC {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureGIC) Equal(y *DomainFeatureGIC) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Version != y.Version {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureIOAPIC) Clone() *DomainFeatureIOAPIC {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureIOAPIC) Equal(y *DomainFeatureIOAPIC) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Driver != y.Driver {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureHPT) Clone() *DomainFeatureHPT {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.MaxPageSize = x.MaxPageSize.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureHPT) Equal(y *DomainFeatureHPT) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Resizing != y.Resizing {
+		return false
+	}
+	if !x.MaxPageSize.Equal(y.MaxPageSize) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureHPTPageSize) Clone() *DomainFeatureHPTPageSize {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureHPTPageSize) Equal(y *DomainFeatureHPTPageSize) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureSMM) Clone() *DomainFeatureSMM {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.TSeg = x.TSeg.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureSMM) Equal(y *DomainFeatureSMM) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.State != y.State {
+		return false
+	}
+	if !x.TSeg.Equal(y.TSeg) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureSMMTSeg) Clone() *DomainFeatureSMMTSeg {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureSMMTSeg) Equal(y *DomainFeatureSMMTSeg) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureCapability) Clone() *DomainFeatureCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureCapability) Equal(y *DomainFeatureCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.State != y.State {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainLaunchSecurity) Clone() *DomainLaunchSecurity {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.SEV = x.SEV.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainLaunchSecurity) Equal(y *DomainLaunchSecurity) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.SEV.Equal(y.SEV) &&
+		!(x.SEV == nil && y.SEV.Equal(&DomainLaunchSecuritySEV{})) &&
+		!(y.SEV == nil && x.SEV.Equal(&DomainLaunchSecuritySEV{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainLaunchSecuritySEV) Clone() *DomainLaunchSecuritySEV {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.CBitPos != nil {
+		v := *x.CBitPos
+		y.CBitPos = &v
+	}
+	if x.ReducedPhysBits != nil {
+		v := *x.ReducedPhysBits
+		y.ReducedPhysBits = &v
+	}
+	if x.Policy != nil {
+		v := *x.Policy
+		y.Policy = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainLaunchSecuritySEV) Equal(y *DomainLaunchSecuritySEV) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.CBitPos == nil) != (y.CBitPos == nil) {
+		return false
+	}
+	if x.CBitPos != nil {
+		if (*x.CBitPos) != (*y.CBitPos) {
+			return false
+		}
+	}
+	if (x.ReducedPhysBits == nil) != (y.ReducedPhysBits == nil) {
+		return false
+	}
+	if x.ReducedPhysBits != nil {
+		if (*x.ReducedPhysBits) != (*y.ReducedPhysBits) {
+			return false
+		}
+	}
+	if (x.Policy == nil) != (y.Policy == nil) {
+		return false
+	}
+	if x.Policy != nil {
+		if (*x.Policy) != (*y.Policy) {
+			return false
+		}
+	}
+	if x.DHCert != y.DHCert {
+		return false
+	}
+	if x.Session != y.Session {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureCapabilities) Clone() *DomainFeatureCapabilities {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.AuditControl = x.AuditControl.Clone()
+	y.AuditWrite = x.AuditWrite.Clone()
+	y.BlockSuspend = x.BlockSuspend.Clone()
+	y.Chown = x.Chown.Clone()
+	y.DACOverride = x.DACOverride.Clone()
+	y.DACReadSearch = x.DACReadSearch.Clone()
+	y.FOwner = x.FOwner.Clone()
+	y.FSetID = x.FSetID.Clone()
+	y.IPCLock = x.IPCLock.Clone()
+	y.IPCOwner = x.IPCOwner.Clone()
+	y.Kill = x.Kill.Clone()
+	y.Lease = x.Lease.Clone()
+	y.LinuxImmutable = x.LinuxImmutable.Clone()
+	y.MACAdmin = x.MACAdmin.Clone()
+	y.MACOverride = x.MACOverride.Clone()
+	y.MkNod = x.MkNod.Clone()
+	y.NetAdmin = x.NetAdmin.Clone()
+	y.NetBindService = x.NetBindService.Clone()
+	y.NetBroadcast = x.NetBroadcast.Clone()
+	y.NetRaw = x.NetRaw.Clone()
+	y.SetGID = x.SetGID.Clone()
+	y.SetFCap = x.SetFCap.Clone()
+	y.SetPCap = x.SetPCap.Clone()
+	y.SetUID = x.SetUID.Clone()
+	y.SysAdmin = x.SysAdmin.Clone()
+	y.SysBoot = x.SysBoot.Clone()
+	y.SysChRoot = x.SysChRoot.Clone()
+	y.SysModule = x.SysModule.Clone()
+	y.SysNice = x.SysNice.Clone()
+	y.SysPAcct = x.SysPAcct.Clone()
+	y.SysPTrace = x.SysPTrace.Clone()
+	y.SysRawIO = x.SysRawIO.Clone()
+	y.SysResource = x.SysResource.Clone()
+	y.SysTime = x.SysTime.Clone()
+	y.SysTTYCnofig = x.SysTTYCnofig.Clone()
+	y.SysLog = x.SysLog.Clone()
+	y.WakeAlarm = x.WakeAlarm.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureCapabilities) Equal(y *DomainFeatureCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Policy != y.Policy {
+		return false
+	}
+	if !x.AuditControl.Equal(y.AuditControl) {
+		return false
+	}
+	if !x.AuditWrite.Equal(y.AuditWrite) {
+		return false
+	}
+	if !x.BlockSuspend.Equal(y.BlockSuspend) {
+		return false
+	}
+	if !x.Chown.Equal(y.Chown) {
+		return false
+	}
+	if !x.DACOverride.Equal(y.DACOverride) {
+		return false
+	}
+	if !x.DACReadSearch.Equal(y.DACReadSearch) {
+		return false
+	}
+	if !x.FOwner.Equal(y.FOwner) {
+		return false
+	}
+	if !x.FSetID.Equal(y.FSetID) {
+		return false
+	}
+	if !x.IPCLock.Equal(y.IPCLock) {
+		return false
+	}
+	if !x.IPCOwner.Equal(y.IPCOwner) {
+		return false
+	}
+	if !x.Kill.Equal(y.Kill) {
+		return false
+	}
+	if !x.Lease.Equal(y.Lease) {
+		return false
+	}
+	if !x.LinuxImmutable.Equal(y.LinuxImmutable) {
+		return false
+	}
+	if !x.MACAdmin.Equal(y.MACAdmin) {
+		return false
+	}
+	if !x.MACOverride.Equal(y.MACOverride) {
+		return false
+	}
+	if !x.MkNod.Equal(y.MkNod) {
+		return false
+	}
+	if !x.NetAdmin.Equal(y.NetAdmin) {
+		return false
+	}
+	if !x.NetBindService.Equal(y.NetBindService) {
+		return false
+	}
+	if !x.NetBroadcast.Equal(y.NetBroadcast) {
+		return false
+	}
+	if !x.NetRaw.Equal(y.NetRaw) {
+		return false
+	}
+	if !x.SetGID.Equal(y.SetGID) {
+		return false
+	}
+	if !x.SetFCap.Equal(y.SetFCap) {
+		return false
+	}
+	if !x.SetPCap.Equal(y.SetPCap) {
+		return false
+	}
+	if !x.SetUID.Equal(y.SetUID) {
+		return false
+	}
+	if !x.SysAdmin.Equal(y.SysAdmin) {
+		return false
+	}
+	if !x.SysBoot.Equal(y.SysBoot) {
+		return false
+	}
+	if !x.SysChRoot.Equal(y.SysChRoot) {
+		return false
+	}
+	if !x.SysModule.Equal(y.SysModule) {
+		return false
+	}
+	if !x.SysNice.Equal(y.SysNice) {
+		return false
+	}
+	if !x.SysPAcct.Equal(y.SysPAcct) {
+		return false
+	}
+	if !x.SysPTrace.Equal(y.SysPTrace) {
+		return false
+	}
+	if !x.SysRawIO.Equal(y.SysRawIO) {
+		return false
+	}
+	if !x.SysResource.Equal(y.SysResource) {
+		return false
+	}
+	if !x.SysTime.Equal(y.SysTime) {
+		return false
+	}
+	if !x.SysTTYCnofig.Equal(y.SysTTYCnofig) {
+		return false
+	}
+	if !x.SysLog.Equal(y.SysLog) {
+		return false
+	}
+	if !x.WakeAlarm.Equal(y.WakeAlarm) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureMSRS) Clone() *DomainFeatureMSRS {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureMSRS) Equal(y *DomainFeatureMSRS) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Unknown != y.Unknown {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureCFPC) Clone() *DomainFeatureCFPC {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureCFPC) Equal(y *DomainFeatureCFPC) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureSBBC) Clone() *DomainFeatureSBBC {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureSBBC) Equal(y *DomainFeatureSBBC) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureIBS) Clone() *DomainFeatureIBS {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureIBS) Equal(y *DomainFeatureIBS) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainFeatureList) Clone() *DomainFeatureList {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.PAE = x.PAE.Clone()
+	y.ACPI = x.ACPI.Clone()
+	y.APIC = x.APIC.Clone()
+	y.HAP = x.HAP.Clone()
+	y.Viridian = x.Viridian.Clone()
+	y.PrivNet = x.PrivNet.Clone()
+	y.HyperV = x.HyperV.Clone()
+	y.KVM = x.KVM.Clone()
+	y.Xen = x.Xen.Clone()
+	y.PVSpinlock = x.PVSpinlock.Clone()
+	y.PMU = x.PMU.Clone()
+	y.VMPort = x.VMPort.Clone()
+	y.GIC = x.GIC.Clone()
+	y.SMM = x.SMM.Clone()
+	y.IOAPIC = x.IOAPIC.Clone()
+	y.HPT = x.HPT.Clone()
+	y.HTM = x.HTM.Clone()
+	y.NestedHV = x.NestedHV.Clone()
+	y.Capabilities = x.Capabilities.Clone()
+	y.VMCoreInfo = x.VMCoreInfo.Clone()
+	y.MSRS = x.MSRS.Clone()
+	y.CCFAssist = x.CCFAssist.Clone()
+	y.CFPC = x.CFPC.Clone()
+	y.SBBC = x.SBBC.Clone()
+	y.IBS = x.IBS.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainFeatureList) Equal(y *DomainFeatureList) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.PAE.Equal(y.PAE) {
+		return false
+	}
+	if !x.ACPI.Equal(y.ACPI) {
+		return false
+	}
+	if !x.APIC.Equal(y.APIC) {
+		return false
+	}
+	if !x.HAP.Equal(y.HAP) {
+		return false
+	}
+	if !x.Viridian.Equal(y.Viridian) {
+		return false
+	}
+	if !x.PrivNet.Equal(y.PrivNet) {
+		return false
+	}
+	if !x.HyperV.Equal(y.HyperV) {
+		return false
+	}
+	if !x.KVM.Equal(y.KVM) {
+		return false
+	}
+	if !x.Xen.Equal(y.Xen) {
+		return false
+	}
+	if !x.PVSpinlock.Equal(y.PVSpinlock) {
+		return false
+	}
+	if !x.PMU.Equal(y.PMU) {
+		return false
+	}
+	if !x.VMPort.Equal(y.VMPort) {
+		return false
+	}
+	if !x.GIC.Equal(y.GIC) {
+		return false
+	}
+	if !x.SMM.Equal(y.SMM) {
+		return false
+	}
+	if !x.IOAPIC.Equal(y.IOAPIC) {
+		return false
+	}
+	if !x.HPT.Equal(y.HPT) {
+		return false
+	}
+	if !x.HTM.Equal(y.HTM) {
+		return false
+	}
+	if !x.NestedHV.Equal(y.NestedHV) {
+		return false
+	}
+	if !x.Capabilities.Equal(y.Capabilities) {
+		return false
+	}
+	if !x.VMCoreInfo.Equal(y.VMCoreInfo) {
+		return false
+	}
+	if !x.MSRS.Equal(y.MSRS) {
+		return false
+	}
+	if !x.CCFAssist.Equal(y.CCFAssist) {
+		return false
+	}
+	if !x.CFPC.Equal(y.CFPC) {
+		return false
+	}
+	if !x.SBBC.Equal(y.SBBC) {
+		return false
+	}
+	if !x.IBS.Equal(y.IBS) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCPUTuneShares) Clone() *DomainCPUTuneShares {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCPUTuneShares) Equal(y *DomainCPUTuneShares) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCPUTunePeriod) Clone() *DomainCPUTunePeriod {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCPUTunePeriod) Equal(y *DomainCPUTunePeriod) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCPUTuneQuota) Clone() *DomainCPUTuneQuota {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCPUTuneQuota) Equal(y *DomainCPUTuneQuota) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCPUTuneVCPUPin) Clone() *DomainCPUTuneVCPUPin {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCPUTuneVCPUPin) Equal(y *DomainCPUTuneVCPUPin) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.VCPU != y.VCPU {
+		return false
+	}
+	if x.CPUSet != y.CPUSet {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCPUTuneEmulatorPin) Clone() *DomainCPUTuneEmulatorPin {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCPUTuneEmulatorPin) Equal(y *DomainCPUTuneEmulatorPin) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.CPUSet != y.CPUSet {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCPUTuneIOThreadPin) Clone() *DomainCPUTuneIOThreadPin {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCPUTuneIOThreadPin) Equal(y *DomainCPUTuneIOThreadPin) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.IOThread != y.IOThread {
+		return false
+	}
+	if x.CPUSet != y.CPUSet {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCPUTuneVCPUSched) Clone() *DomainCPUTuneVCPUSched {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Priority != nil {
+		v := *x.Priority
+		y.Priority = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCPUTuneVCPUSched) Equal(y *DomainCPUTuneVCPUSched) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.VCPUs != y.VCPUs {
+		return false
+	}
+	if x.Scheduler != y.Scheduler {
+		return false
+	}
+	if (x.Priority == nil) != (y.Priority == nil) {
+		return false
+	}
+	if x.Priority != nil {
+		if (*x.Priority) != (*y.Priority) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCPUTuneIOThreadSched) Clone() *DomainCPUTuneIOThreadSched {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Priority != nil {
+		v := *x.Priority
+		y.Priority = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCPUTuneIOThreadSched) Equal(y *DomainCPUTuneIOThreadSched) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.IOThreads != y.IOThreads {
+		return false
+	}
+	if x.Scheduler != y.Scheduler {
+		return false
+	}
+	if (x.Priority == nil) != (y.Priority == nil) {
+		return false
+	}
+	if x.Priority != nil {
+		if (*x.Priority) != (*y.Priority) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCPUTuneEmulatorSched) Clone() *DomainCPUTuneEmulatorSched {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Priority != nil {
+		v := *x.Priority
+		y.Priority = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCPUTuneEmulatorSched) Equal(y *DomainCPUTuneEmulatorSched) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Scheduler != y.Scheduler {
+		return false
+	}
+	if (x.Priority == nil) != (y.Priority == nil) {
+		return false
+	}
+	if x.Priority != nil {
+		if (*x.Priority) != (*y.Priority) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCPUCacheTune) Clone() *DomainCPUCacheTune {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Cache != nil {
+		y.Cache = make([]DomainCPUCacheTuneCache, len(x.Cache))
+		copy(y.Cache, x.Cache)
+	}
+	if x.Monitor != nil {
+		y.Monitor = make([]DomainCPUCacheTuneMonitor, len(x.Monitor))
+		copy(y.Monitor, x.Monitor)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCPUCacheTune) Equal(y *DomainCPUCacheTune) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.VCPUs != y.VCPUs {
+		return false
+	}
+	if len(x.Cache) != len(y.Cache) {
+		return false
+	}
+	for i := range x.Cache {
+		if !x.Cache[i].Equal(&y.Cache[i]) {
+			return false
+		}
+	}
+	if len(x.Monitor) != len(y.Monitor) {
+		return false
+	}
+	for i := range x.Monitor {
+		if !x.Monitor[i].Equal(&y.Monitor[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCPUCacheTuneCache) Clone() *DomainCPUCacheTuneCache {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCPUCacheTuneCache) Equal(y *DomainCPUCacheTuneCache) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ID != y.ID {
+		return false
+	}
+	if x.Level != y.Level {
+		return false
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Size != y.Size {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCPUCacheTuneMonitor) Clone() *DomainCPUCacheTuneMonitor {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCPUCacheTuneMonitor) Equal(y *DomainCPUCacheTuneMonitor) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Level != y.Level {
+		return false
+	}
+	if x.VCPUs != y.VCPUs {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCPUMemoryTune) Clone() *DomainCPUMemoryTune {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Nodes != nil {
+		y.Nodes = make([]DomainCPUMemoryTuneNode, len(x.Nodes))
+		copy(y.Nodes, x.Nodes)
+	}
+	if x.Monitor != nil {
+		y.Monitor = make([]DomainCPUMemoryTuneMonitor, len(x.Monitor))
+		copy(y.Monitor, x.Monitor)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCPUMemoryTune) Equal(y *DomainCPUMemoryTune) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.VCPUs != y.VCPUs {
+		return false
+	}
+	if len(x.Nodes) != len(y.Nodes) {
+		return false
+	}
+	for i := range x.Nodes {
+		if !x.Nodes[i].Equal(&y.Nodes[i]) {
+			return false
+		}
+	}
+	if len(x.Monitor) != len(y.Monitor) {
+		return false
+	}
+	for i := range x.Monitor {
+		if !x.Monitor[i].Equal(&y.Monitor[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCPUMemoryTuneNode) Clone() *DomainCPUMemoryTuneNode {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCPUMemoryTuneNode) Equal(y *DomainCPUMemoryTuneNode) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ID != y.ID {
+		return false
+	}
+	if x.Bandwidth != y.Bandwidth {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCPUMemoryTuneMonitor) Clone() *DomainCPUMemoryTuneMonitor {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCPUMemoryTuneMonitor) Equal(y *DomainCPUMemoryTuneMonitor) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Level != y.Level {
+		return false
+	}
+	if x.VCPUs != y.VCPUs {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCPUTune) Clone() *DomainCPUTune {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Shares = x.Shares.Clone()
+	y.Period = x.Period.Clone()
+	y.Quota = x.Quota.Clone()
+	y.GlobalPeriod = x.GlobalPeriod.Clone()
+	y.GlobalQuota = x.GlobalQuota.Clone()
+	y.EmulatorPeriod = x.EmulatorPeriod.Clone()
+	y.EmulatorQuota = x.EmulatorQuota.Clone()
+	y.IOThreadPeriod = x.IOThreadPeriod.Clone()
+	y.IOThreadQuota = x.IOThreadQuota.Clone()
+	if x.VCPUPin != nil {
+		y.VCPUPin = make([]DomainCPUTuneVCPUPin, len(x.VCPUPin))
+		copy(y.VCPUPin, x.VCPUPin)
+	}
+	y.EmulatorPin = x.EmulatorPin.Clone()
+	if x.IOThreadPin != nil {
+		y.IOThreadPin = make([]DomainCPUTuneIOThreadPin, len(x.IOThreadPin))
+		copy(y.IOThreadPin, x.IOThreadPin)
+	}
+	if x.VCPUSched != nil {
+		y.VCPUSched = make([]DomainCPUTuneVCPUSched, len(x.VCPUSched))
+		copy(y.VCPUSched, x.VCPUSched)
+		for i := range x.VCPUSched {
+			y.VCPUSched[i] = *x.VCPUSched[i].Clone()
+		}
+	}
+	y.EmulatorSched = x.EmulatorSched.Clone()
+	if x.IOThreadSched != nil {
+		y.IOThreadSched = make([]DomainCPUTuneIOThreadSched, len(x.IOThreadSched))
+		copy(y.IOThreadSched, x.IOThreadSched)
+		for i := range x.IOThreadSched {
+			y.IOThreadSched[i] = *x.IOThreadSched[i].Clone()
+		}
+	}
+	if x.CacheTune != nil {
+		y.CacheTune = make([]DomainCPUCacheTune, len(x.CacheTune))
+		copy(y.CacheTune, x.CacheTune)
+		for i := range x.CacheTune {
+			y.CacheTune[i] = *x.CacheTune[i].Clone()
+		}
+	}
+	if x.MemoryTune != nil {
+		y.MemoryTune = make([]DomainCPUMemoryTune, len(x.MemoryTune))
+		copy(y.MemoryTune, x.MemoryTune)
+		for i := range x.MemoryTune {
+			y.MemoryTune[i] = *x.MemoryTune[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCPUTune) Equal(y *DomainCPUTune) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Shares.Equal(y.Shares) {
+		return false
+	}
+	if !x.Period.Equal(y.Period) {
+		return false
+	}
+	if !x.Quota.Equal(y.Quota) {
+		return false
+	}
+	if !x.GlobalPeriod.Equal(y.GlobalPeriod) {
+		return false
+	}
+	if !x.GlobalQuota.Equal(y.GlobalQuota) {
+		return false
+	}
+	if !x.EmulatorPeriod.Equal(y.EmulatorPeriod) {
+		return false
+	}
+	if !x.EmulatorQuota.Equal(y.EmulatorQuota) {
+		return false
+	}
+	if !x.IOThreadPeriod.Equal(y.IOThreadPeriod) {
+		return false
+	}
+	if !x.IOThreadQuota.Equal(y.IOThreadQuota) {
+		return false
+	}
+	if len(x.VCPUPin) != len(y.VCPUPin) {
+		return false
+	}
+	for i := range x.VCPUPin {
+		if !x.VCPUPin[i].Equal(&y.VCPUPin[i]) {
+			return false
+		}
+	}
+	if !x.EmulatorPin.Equal(y.EmulatorPin) {
+		return false
+	}
+	if len(x.IOThreadPin) != len(y.IOThreadPin) {
+		return false
+	}
+	for i := range x.IOThreadPin {
+		if !x.IOThreadPin[i].Equal(&y.IOThreadPin[i]) {
+			return false
+		}
+	}
+	if len(x.VCPUSched) != len(y.VCPUSched) {
+		return false
+	}
+	for i := range x.VCPUSched {
+		if !x.VCPUSched[i].Equal(&y.VCPUSched[i]) {
+			return false
+		}
+	}
+	if !x.EmulatorSched.Equal(y.EmulatorSched) {
+		return false
+	}
+	if len(x.IOThreadSched) != len(y.IOThreadSched) {
+		return false
+	}
+	for i := range x.IOThreadSched {
+		if !x.IOThreadSched[i].Equal(&y.IOThreadSched[i]) {
+			return false
+		}
+	}
+	if len(x.CacheTune) != len(y.CacheTune) {
+		return false
+	}
+	for i := range x.CacheTune {
+		if !x.CacheTune[i].Equal(&y.CacheTune[i]) {
+			return false
+		}
+	}
+	if len(x.MemoryTune) != len(y.MemoryTune) {
+		return false
+	}
+	for i := range x.MemoryTune {
+		if !x.MemoryTune[i].Equal(&y.MemoryTune[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainQEMUCommandlineArg) Clone() *DomainQEMUCommandlineArg {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainQEMUCommandlineArg) Equal(y *DomainQEMUCommandlineArg) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainQEMUCommandlineEnv) Clone() *DomainQEMUCommandlineEnv {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainQEMUCommandlineEnv) Equal(y *DomainQEMUCommandlineEnv) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainQEMUCommandline) Clone() *DomainQEMUCommandline {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Args != nil {
+		y.Args = make([]DomainQEMUCommandlineArg, len(x.Args))
+		copy(y.Args, x.Args)
+	}
+	if x.Envs != nil {
+		y.Envs = make([]DomainQEMUCommandlineEnv, len(x.Envs))
+		copy(y.Envs, x.Envs)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainQEMUCommandline) Equal(y *DomainQEMUCommandline) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Args) != len(y.Args) {
+		return false
+	}
+	for i := range x.Args {
+		if !x.Args[i].Equal(&y.Args[i]) {
+			return false
+		}
+	}
+	if len(x.Envs) != len(y.Envs) {
+		return false
+	}
+	for i := range x.Envs {
+		if !x.Envs[i].Equal(&y.Envs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainQEMUCapabilitiesEntry) Clone() *DomainQEMUCapabilitiesEntry {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainQEMUCapabilitiesEntry) Equal(y *DomainQEMUCapabilitiesEntry) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainQEMUCapabilities) Clone() *DomainQEMUCapabilities {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Add != nil {
+		y.Add = make([]DomainQEMUCapabilitiesEntry, len(x.Add))
+		copy(y.Add, x.Add)
+	}
+	if x.Del != nil {
+		y.Del = make([]DomainQEMUCapabilitiesEntry, len(x.Del))
+		copy(y.Del, x.Del)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainQEMUCapabilities) Equal(y *DomainQEMUCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Add) != len(y.Add) {
+		return false
+	}
+	for i := range x.Add {
+		if !x.Add[i].Equal(&y.Add[i]) {
+			return false
+		}
+	}
+	if len(x.Del) != len(y.Del) {
+		return false
+	}
+	for i := range x.Del {
+		if !x.Del[i].Equal(&y.Del[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainQEMUDeprecation) Clone() *DomainQEMUDeprecation {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainQEMUDeprecation) Equal(y *DomainQEMUDeprecation) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Behavior != y.Behavior {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainLXCNamespace) Clone() *DomainLXCNamespace {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.ShareNet = x.ShareNet.Clone()
+	y.ShareIPC = x.ShareIPC.Clone()
+	y.ShareUTS = x.ShareUTS.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainLXCNamespace) Equal(y *DomainLXCNamespace) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.ShareNet.Equal(y.ShareNet) {
+		return false
+	}
+	if !x.ShareIPC.Equal(y.ShareIPC) {
+		return false
+	}
+	if !x.ShareUTS.Equal(y.ShareUTS) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainLXCNamespaceMap) Clone() *DomainLXCNamespaceMap {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainLXCNamespaceMap) Equal(y *DomainLXCNamespaceMap) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainBHyveCommandlineArg) Clone() *DomainBHyveCommandlineArg {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainBHyveCommandlineArg) Equal(y *DomainBHyveCommandlineArg) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainBHyveCommandlineEnv) Clone() *DomainBHyveCommandlineEnv {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainBHyveCommandlineEnv) Equal(y *DomainBHyveCommandlineEnv) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainBHyveCommandline) Clone() *DomainBHyveCommandline {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Args != nil {
+		y.Args = make([]DomainBHyveCommandlineArg, len(x.Args))
+		copy(y.Args, x.Args)
+	}
+	if x.Envs != nil {
+		y.Envs = make([]DomainBHyveCommandlineEnv, len(x.Envs))
+		copy(y.Envs, x.Envs)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainBHyveCommandline) Equal(y *DomainBHyveCommandline) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Args) != len(y.Args) {
+		return false
+	}
+	for i := range x.Args {
+		if !x.Args[i].Equal(&y.Args[i]) {
+			return false
+		}
+	}
+	if len(x.Envs) != len(y.Envs) {
+		return false
+	}
+	for i := range x.Envs {
+		if !x.Envs[i].Equal(&y.Envs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainXenCommandlineArg) Clone() *DomainXenCommandlineArg {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainXenCommandlineArg) Equal(y *DomainXenCommandlineArg) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainXenCommandline) Clone() *DomainXenCommandline {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Args != nil {
+		y.Args = make([]DomainXenCommandlineArg, len(x.Args))
+		copy(y.Args, x.Args)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainXenCommandline) Equal(y *DomainXenCommandline) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Args) != len(y.Args) {
+		return false
+	}
+	for i := range x.Args {
+		if !x.Args[i].Equal(&y.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainBlockIOTune) Clone() *DomainBlockIOTune {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Device != nil {
+		y.Device = make([]DomainBlockIOTuneDevice, len(x.Device))
+		copy(y.Device, x.Device)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainBlockIOTune) Equal(y *DomainBlockIOTune) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Weight != y.Weight {
+		return false
+	}
+	if len(x.Device) != len(y.Device) {
+		return false
+	}
+	for i := range x.Device {
+		if !x.Device[i].Equal(&y.Device[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainBlockIOTuneDevice) Clone() *DomainBlockIOTuneDevice {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainBlockIOTuneDevice) Equal(y *DomainBlockIOTuneDevice) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	if x.Weight != y.Weight {
+		return false
+	}
+	if x.ReadIopsSec != y.ReadIopsSec {
+		return false
+	}
+	if x.WriteIopsSec != y.WriteIopsSec {
+		return false
+	}
+	if x.ReadBytesSec != y.ReadBytesSec {
+		return false
+	}
+	if x.WriteBytesSec != y.WriteBytesSec {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainPM) Clone() *DomainPM {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.SuspendToMem = x.SuspendToMem.Clone()
+	y.SuspendToDisk = x.SuspendToDisk.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainPM) Equal(y *DomainPM) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.SuspendToMem.Equal(y.SuspendToMem) {
+		return false
+	}
+	if !x.SuspendToDisk.Equal(y.SuspendToDisk) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainPMPolicy) Clone() *DomainPMPolicy {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainPMPolicy) Equal(y *DomainPMPolicy) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Enabled != y.Enabled {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSecLabel) Clone() *DomainSecLabel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSecLabel) Equal(y *DomainSecLabel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Model != y.Model {
+		return false
+	}
+	if x.Relabel != y.Relabel {
+		return false
+	}
+	if x.Label != y.Label {
+		return false
+	}
+	if x.ImageLabel != y.ImageLabel {
+		return false
+	}
+	if x.BaseLabel != y.BaseLabel {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainDeviceSecLabel) Clone() *DomainDeviceSecLabel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainDeviceSecLabel) Equal(y *DomainDeviceSecLabel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Model != y.Model {
+		return false
+	}
+	if x.LabelSkip != y.LabelSkip {
+		return false
+	}
+	if x.Relabel != y.Relabel {
+		return false
+	}
+	if x.Label != y.Label {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainNUMATune) Clone() *DomainNUMATune {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Memory = x.Memory.Clone()
+	if x.MemNodes != nil {
+		y.MemNodes = make([]DomainNUMATuneMemNode, len(x.MemNodes))
+		copy(y.MemNodes, x.MemNodes)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainNUMATune) Equal(y *DomainNUMATune) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Memory.Equal(y.Memory) {
+		return false
+	}
+	if len(x.MemNodes) != len(y.MemNodes) {
+		return false
+	}
+	for i := range x.MemNodes {
+		if !x.MemNodes[i].Equal(&y.MemNodes[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainNUMATuneMemory) Clone() *DomainNUMATuneMemory {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainNUMATuneMemory) Equal(y *DomainNUMATuneMemory) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	if x.Nodeset != y.Nodeset {
+		return false
+	}
+	if x.Placement != y.Placement {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainNUMATuneMemNode) Clone() *DomainNUMATuneMemNode {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainNUMATuneMemNode) Equal(y *DomainNUMATuneMemNode) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.CellID != y.CellID {
+		return false
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	if x.Nodeset != y.Nodeset {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainIOThreadIDs) Clone() *DomainIOThreadIDs {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.IOThreads != nil {
+		y.IOThreads = make([]DomainIOThread, len(x.IOThreads))
+		copy(y.IOThreads, x.IOThreads)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainIOThreadIDs) Equal(y *DomainIOThreadIDs) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.IOThreads) != len(y.IOThreads) {
+		return false
+	}
+	for i := range x.IOThreads {
+		if !x.IOThreads[i].Equal(&y.IOThreads[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainIOThread) Clone() *DomainIOThread {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainIOThread) Equal(y *DomainIOThread) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ID != y.ID {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainKeyWrap) Clone() *DomainKeyWrap {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Ciphers != nil {
+		y.Ciphers = make([]DomainKeyWrapCipher, len(x.Ciphers))
+		copy(y.Ciphers, x.Ciphers)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainKeyWrap) Equal(y *DomainKeyWrap) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Ciphers) != len(y.Ciphers) {
+		return false
+	}
+	for i := range x.Ciphers {
+		if !x.Ciphers[i].Equal(&y.Ciphers[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainKeyWrapCipher) Clone() *DomainKeyWrapCipher {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainKeyWrapCipher) Equal(y *DomainKeyWrapCipher) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.State != y.State {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainIDMap) Clone() *DomainIDMap {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.UIDs != nil {
+		y.UIDs = make([]DomainIDMapRange, len(x.UIDs))
+		copy(y.UIDs, x.UIDs)
+	}
+	if x.GIDs != nil {
+		y.GIDs = make([]DomainIDMapRange, len(x.GIDs))
+		copy(y.GIDs, x.GIDs)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainIDMap) Equal(y *DomainIDMap) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.UIDs) != len(y.UIDs) {
+		return false
+	}
+	for i := range x.UIDs {
+		if !x.UIDs[i].Equal(&y.UIDs[i]) {
+			return false
+		}
+	}
+	if len(x.GIDs) != len(y.GIDs) {
+		return false
+	}
+	for i := range x.GIDs {
+		if !x.GIDs[i].Equal(&y.GIDs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainIDMapRange) Clone() *DomainIDMapRange {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainIDMapRange) Equal(y *DomainIDMapRange) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Start != y.Start {
+		return false
+	}
+	if x.Target != y.Target {
+		return false
+	}
+	if x.Count != y.Count {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemoryTuneLimit) Clone() *DomainMemoryTuneLimit {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemoryTuneLimit) Equal(y *DomainMemoryTuneLimit) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMemoryTune) Clone() *DomainMemoryTune {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.HardLimit = x.HardLimit.Clone()
+	y.SoftLimit = x.SoftLimit.Clone()
+	y.MinGuarantee = x.MinGuarantee.Clone()
+	y.SwapHardLimit = x.SwapHardLimit.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMemoryTune) Equal(y *DomainMemoryTune) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.HardLimit.Equal(y.HardLimit) {
+		return false
+	}
+	if !x.SoftLimit.Equal(y.SoftLimit) {
+		return false
+	}
+	if !x.MinGuarantee.Equal(y.MinGuarantee) {
+		return false
+	}
+	if !x.SwapHardLimit.Equal(y.SwapHardLimit) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainMetadata) Clone() *DomainMetadata {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainMetadata) Equal(y *DomainMetadata) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.XML != y.XML {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainVMWareDataCenterPath) Clone() *DomainVMWareDataCenterPath {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainVMWareDataCenterPath) Equal(y *DomainVMWareDataCenterPath) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainPerf) Clone() *DomainPerf {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Events != nil {
+		y.Events = make([]DomainPerfEvent, len(x.Events))
+		copy(y.Events, x.Events)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainPerf) Equal(y *DomainPerf) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Events) != len(y.Events) {
+		return false
+	}
+	for i := range x.Events {
+		if !x.Events[i].Equal(&y.Events[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainPerfEvent) Clone() *DomainPerfEvent {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainPerfEvent) Equal(y *DomainPerfEvent) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Enabled != y.Enabled {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainGenID) Clone() *DomainGenID {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainGenID) Equal(y *DomainGenID) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *Domain) Clone() *Domain {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.ID != nil {
+		v := *x.ID
+		y.ID = &v
+	}
+	y.GenID = x.GenID.Clone()
+	y.Metadata = x.Metadata.Clone()
+	y.MaximumMemory = x.MaximumMemory.Clone()
+	y.Memory = x.Memory.Clone()
+	y.CurrentMemory = x.CurrentMemory.Clone()
+	y.BlockIOTune = x.BlockIOTune.Clone()
+	y.MemoryTune = x.MemoryTune.Clone()
+	y.MemoryBacking = x.MemoryBacking.Clone()
+	y.VCPU = x.VCPU.Clone()
+	y.VCPUs = x.VCPUs.Clone()
+	y.IOThreadIDs = x.IOThreadIDs.Clone()
+	y.CPUTune = x.CPUTune.Clone()
+	y.NUMATune = x.NUMATune.Clone()
+	y.Resource = x.Resource.Clone()
+	if x.SysInfo != nil {
+		y.SysInfo = make([]DomainSysInfo, len(x.SysInfo))
+		copy(y.SysInfo, x.SysInfo)
+		for i := range x.SysInfo {
+			y.SysInfo[i] = *x.SysInfo[i].Clone()
+		}
+	}
+	y.OS = x.OS.Clone()
+	y.IDMap = x.IDMap.Clone()
+	y.Features = x.Features.Clone()
+	y.CPU = x.CPU.Clone()
+	y.Clock = x.Clock.Clone()
+	y.PM = x.PM.Clone()
+	y.Perf = x.Perf.Clone()
+	y.Devices = x.Devices.Clone()
+	if x.SecLabel != nil {
+		y.SecLabel = make([]DomainSecLabel, len(x.SecLabel))
+		copy(y.SecLabel, x.SecLabel)
+	}
+	y.KeyWrap = x.KeyWrap.Clone()
+	y.LaunchSecurity = x.LaunchSecurity.Clone()
+	y.QEMUCommandline = x.QEMUCommandline.Clone()
+	y.QEMUCapabilities = x.QEMUCapabilities.Clone()
+	y.QEMUDeprecation = x.QEMUDeprecation.Clone()
+	y.LXCNamespace = x.LXCNamespace.Clone()
+	y.BHyveCommandline = x.BHyveCommandline.Clone()
+	y.VMWareDataCenterPath = x.VMWareDataCenterPath.Clone()
+	y.XenCommandline = x.XenCommandline.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *Domain) Equal(y *Domain) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if (x.ID == nil) != (y.ID == nil) {
+		return false
+	}
+	if x.ID != nil {
+		if (*x.ID) != (*y.ID) {
+			return false
+		}
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.UUID != y.UUID {
+		return false
+	}
+	if !x.GenID.Equal(y.GenID) {
+		return false
+	}
+	if x.Title != y.Title {
+		return false
+	}
+	if x.Description != y.Description {
+		return false
+	}
+	if !x.Metadata.Equal(y.Metadata) {
+		return false
+	}
+	if !x.MaximumMemory.Equal(y.MaximumMemory) {
+		return false
+	}
+	if !x.Memory.Equal(y.Memory) {
+		return false
+	}
+	if !x.CurrentMemory.Equal(y.CurrentMemory) {
+		return false
+	}
+	if !x.BlockIOTune.Equal(y.BlockIOTune) {
+		return false
+	}
+	if !x.MemoryTune.Equal(y.MemoryTune) {
+		return false
+	}
+	if !x.MemoryBacking.Equal(y.MemoryBacking) {
+		return false
+	}
+	if !x.VCPU.Equal(y.VCPU) {
+		return false
+	}
+	if !x.VCPUs.Equal(y.VCPUs) {
+		return false
+	}
+	if x.IOThreads != y.IOThreads {
+		return false
+	}
+	if !x.IOThreadIDs.Equal(y.IOThreadIDs) {
+		return false
+	}
+	if !x.CPUTune.Equal(y.CPUTune) {
+		return false
+	}
+	if !x.NUMATune.Equal(y.NUMATune) {
+		return false
+	}
+	if !x.Resource.Equal(y.Resource) {
+		return false
+	}
+	if len(x.SysInfo) != len(y.SysInfo) {
+		return false
+	}
+	for i := range x.SysInfo {
+		if !x.SysInfo[i].Equal(&y.SysInfo[i]) {
+			return false
+		}
+	}
+	if x.Bootloader != y.Bootloader {
+		return false
+	}
+	if x.BootloaderArgs != y.BootloaderArgs {
+		return false
+	}
+	if !x.OS.Equal(y.OS) {
+		return false
+	}
+	if !x.IDMap.Equal(y.IDMap) {
+		return false
+	}
+	if !x.Features.Equal(y.Features) {
+		return false
+	}
+	if !x.CPU.Equal(y.CPU) {
+		return false
+	}
+	if !x.Clock.Equal(y.Clock) {
+		return false
+	}
+	if x.OnPoweroff != y.OnPoweroff {
+		return false
+	}
+	if x.OnReboot != y.OnReboot {
+		return false
+	}
+	if x.OnCrash != y.OnCrash {
+		return false
+	}
+	if !x.PM.Equal(y.PM) {
+		return false
+	}
+	if !x.Perf.Equal(y.Perf) {
+		return false
+	}
+	if !x.Devices.Equal(y.Devices) {
+		return false
+	}
+	if len(x.SecLabel) != len(y.SecLabel) {
+		return false
+	}
+	for i := range x.SecLabel {
+		if !x.SecLabel[i].Equal(&y.SecLabel[i]) {
+			return false
+		}
+	}
+	if !x.KeyWrap.Equal(y.KeyWrap) {
+		return false
+	}
+	if !x.LaunchSecurity.Equal(y.LaunchSecurity) &&
+		!(x.LaunchSecurity == nil && y.LaunchSecurity.Equal(&DomainLaunchSecurity{})) &&
+		!(y.LaunchSecurity == nil && x.LaunchSecurity.Equal(&DomainLaunchSecurity{})) {
+		return false
+	}
+	if !x.QEMUCommandline.Equal(y.QEMUCommandline) {
+		return false
+	}
+	if !x.QEMUCapabilities.Equal(y.QEMUCapabilities) {
+		return false
+	}
+	if !x.QEMUDeprecation.Equal(y.QEMUDeprecation) {
+		return false
+	}
+	if !x.LXCNamespace.Equal(y.LXCNamespace) {
+		return false
+	}
+	if !x.BHyveCommandline.Equal(y.BHyveCommandline) {
+		return false
+	}
+	if !x.VMWareDataCenterPath.Equal(y.VMWareDataCenterPath) {
+		return false
+	}
+	if !x.XenCommandline.Equal(y.XenCommandline) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainBackupServer) Clone() *DomainBackupServer {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainBackupServer) Equal(y *DomainBackupServer) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Transport != y.Transport {
+		return false
+	}
+	if x.Socket != y.Socket {
+		return false
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Port != y.Port {
+		return false
+	}
+	if x.TLS != y.TLS {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainBackupDisk) Clone() *DomainBackupDisk {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Driver = x.Driver.Clone()
+	y.Target = x.Target.Clone()
+	y.Scratch = x.Scratch.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainBackupDisk) Equal(y *DomainBackupDisk) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Backup != y.Backup {
+		return false
+	}
+	if x.BackupMode != y.BackupMode {
+		return false
+	}
+	if x.Incremental != y.Incremental {
+		return false
+	}
+	if x.ExportName != y.ExportName {
+		return false
+	}
+	if x.ExportBitmap != y.ExportBitmap {
+		return false
+	}
+	if !x.Driver.Equal(y.Driver) {
+		return false
+	}
+	if !x.Target.Equal(y.Target) &&
+		!(x.Target == nil && y.Target.Equal(&DomainDiskSource{})) &&
+		!(y.Target == nil && x.Target.Equal(&DomainDiskSource{})) {
+		return false
+	}
+	if !x.Scratch.Equal(y.Scratch) &&
+		!(x.Scratch == nil && y.Scratch.Equal(&DomainDiskSource{})) &&
+		!(y.Scratch == nil && x.Scratch.Equal(&DomainDiskSource{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainBackupDisks) Clone() *DomainBackupDisks {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Disks != nil {
+		y.Disks = make([]DomainBackupDisk, len(x.Disks))
+		copy(y.Disks, x.Disks)
+		for i := range x.Disks {
+			y.Disks[i] = *x.Disks[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainBackupDisks) Equal(y *DomainBackupDisks) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Disks) != len(y.Disks) {
+		return false
+	}
+	for i := range x.Disks {
+		if !x.Disks[i].Equal(&y.Disks[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainBackup) Clone() *DomainBackup {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Server = x.Server.Clone()
+	y.Disks = x.Disks.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainBackup) Equal(y *DomainBackup) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	if x.Incremental != y.Incremental {
+		return false
+	}
+	if !x.Server.Equal(y.Server) {
+		return false
+	}
+	if !x.Disks.Equal(y.Disks) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCaps) Clone() *DomainCaps {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.VCPU = x.VCPU.Clone()
+	y.IOThreads = x.IOThreads.Clone()
+	y.OS = x.OS.Clone()
+	y.CPU = x.CPU.Clone()
+	y.Devices = x.Devices.Clone()
+	y.Features = x.Features.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCaps) Equal(y *DomainCaps) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	if x.Domain != y.Domain {
+		return false
+	}
+	if x.Machine != y.Machine {
+		return false
+	}
+	if x.Arch != y.Arch {
+		return false
+	}
+	if !x.VCPU.Equal(y.VCPU) {
+		return false
+	}
+	if !x.IOThreads.Equal(y.IOThreads) {
+		return false
+	}
+	if !x.OS.Equal(y.OS) {
+		return false
+	}
+	if !x.CPU.Equal(y.CPU) {
+		return false
+	}
+	if !x.Devices.Equal(y.Devices) {
+		return false
+	}
+	if !x.Features.Equal(y.Features) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCapsVCPU) Clone() *DomainCapsVCPU {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCapsVCPU) Equal(y *DomainCapsVCPU) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Max != y.Max {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCapsOS) Clone() *DomainCapsOS {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Loader = x.Loader.Clone()
+	if x.Enums != nil {
+		y.Enums = make([]DomainCapsEnum, len(x.Enums))
+		copy(y.Enums, x.Enums)
+		for i := range x.Enums {
+			y.Enums[i] = *x.Enums[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCapsOS) Equal(y *DomainCapsOS) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Supported != y.Supported {
+		return false
+	}
+	if !x.Loader.Equal(y.Loader) {
+		return false
+	}
+	if len(x.Enums) != len(y.Enums) {
+		return false
+	}
+	for i := range x.Enums {
+		if !x.Enums[i].Equal(&y.Enums[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCapsOSLoader) Clone() *DomainCapsOSLoader {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Values != nil {
+		y.Values = make([]string, len(x.Values))
+		copy(y.Values, x.Values)
+	}
+	if x.Enums != nil {
+		y.Enums = make([]DomainCapsEnum, len(x.Enums))
+		copy(y.Enums, x.Enums)
+		for i := range x.Enums {
+			y.Enums[i] = *x.Enums[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCapsOSLoader) Equal(y *DomainCapsOSLoader) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Supported != y.Supported {
+		return false
+	}
+	if len(x.Values) != len(y.Values) {
+		return false
+	}
+	for i := range x.Values {
+		if x.Values[i] != y.Values[i] {
+			return false
+		}
+	}
+	if len(x.Enums) != len(y.Enums) {
+		return false
+	}
+	for i := range x.Enums {
+		if !x.Enums[i].Equal(&y.Enums[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCapsIOThreads) Clone() *DomainCapsIOThreads {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCapsIOThreads) Equal(y *DomainCapsIOThreads) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Supported != y.Supported {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCapsCPU) Clone() *DomainCapsCPU {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Modes != nil {
+		y.Modes = make([]DomainCapsCPUMode, len(x.Modes))
+		copy(y.Modes, x.Modes)
+		for i := range x.Modes {
+			y.Modes[i] = *x.Modes[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCapsCPU) Equal(y *DomainCapsCPU) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Modes) != len(y.Modes) {
+		return false
+	}
+	for i := range x.Modes {
+		if !x.Modes[i].Equal(&y.Modes[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCapsCPUMode) Clone() *DomainCapsCPUMode {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Models != nil {
+		y.Models = make([]DomainCapsCPUModel, len(x.Models))
+		copy(y.Models, x.Models)
+	}
+	if x.Features != nil {
+		y.Features = make([]DomainCapsCPUFeature, len(x.Features))
+		copy(y.Features, x.Features)
+	}
+	if x.Enums != nil {
+		y.Enums = make([]DomainCapsEnum, len(x.Enums))
+		copy(y.Enums, x.Enums)
+		for i := range x.Enums {
+			y.Enums[i] = *x.Enums[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCapsCPUMode) Equal(y *DomainCapsCPUMode) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Supported != y.Supported {
+		return false
+	}
+	if len(x.Models) != len(y.Models) {
+		return false
+	}
+	for i := range x.Models {
+		if !x.Models[i].Equal(&y.Models[i]) {
+			return false
+		}
+	}
+	if x.Vendor != y.Vendor {
+		return false
+	}
+	if len(x.Features) != len(y.Features) {
+		return false
+	}
+	for i := range x.Features {
+		if !x.Features[i].Equal(&y.Features[i]) {
+			return false
+		}
+	}
+	if len(x.Enums) != len(y.Enums) {
+		return false
+	}
+	for i := range x.Enums {
+		if !x.Enums[i].Equal(&y.Enums[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCapsCPUModel) Clone() *DomainCapsCPUModel {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCapsCPUModel) Equal(y *DomainCapsCPUModel) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Usable != y.Usable {
+		return false
+	}
+	if x.Fallback != y.Fallback {
+		return false
+	}
+	if x.Deprecated != y.Deprecated {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCapsCPUFeature) Clone() *DomainCapsCPUFeature {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCapsCPUFeature) Equal(y *DomainCapsCPUFeature) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Policy != y.Policy {
+		return false
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCapsEnum) Clone() *DomainCapsEnum {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Values != nil {
+		y.Values = make([]string, len(x.Values))
+		copy(y.Values, x.Values)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCapsEnum) Equal(y *DomainCapsEnum) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if len(x.Values) != len(y.Values) {
+		return false
+	}
+	for i := range x.Values {
+		if x.Values[i] != y.Values[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCapsDevices) Clone() *DomainCapsDevices {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Disk = x.Disk.Clone()
+	y.Graphics = x.Graphics.Clone()
+	y.Video = x.Video.Clone()
+	y.HostDev = x.HostDev.Clone()
+	y.RNG = x.RNG.Clone()
+	y.FileSystem = x.FileSystem.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCapsDevices) Equal(y *DomainCapsDevices) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Disk.Equal(y.Disk) {
+		return false
+	}
+	if !x.Graphics.Equal(y.Graphics) {
+		return false
+	}
+	if !x.Video.Equal(y.Video) {
+		return false
+	}
+	if !x.HostDev.Equal(y.HostDev) {
+		return false
+	}
+	if !x.RNG.Equal(y.RNG) {
+		return false
+	}
+	if !x.FileSystem.Equal(y.FileSystem) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCapsDevice) Clone() *DomainCapsDevice {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Enums != nil {
+		y.Enums = make([]DomainCapsEnum, len(x.Enums))
+		copy(y.Enums, x.Enums)
+		for i := range x.Enums {
+			y.Enums[i] = *x.Enums[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCapsDevice) Equal(y *DomainCapsDevice) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Supported != y.Supported {
+		return false
+	}
+	if len(x.Enums) != len(y.Enums) {
+		return false
+	}
+	for i := range x.Enums {
+		if !x.Enums[i].Equal(&y.Enums[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCapsFeatures) Clone() *DomainCapsFeatures {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.GIC = x.GIC.Clone()
+	y.VMCoreInfo = x.VMCoreInfo.Clone()
+	y.GenID = x.GenID.Clone()
+	y.BackingStoreInput = x.BackingStoreInput.Clone()
+	y.Backup = x.Backup.Clone()
+	y.SEV = x.SEV.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCapsFeatures) Equal(y *DomainCapsFeatures) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.GIC.Equal(y.GIC) {
+		return false
+	}
+	if !x.VMCoreInfo.Equal(y.VMCoreInfo) {
+		return false
+	}
+	if !x.GenID.Equal(y.GenID) {
+		return false
+	}
+	if !x.BackingStoreInput.Equal(y.BackingStoreInput) {
+		return false
+	}
+	if !x.Backup.Equal(y.Backup) {
+		return false
+	}
+	if !x.SEV.Equal(y.SEV) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCapsFeatureGIC) Clone() *DomainCapsFeatureGIC {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Enums != nil {
+		y.Enums = make([]DomainCapsEnum, len(x.Enums))
+		copy(y.Enums, x.Enums)
+		for i := range x.Enums {
+			y.Enums[i] = *x.Enums[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCapsFeatureGIC) Equal(y *DomainCapsFeatureGIC) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Supported != y.Supported {
+		return false
+	}
+	if len(x.Enums) != len(y.Enums) {
+		return false
+	}
+	for i := range x.Enums {
+		if !x.Enums[i].Equal(&y.Enums[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCapsFeatureVMCoreInfo) Clone() *DomainCapsFeatureVMCoreInfo {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCapsFeatureVMCoreInfo) Equal(y *DomainCapsFeatureVMCoreInfo) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Supported != y.Supported {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCapsFeatureGenID) Clone() *DomainCapsFeatureGenID {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCapsFeatureGenID) Equal(y *DomainCapsFeatureGenID) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Supported != y.Supported {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCapsFeatureBackingStoreInput) Clone() *DomainCapsFeatureBackingStoreInput {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCapsFeatureBackingStoreInput) Equal(y *DomainCapsFeatureBackingStoreInput) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Supported != y.Supported {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCapsFeatureBackup) Clone() *DomainCapsFeatureBackup {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCapsFeatureBackup) Equal(y *DomainCapsFeatureBackup) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Supported != y.Supported {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCapsFeatureSEV) Clone() *DomainCapsFeatureSEV {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCapsFeatureSEV) Equal(y *DomainCapsFeatureSEV) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Supported != y.Supported {
+		return false
+	}
+	if x.CBitPos != y.CBitPos {
+		return false
+	}
+	if x.ReducedPhysBits != y.ReducedPhysBits {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCheckpointParent) Clone() *DomainCheckpointParent {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCheckpointParent) Equal(y *DomainCheckpointParent) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCheckpointDisk) Clone() *DomainCheckpointDisk {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCheckpointDisk) Equal(y *DomainCheckpointDisk) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Checkpoint != y.Checkpoint {
+		return false
+	}
+	if x.Bitmap != y.Bitmap {
+		return false
+	}
+	if x.Size != y.Size {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCheckpointDisks) Clone() *DomainCheckpointDisks {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Disks != nil {
+		y.Disks = make([]DomainCheckpointDisk, len(x.Disks))
+		copy(y.Disks, x.Disks)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCheckpointDisks) Equal(y *DomainCheckpointDisks) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Disks) != len(y.Disks) {
+		return false
+	}
+	for i := range x.Disks {
+		if !x.Disks[i].Equal(&y.Disks[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainCheckpoint) Clone() *DomainCheckpoint {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Parent = x.Parent.Clone()
+	y.Disks = x.Disks.Clone()
+	y.Domain = x.Domain.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainCheckpoint) Equal(y *DomainCheckpoint) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Description != y.Description {
+		return false
+	}
+	if x.State != y.State {
+		return false
+	}
+	if x.CreationTime != y.CreationTime {
+		return false
+	}
+	if !x.Parent.Equal(y.Parent) {
+		return false
+	}
+	if !x.Disks.Equal(y.Disks) {
+		return false
+	}
+	if !x.Domain.Equal(y.Domain) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSnapshotDisk) Clone() *DomainSnapshotDisk {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Driver = x.Driver.Clone()
+	y.Source = x.Source.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSnapshotDisk) Equal(y *DomainSnapshotDisk) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Snapshot != y.Snapshot {
+		return false
+	}
+	if !x.Driver.Equal(y.Driver) {
+		return false
+	}
+	if !x.Source.Equal(y.Source) &&
+		!(x.Source == nil && y.Source.Equal(&DomainDiskSource{})) &&
+		!(y.Source == nil && x.Source.Equal(&DomainDiskSource{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSnapshotDisks) Clone() *DomainSnapshotDisks {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Disks != nil {
+		y.Disks = make([]DomainSnapshotDisk, len(x.Disks))
+		copy(y.Disks, x.Disks)
+		for i := range x.Disks {
+			y.Disks[i] = *x.Disks[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSnapshotDisks) Equal(y *DomainSnapshotDisks) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Disks) != len(y.Disks) {
+		return false
+	}
+	for i := range x.Disks {
+		if !x.Disks[i].Equal(&y.Disks[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSnapshotMemory) Clone() *DomainSnapshotMemory {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSnapshotMemory) Equal(y *DomainSnapshotMemory) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Snapshot != y.Snapshot {
+		return false
+	}
+	if x.File != y.File {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSnapshotParent) Clone() *DomainSnapshotParent {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSnapshotParent) Equal(y *DomainSnapshotParent) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *DomainSnapshot) Clone() *DomainSnapshot {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Parent = x.Parent.Clone()
+	y.Memory = x.Memory.Clone()
+	y.Disks = x.Disks.Clone()
+	y.Domain = x.Domain.Clone()
+	if x.Active != nil {
+		v := *x.Active
+		y.Active = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *DomainSnapshot) Equal(y *DomainSnapshot) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Description != y.Description {
+		return false
+	}
+	if x.State != y.State {
+		return false
+	}
+	if x.CreationTime != y.CreationTime {
+		return false
+	}
+	if !x.Parent.Equal(y.Parent) {
+		return false
+	}
+	if !x.Memory.Equal(y.Memory) {
+		return false
+	}
+	if !x.Disks.Equal(y.Disks) {
+		return false
+	}
+	if !x.Domain.Equal(y.Domain) {
+		return false
+	}
+	if (x.Active == nil) != (y.Active == nil) {
+		return false
+	}
+	if x.Active != nil {
+		if (*x.Active) != (*y.Active) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *Interface) Clone() *Interface {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Start = x.Start.Clone()
+	y.MTU = x.MTU.Clone()
+	if x.Protocol != nil {
+		y.Protocol = make([]InterfaceProtocol, len(x.Protocol))
+		copy(y.Protocol, x.Protocol)
+		for i := range x.Protocol {
+			y.Protocol[i] = *x.Protocol[i].Clone()
+		}
+	}
+	y.Link = x.Link.Clone()
+	y.MAC = x.MAC.Clone()
+	y.Bond = x.Bond.Clone()
+	y.Bridge = x.Bridge.Clone()
+	y.VLAN = x.VLAN.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *Interface) Equal(y *Interface) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if !x.Start.Equal(y.Start) {
+		return false
+	}
+	if !x.MTU.Equal(y.MTU) {
+		return false
+	}
+	if len(x.Protocol) != len(y.Protocol) {
+		return false
+	}
+	for i := range x.Protocol {
+		if !x.Protocol[i].Equal(&y.Protocol[i]) {
+			return false
+		}
+	}
+	if !x.Link.Equal(y.Link) {
+		return false
+	}
+	if !x.MAC.Equal(y.MAC) {
+		return false
+	}
+	if !x.Bond.Equal(y.Bond) {
+		return false
+	}
+	if !x.Bridge.Equal(y.Bridge) {
+		return false
+	}
+	if !x.VLAN.Equal(y.VLAN) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *InterfaceStart) Clone() *InterfaceStart {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *InterfaceStart) Equal(y *InterfaceStart) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *InterfaceMTU) Clone() *InterfaceMTU {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *InterfaceMTU) Equal(y *InterfaceMTU) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Size != y.Size {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *InterfaceProtocol) Clone() *InterfaceProtocol {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.AutoConf = x.AutoConf.Clone()
+	y.DHCP = x.DHCP.Clone()
+	if x.IPs != nil {
+		y.IPs = make([]InterfaceIP, len(x.IPs))
+		copy(y.IPs, x.IPs)
+	}
+	if x.Route != nil {
+		y.Route = make([]InterfaceRoute, len(x.Route))
+		copy(y.Route, x.Route)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *InterfaceProtocol) Equal(y *InterfaceProtocol) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Family != y.Family {
+		return false
+	}
+	if !x.AutoConf.Equal(y.AutoConf) {
+		return false
+	}
+	if !x.DHCP.Equal(y.DHCP) {
+		return false
+	}
+	if len(x.IPs) != len(y.IPs) {
+		return false
+	}
+	for i := range x.IPs {
+		if !x.IPs[i].Equal(&y.IPs[i]) {
+			return false
+		}
+	}
+	if len(x.Route) != len(y.Route) {
+		return false
+	}
+	for i := range x.Route {
+		if !x.Route[i].Equal(&y.Route[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *InterfaceAutoConf) Clone() *InterfaceAutoConf {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *InterfaceAutoConf) Equal(y *InterfaceAutoConf) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *InterfaceDHCP) Clone() *InterfaceDHCP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *InterfaceDHCP) Equal(y *InterfaceDHCP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.PeerDNS != y.PeerDNS {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *InterfaceIP) Clone() *InterfaceIP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *InterfaceIP) Equal(y *InterfaceIP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	if x.Prefix != y.Prefix {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *InterfaceRoute) Clone() *InterfaceRoute {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *InterfaceRoute) Equal(y *InterfaceRoute) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Gateway != y.Gateway {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *InterfaceLink) Clone() *InterfaceLink {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *InterfaceLink) Equal(y *InterfaceLink) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Speed != y.Speed {
+		return false
+	}
+	if x.State != y.State {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *InterfaceMAC) Clone() *InterfaceMAC {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *InterfaceMAC) Equal(y *InterfaceMAC) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *InterfaceBond) Clone() *InterfaceBond {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.ARPMon = x.ARPMon.Clone()
+	y.MIIMon = x.MIIMon.Clone()
+	if x.Interfaces != nil {
+		y.Interfaces = make([]Interface, len(x.Interfaces))
+		copy(y.Interfaces, x.Interfaces)
+		for i := range x.Interfaces {
+			y.Interfaces[i] = *x.Interfaces[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *InterfaceBond) Equal(y *InterfaceBond) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	if !x.ARPMon.Equal(y.ARPMon) {
+		return false
+	}
+	if !x.MIIMon.Equal(y.MIIMon) {
+		return false
+	}
+	if len(x.Interfaces) != len(y.Interfaces) {
+		return false
+	}
+	for i := range x.Interfaces {
+		if !x.Interfaces[i].Equal(&y.Interfaces[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *InterfaceBondARPMon) Clone() *InterfaceBondARPMon {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *InterfaceBondARPMon) Equal(y *InterfaceBondARPMon) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Interval != y.Interval {
+		return false
+	}
+	if x.Target != y.Target {
+		return false
+	}
+	if x.Validate != y.Validate {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *InterfaceBondMIIMon) Clone() *InterfaceBondMIIMon {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *InterfaceBondMIIMon) Equal(y *InterfaceBondMIIMon) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Freq != y.Freq {
+		return false
+	}
+	if x.UpDelay != y.UpDelay {
+		return false
+	}
+	if x.Carrier != y.Carrier {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *InterfaceBridge) Clone() *InterfaceBridge {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Delay != nil {
+		v := *x.Delay
+		y.Delay = &v
+	}
+	if x.Interfaces != nil {
+		y.Interfaces = make([]Interface, len(x.Interfaces))
+		copy(y.Interfaces, x.Interfaces)
+		for i := range x.Interfaces {
+			y.Interfaces[i] = *x.Interfaces[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *InterfaceBridge) Equal(y *InterfaceBridge) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.STP != y.STP {
+		return false
+	}
+	if (x.Delay == nil) != (y.Delay == nil) {
+		return false
+	}
+	if x.Delay != nil {
+		if (*x.Delay) != (*y.Delay) {
+			return false
+		}
+	}
+	if len(x.Interfaces) != len(y.Interfaces) {
+		return false
+	}
+	for i := range x.Interfaces {
+		if !x.Interfaces[i].Equal(&y.Interfaces[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *InterfaceVLAN) Clone() *InterfaceVLAN {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Tag != nil {
+		v := *x.Tag
+		y.Tag = &v
+	}
+	y.Interface = x.Interface.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *InterfaceVLAN) Equal(y *InterfaceVLAN) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.Tag == nil) != (y.Tag == nil) {
+		return false
+	}
+	if x.Tag != nil {
+		if (*x.Tag) != (*y.Tag) {
+			return false
+		}
+	}
+	if !x.Interface.Equal(y.Interface) &&
+		!(x.Interface == nil && y.Interface.Equal(&Interface{})) &&
+		!(y.Interface == nil && x.Interface.Equal(&Interface{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkBridge) Clone() *NetworkBridge {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkBridge) Equal(y *NetworkBridge) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.STP != y.STP {
+		return false
+	}
+	if x.Delay != y.Delay {
+		return false
+	}
+	if x.MACTableManager != y.MACTableManager {
+		return false
+	}
+	if x.Zone != y.Zone {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkVirtualPort) Clone() *NetworkVirtualPort {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Params = x.Params.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkVirtualPort) Equal(y *NetworkVirtualPort) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Params.Equal(y.Params) &&
+		!(x.Params == nil && y.Params.Equal(&NetworkVirtualPortParams{})) &&
+		!(y.Params == nil && x.Params.Equal(&NetworkVirtualPortParams{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkVirtualPortParams) Clone() *NetworkVirtualPortParams {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Any = x.Any.Clone()
+	y.VEPA8021QBG = x.VEPA8021QBG.Clone()
+	y.VNTag8011QBH = x.VNTag8011QBH.Clone()
+	y.OpenVSwitch = x.OpenVSwitch.Clone()
+	y.MidoNet = x.MidoNet.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkVirtualPortParams) Equal(y *NetworkVirtualPortParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Any.Equal(y.Any) &&
+		!(x.Any == nil && y.Any.Equal(&NetworkVirtualPortParamsAny{})) &&
+		!(y.Any == nil && x.Any.Equal(&NetworkVirtualPortParamsAny{})) {
+		return false
+	}
+	if !x.VEPA8021QBG.Equal(y.VEPA8021QBG) &&
+		!(x.VEPA8021QBG == nil && y.VEPA8021QBG.Equal(&NetworkVirtualPortParamsVEPA8021QBG{})) &&
+		!(y.VEPA8021QBG == nil && x.VEPA8021QBG.Equal(&NetworkVirtualPortParamsVEPA8021QBG{})) {
+		return false
+	}
+	if !x.VNTag8011QBH.Equal(y.VNTag8011QBH) &&
+		!(x.VNTag8011QBH == nil && y.VNTag8011QBH.Equal(&NetworkVirtualPortParamsVNTag8021QBH{})) &&
+		!(y.VNTag8011QBH == nil && x.VNTag8011QBH.Equal(&NetworkVirtualPortParamsVNTag8021QBH{})) {
+		return false
+	}
+	if !x.OpenVSwitch.Equal(y.OpenVSwitch) &&
+		!(x.OpenVSwitch == nil && y.OpenVSwitch.Equal(&NetworkVirtualPortParamsOpenVSwitch{})) &&
+		!(y.OpenVSwitch == nil && x.OpenVSwitch.Equal(&NetworkVirtualPortParamsOpenVSwitch{})) {
+		return false
+	}
+	if !x.MidoNet.Equal(y.MidoNet) &&
+		!(x.MidoNet == nil && y.MidoNet.Equal(&NetworkVirtualPortParamsMidoNet{})) &&
+		!(y.MidoNet == nil && x.MidoNet.Equal(&NetworkVirtualPortParamsMidoNet{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkVirtualPortParamsAny) Clone() *NetworkVirtualPortParamsAny {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.ManagerID != nil {
+		v := *x.ManagerID
+		y.ManagerID = &v
+	}
+	if x.TypeID != nil {
+		v := *x.TypeID
+		y.TypeID = &v
+	}
+	if x.TypeIDVersion != nil {
+		v := *x.TypeIDVersion
+		y.TypeIDVersion = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkVirtualPortParamsAny) Equal(y *NetworkVirtualPortParamsAny) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.ManagerID == nil) != (y.ManagerID == nil) {
+		return false
+	}
+	if x.ManagerID != nil {
+		if (*x.ManagerID) != (*y.ManagerID) {
+			return false
+		}
+	}
+	if (x.TypeID == nil) != (y.TypeID == nil) {
+		return false
+	}
+	if x.TypeID != nil {
+		if (*x.TypeID) != (*y.TypeID) {
+			return false
+		}
+	}
+	if (x.TypeIDVersion == nil) != (y.TypeIDVersion == nil) {
+		return false
+	}
+	if x.TypeIDVersion != nil {
+		if (*x.TypeIDVersion) != (*y.TypeIDVersion) {
+			return false
+		}
+	}
+	if x.InstanceID != y.InstanceID {
+		return false
+	}
+	if x.ProfileID != y.ProfileID {
+		return false
+	}
+	if x.InterfaceID != y.InterfaceID {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkVirtualPortParamsVEPA8021QBG) Clone() *NetworkVirtualPortParamsVEPA8021QBG {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.ManagerID != nil {
+		v := *x.ManagerID
+		y.ManagerID = &v
+	}
+	if x.TypeID != nil {
+		v := *x.TypeID
+		y.TypeID = &v
+	}
+	if x.TypeIDVersion != nil {
+		v := *x.TypeIDVersion
+		y.TypeIDVersion = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkVirtualPortParamsVEPA8021QBG) Equal(y *NetworkVirtualPortParamsVEPA8021QBG) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.ManagerID == nil) != (y.ManagerID == nil) {
+		return false
+	}
+	if x.ManagerID != nil {
+		if (*x.ManagerID) != (*y.ManagerID) {
+			return false
+		}
+	}
+	if (x.TypeID == nil) != (y.TypeID == nil) {
+		return false
+	}
+	if x.TypeID != nil {
+		if (*x.TypeID) != (*y.TypeID) {
+			return false
+		}
+	}
+	if (x.TypeIDVersion == nil) != (y.TypeIDVersion == nil) {
+		return false
+	}
+	if x.TypeIDVersion != nil {
+		if (*x.TypeIDVersion) != (*y.TypeIDVersion) {
+			return false
+		}
+	}
+	if x.InstanceID != y.InstanceID {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkVirtualPortParamsVNTag8021QBH) Clone() *NetworkVirtualPortParamsVNTag8021QBH {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkVirtualPortParamsVNTag8021QBH) Equal(y *NetworkVirtualPortParamsVNTag8021QBH) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ProfileID != y.ProfileID {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkVirtualPortParamsOpenVSwitch) Clone() *NetworkVirtualPortParamsOpenVSwitch {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkVirtualPortParamsOpenVSwitch) Equal(y *NetworkVirtualPortParamsOpenVSwitch) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.InterfaceID != y.InterfaceID {
+		return false
+	}
+	if x.ProfileID != y.ProfileID {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkVirtualPortParamsMidoNet) Clone() *NetworkVirtualPortParamsMidoNet {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkVirtualPortParamsMidoNet) Equal(y *NetworkVirtualPortParamsMidoNet) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.InterfaceID != y.InterfaceID {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkDomain) Clone() *NetworkDomain {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkDomain) Equal(y *NetworkDomain) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.LocalOnly != y.LocalOnly {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkForwardNATAddress) Clone() *NetworkForwardNATAddress {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkForwardNATAddress) Equal(y *NetworkForwardNATAddress) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Start != y.Start {
+		return false
+	}
+	if x.End != y.End {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkForwardNATPort) Clone() *NetworkForwardNATPort {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkForwardNATPort) Equal(y *NetworkForwardNATPort) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Start != y.Start {
+		return false
+	}
+	if x.End != y.End {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkForwardNAT) Clone() *NetworkForwardNAT {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Addresses != nil {
+		y.Addresses = make([]NetworkForwardNATAddress, len(x.Addresses))
+		copy(y.Addresses, x.Addresses)
+	}
+	if x.Ports != nil {
+		y.Ports = make([]NetworkForwardNATPort, len(x.Ports))
+		copy(y.Ports, x.Ports)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkForwardNAT) Equal(y *NetworkForwardNAT) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.IPv6 != y.IPv6 {
+		return false
+	}
+	if len(x.Addresses) != len(y.Addresses) {
+		return false
+	}
+	for i := range x.Addresses {
+		if !x.Addresses[i].Equal(&y.Addresses[i]) {
+			return false
+		}
+	}
+	if len(x.Ports) != len(y.Ports) {
+		return false
+	}
+	for i := range x.Ports {
+		if !x.Ports[i].Equal(&y.Ports[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkForward) Clone() *NetworkForward {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Driver = x.Driver.Clone()
+	if x.PFs != nil {
+		y.PFs = make([]NetworkForwardPF, len(x.PFs))
+		copy(y.PFs, x.PFs)
+	}
+	y.NAT = x.NAT.Clone()
+	if x.Interfaces != nil {
+		y.Interfaces = make([]NetworkForwardInterface, len(x.Interfaces))
+		copy(y.Interfaces, x.Interfaces)
+	}
+	if x.Addresses != nil {
+		y.Addresses = make([]NetworkForwardAddress, len(x.Addresses))
+		copy(y.Addresses, x.Addresses)
+		for i := range x.Addresses {
+			y.Addresses[i] = *x.Addresses[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkForward) Equal(y *NetworkForward) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	if x.Dev != y.Dev {
+		return false
+	}
+	if x.Managed != y.Managed {
+		return false
+	}
+	if !x.Driver.Equal(y.Driver) {
+		return false
+	}
+	if len(x.PFs) != len(y.PFs) {
+		return false
+	}
+	for i := range x.PFs {
+		if !x.PFs[i].Equal(&y.PFs[i]) {
+			return false
+		}
+	}
+	if !x.NAT.Equal(y.NAT) {
+		return false
+	}
+	if len(x.Interfaces) != len(y.Interfaces) {
+		return false
+	}
+	for i := range x.Interfaces {
+		if !x.Interfaces[i].Equal(&y.Interfaces[i]) {
+			return false
+		}
+	}
+	if len(x.Addresses) != len(y.Addresses) {
+		return false
+	}
+	for i := range x.Addresses {
+		if !x.Addresses[i].Equal(&y.Addresses[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkForwardDriver) Clone() *NetworkForwardDriver {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkForwardDriver) Equal(y *NetworkForwardDriver) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkForwardPF) Clone() *NetworkForwardPF {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkForwardPF) Equal(y *NetworkForwardPF) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Dev != y.Dev {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkForwardAddress) Clone() *NetworkForwardAddress {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.PCI = x.PCI.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkForwardAddress) Equal(y *NetworkForwardAddress) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.PCI.Equal(y.PCI) &&
+		!(x.PCI == nil && y.PCI.Equal(&NetworkForwardAddressPCI{})) &&
+		!(y.PCI == nil && x.PCI.Equal(&NetworkForwardAddressPCI{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkForwardAddressPCI) Clone() *NetworkForwardAddressPCI {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Domain != nil {
+		v := *x.Domain
+		y.Domain = &v
+	}
+	if x.Bus != nil {
+		v := *x.Bus
+		y.Bus = &v
+	}
+	if x.Slot != nil {
+		v := *x.Slot
+		y.Slot = &v
+	}
+	if x.Function != nil {
+		v := *x.Function
+		y.Function = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkForwardAddressPCI) Equal(y *NetworkForwardAddressPCI) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.Domain == nil) != (y.Domain == nil) {
+		return false
+	}
+	if x.Domain != nil {
+		if (*x.Domain) != (*y.Domain) {
+			return false
+		}
+	}
+	if (x.Bus == nil) != (y.Bus == nil) {
+		return false
+	}
+	if x.Bus != nil {
+		if (*x.Bus) != (*y.Bus) {
+			return false
+		}
+	}
+	if (x.Slot == nil) != (y.Slot == nil) {
+		return false
+	}
+	if x.Slot != nil {
+		if (*x.Slot) != (*y.Slot) {
+			return false
+		}
+	}
+	if (x.Function == nil) != (y.Function == nil) {
+		return false
+	}
+	if x.Function != nil {
+		if (*x.Function) != (*y.Function) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkForwardInterface) Clone() *NetworkForwardInterface {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkForwardInterface) Equal(y *NetworkForwardInterface) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Dev != y.Dev {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkMAC) Clone() *NetworkMAC {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkMAC) Equal(y *NetworkMAC) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkDHCPRange) Clone() *NetworkDHCPRange {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Lease = x.Lease.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkDHCPRange) Equal(y *NetworkDHCPRange) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Start != y.Start {
+		return false
+	}
+	if x.End != y.End {
+		return false
+	}
+	if !x.Lease.Equal(y.Lease) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkDHCPLease) Clone() *NetworkDHCPLease {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkDHCPLease) Equal(y *NetworkDHCPLease) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Expiry != y.Expiry {
+		return false
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkDHCPHost) Clone() *NetworkDHCPHost {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Lease = x.Lease.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkDHCPHost) Equal(y *NetworkDHCPHost) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ID != y.ID {
+		return false
+	}
+	if x.MAC != y.MAC {
+		return false
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.IP != y.IP {
+		return false
+	}
+	if !x.Lease.Equal(y.Lease) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkBootp) Clone() *NetworkBootp {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkBootp) Equal(y *NetworkBootp) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.File != y.File {
+		return false
+	}
+	if x.Server != y.Server {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkDHCP) Clone() *NetworkDHCP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Ranges != nil {
+		y.Ranges = make([]NetworkDHCPRange, len(x.Ranges))
+		copy(y.Ranges, x.Ranges)
+		for i := range x.Ranges {
+			y.Ranges[i] = *x.Ranges[i].Clone()
+		}
+	}
+	if x.Hosts != nil {
+		y.Hosts = make([]NetworkDHCPHost, len(x.Hosts))
+		copy(y.Hosts, x.Hosts)
+		for i := range x.Hosts {
+			y.Hosts[i] = *x.Hosts[i].Clone()
+		}
+	}
+	if x.Bootp != nil {
+		y.Bootp = make([]NetworkBootp, len(x.Bootp))
+		copy(y.Bootp, x.Bootp)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkDHCP) Equal(y *NetworkDHCP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Ranges) != len(y.Ranges) {
+		return false
+	}
+	for i := range x.Ranges {
+		if !x.Ranges[i].Equal(&y.Ranges[i]) {
+			return false
+		}
+	}
+	if len(x.Hosts) != len(y.Hosts) {
+		return false
+	}
+	for i := range x.Hosts {
+		if !x.Hosts[i].Equal(&y.Hosts[i]) {
+			return false
+		}
+	}
+	if len(x.Bootp) != len(y.Bootp) {
+		return false
+	}
+	for i := range x.Bootp {
+		if !x.Bootp[i].Equal(&y.Bootp[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkIP) Clone() *NetworkIP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.DHCP = x.DHCP.Clone()
+	y.TFTP = x.TFTP.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkIP) Equal(y *NetworkIP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	if x.Family != y.Family {
+		return false
+	}
+	if x.Netmask != y.Netmask {
+		return false
+	}
+	if x.Prefix != y.Prefix {
+		return false
+	}
+	if x.LocalPtr != y.LocalPtr {
+		return false
+	}
+	if !x.DHCP.Equal(y.DHCP) {
+		return false
+	}
+	if !x.TFTP.Equal(y.TFTP) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkTFTP) Clone() *NetworkTFTP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkTFTP) Equal(y *NetworkTFTP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Root != y.Root {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkRoute) Clone() *NetworkRoute {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkRoute) Equal(y *NetworkRoute) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Family != y.Family {
+		return false
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	if x.Netmask != y.Netmask {
+		return false
+	}
+	if x.Prefix != y.Prefix {
+		return false
+	}
+	if x.Gateway != y.Gateway {
+		return false
+	}
+	if x.Metric != y.Metric {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkDNSForwarder) Clone() *NetworkDNSForwarder {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkDNSForwarder) Equal(y *NetworkDNSForwarder) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Domain != y.Domain {
+		return false
+	}
+	if x.Addr != y.Addr {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkDNSTXT) Clone() *NetworkDNSTXT {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkDNSTXT) Equal(y *NetworkDNSTXT) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkDNSHostHostname) Clone() *NetworkDNSHostHostname {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkDNSHostHostname) Equal(y *NetworkDNSHostHostname) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Hostname != y.Hostname {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkDNSHost) Clone() *NetworkDNSHost {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Hostnames != nil {
+		y.Hostnames = make([]NetworkDNSHostHostname, len(x.Hostnames))
+		copy(y.Hostnames, x.Hostnames)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkDNSHost) Equal(y *NetworkDNSHost) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.IP != y.IP {
+		return false
+	}
+	if len(x.Hostnames) != len(y.Hostnames) {
+		return false
+	}
+	for i := range x.Hostnames {
+		if !x.Hostnames[i].Equal(&y.Hostnames[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkDNSSRV) Clone() *NetworkDNSSRV {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkDNSSRV) Equal(y *NetworkDNSSRV) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Service != y.Service {
+		return false
+	}
+	if x.Protocol != y.Protocol {
+		return false
+	}
+	if x.Target != y.Target {
+		return false
+	}
+	if x.Port != y.Port {
+		return false
+	}
+	if x.Priority != y.Priority {
+		return false
+	}
+	if x.Weight != y.Weight {
+		return false
+	}
+	if x.Domain != y.Domain {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkDNS) Clone() *NetworkDNS {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Forwarders != nil {
+		y.Forwarders = make([]NetworkDNSForwarder, len(x.Forwarders))
+		copy(y.Forwarders, x.Forwarders)
+	}
+	if x.TXTs != nil {
+		y.TXTs = make([]NetworkDNSTXT, len(x.TXTs))
+		copy(y.TXTs, x.TXTs)
+	}
+	if x.Host != nil {
+		y.Host = make([]NetworkDNSHost, len(x.Host))
+		copy(y.Host, x.Host)
+		for i := range x.Host {
+			y.Host[i] = *x.Host[i].Clone()
+		}
+	}
+	if x.SRVs != nil {
+		y.SRVs = make([]NetworkDNSSRV, len(x.SRVs))
+		copy(y.SRVs, x.SRVs)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkDNS) Equal(y *NetworkDNS) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Enable != y.Enable {
+		return false
+	}
+	if x.ForwardPlainNames != y.ForwardPlainNames {
+		return false
+	}
+	if len(x.Forwarders) != len(y.Forwarders) {
+		return false
+	}
+	for i := range x.Forwarders {
+		if !x.Forwarders[i].Equal(&y.Forwarders[i]) {
+			return false
+		}
+	}
+	if len(x.TXTs) != len(y.TXTs) {
+		return false
+	}
+	for i := range x.TXTs {
+		if !x.TXTs[i].Equal(&y.TXTs[i]) {
+			return false
+		}
+	}
+	if len(x.Host) != len(y.Host) {
+		return false
+	}
+	for i := range x.Host {
+		if !x.Host[i].Equal(&y.Host[i]) {
+			return false
+		}
+	}
+	if len(x.SRVs) != len(y.SRVs) {
+		return false
+	}
+	for i := range x.SRVs {
+		if !x.SRVs[i].Equal(&y.SRVs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkMetadata) Clone() *NetworkMetadata {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkMetadata) Equal(y *NetworkMetadata) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.XML != y.XML {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkMTU) Clone() *NetworkMTU {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkMTU) Equal(y *NetworkMTU) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Size != y.Size {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *Network) Clone() *Network {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Metadata = x.Metadata.Clone()
+	y.Forward = x.Forward.Clone()
+	y.Bridge = x.Bridge.Clone()
+	y.MTU = x.MTU.Clone()
+	y.MAC = x.MAC.Clone()
+	y.Domain = x.Domain.Clone()
+	y.DNS = x.DNS.Clone()
+	y.VLAN = x.VLAN.Clone()
+	y.Bandwidth = x.Bandwidth.Clone()
+	y.PortOptions = x.PortOptions.Clone()
+	if x.IPs != nil {
+		y.IPs = make([]NetworkIP, len(x.IPs))
+		copy(y.IPs, x.IPs)
+		for i := range x.IPs {
+			y.IPs[i] = *x.IPs[i].Clone()
+		}
+	}
+	if x.Routes != nil {
+		y.Routes = make([]NetworkRoute, len(x.Routes))
+		copy(y.Routes, x.Routes)
+	}
+	y.VirtualPort = x.VirtualPort.Clone()
+	if x.PortGroups != nil {
+		y.PortGroups = make([]NetworkPortGroup, len(x.PortGroups))
+		copy(y.PortGroups, x.PortGroups)
+		for i := range x.PortGroups {
+			y.PortGroups[i] = *x.PortGroups[i].Clone()
+		}
+	}
+	y.DnsmasqOptions = x.DnsmasqOptions.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *Network) Equal(y *Network) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.IPv6 != y.IPv6 {
+		return false
+	}
+	if x.TrustGuestRxFilters != y.TrustGuestRxFilters {
+		return false
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.UUID != y.UUID {
+		return false
+	}
+	if !x.Metadata.Equal(y.Metadata) {
+		return false
+	}
+	if !x.Forward.Equal(y.Forward) {
+		return false
+	}
+	if !x.Bridge.Equal(y.Bridge) {
+		return false
+	}
+	if !x.MTU.Equal(y.MTU) {
+		return false
+	}
+	if !x.MAC.Equal(y.MAC) {
+		return false
+	}
+	if !x.Domain.Equal(y.Domain) {
+		return false
+	}
+	if !x.DNS.Equal(y.DNS) {
+		return false
+	}
+	if !x.VLAN.Equal(y.VLAN) {
+		return false
+	}
+	if !x.Bandwidth.Equal(y.Bandwidth) {
+		return false
+	}
+	if !x.PortOptions.Equal(y.PortOptions) {
+		return false
+	}
+	if len(x.IPs) != len(y.IPs) {
+		return false
+	}
+	for i := range x.IPs {
+		if !x.IPs[i].Equal(&y.IPs[i]) {
+			return false
+		}
+	}
+	if len(x.Routes) != len(y.Routes) {
+		return false
+	}
+	for i := range x.Routes {
+		if !x.Routes[i].Equal(&y.Routes[i]) {
+			return false
+		}
+	}
+	if !x.VirtualPort.Equal(y.VirtualPort) &&
+		!(x.VirtualPort == nil && y.VirtualPort.Equal(&NetworkVirtualPort{})) &&
+		!(y.VirtualPort == nil && x.VirtualPort.Equal(&NetworkVirtualPort{})) {
+		return false
+	}
+	if len(x.PortGroups) != len(y.PortGroups) {
+		return false
+	}
+	for i := range x.PortGroups {
+		if !x.PortGroups[i].Equal(&y.PortGroups[i]) {
+			return false
+		}
+	}
+	if !x.DnsmasqOptions.Equal(y.DnsmasqOptions) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkPortOptions) Clone() *NetworkPortOptions {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkPortOptions) Equal(y *NetworkPortOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Isolated != y.Isolated {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkPortGroup) Clone() *NetworkPortGroup {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.VLAN = x.VLAN.Clone()
+	y.VirtualPort = x.VirtualPort.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkPortGroup) Equal(y *NetworkPortGroup) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Default != y.Default {
+		return false
+	}
+	if x.TrustGuestRxFilters != y.TrustGuestRxFilters {
+		return false
+	}
+	if !x.VLAN.Equal(y.VLAN) {
+		return false
+	}
+	if !x.VirtualPort.Equal(y.VirtualPort) &&
+		!(x.VirtualPort == nil && y.VirtualPort.Equal(&NetworkVirtualPort{})) &&
+		!(y.VirtualPort == nil && x.VirtualPort.Equal(&NetworkVirtualPort{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkVLAN) Clone() *NetworkVLAN {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Tags != nil {
+		y.Tags = make([]NetworkVLANTag, len(x.Tags))
+		copy(y.Tags, x.Tags)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkVLAN) Equal(y *NetworkVLAN) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Trunk != y.Trunk {
+		return false
+	}
+	if len(x.Tags) != len(y.Tags) {
+		return false
+	}
+	for i := range x.Tags {
+		if !x.Tags[i].Equal(&y.Tags[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkVLANTag) Clone() *NetworkVLANTag {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkVLANTag) Equal(y *NetworkVLANTag) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ID != y.ID {
+		return false
+	}
+	if x.NativeMode != y.NativeMode {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkBandwidthParams) Clone() *NetworkBandwidthParams {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Average != nil {
+		v := *x.Average
+		y.Average = &v
+	}
+	if x.Peak != nil {
+		v := *x.Peak
+		y.Peak = &v
+	}
+	if x.Burst != nil {
+		v := *x.Burst
+		y.Burst = &v
+	}
+	if x.Floor != nil {
+		v := *x.Floor
+		y.Floor = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkBandwidthParams) Equal(y *NetworkBandwidthParams) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.Average == nil) != (y.Average == nil) {
+		return false
+	}
+	if x.Average != nil {
+		if (*x.Average) != (*y.Average) {
+			return false
+		}
+	}
+	if (x.Peak == nil) != (y.Peak == nil) {
+		return false
+	}
+	if x.Peak != nil {
+		if (*x.Peak) != (*y.Peak) {
+			return false
+		}
+	}
+	if (x.Burst == nil) != (y.Burst == nil) {
+		return false
+	}
+	if x.Burst != nil {
+		if (*x.Burst) != (*y.Burst) {
+			return false
+		}
+	}
+	if (x.Floor == nil) != (y.Floor == nil) {
+		return false
+	}
+	if x.Floor != nil {
+		if (*x.Floor) != (*y.Floor) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkBandwidth) Clone() *NetworkBandwidth {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Inbound = x.Inbound.Clone()
+	y.Outbound = x.Outbound.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkBandwidth) Equal(y *NetworkBandwidth) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ClassID != y.ClassID {
+		return false
+	}
+	if !x.Inbound.Equal(y.Inbound) {
+		return false
+	}
+	if !x.Outbound.Equal(y.Outbound) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkDnsmasqOptions) Clone() *NetworkDnsmasqOptions {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Option != nil {
+		y.Option = make([]NetworkDnsmasqOption, len(x.Option))
+		copy(y.Option, x.Option)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkDnsmasqOptions) Equal(y *NetworkDnsmasqOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Option) != len(y.Option) {
+		return false
+	}
+	for i := range x.Option {
+		if !x.Option[i].Equal(&y.Option[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkDnsmasqOption) Clone() *NetworkDnsmasqOption {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkDnsmasqOption) Equal(y *NetworkDnsmasqOption) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkPort) Clone() *NetworkPort {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Owner = x.Owner.Clone()
+	y.MAC = x.MAC.Clone()
+	y.Bandwidth = x.Bandwidth.Clone()
+	y.VLAN = x.VLAN.Clone()
+	y.PortOptions = x.PortOptions.Clone()
+	y.VirtualPort = x.VirtualPort.Clone()
+	y.RXFilters = x.RXFilters.Clone()
+	y.Plug = x.Plug.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkPort) Equal(y *NetworkPort) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.UUID != y.UUID {
+		return false
+	}
+	if !x.Owner.Equal(y.Owner) {
+		return false
+	}
+	if !x.MAC.Equal(y.MAC) {
+		return false
+	}
+	if x.Group != y.Group {
+		return false
+	}
+	if !x.Bandwidth.Equal(y.Bandwidth) {
+		return false
+	}
+	if !x.VLAN.Equal(y.VLAN) {
+		return false
+	}
+	if !x.PortOptions.Equal(y.PortOptions) {
+		return false
+	}
+	if !x.VirtualPort.Equal(y.VirtualPort) &&
+		!(x.VirtualPort == nil && y.VirtualPort.Equal(&NetworkVirtualPort{})) &&
+		!(y.VirtualPort == nil && x.VirtualPort.Equal(&NetworkVirtualPort{})) {
+		return false
+	}
+	if !x.RXFilters.Equal(y.RXFilters) {
+		return false
+	}
+	if !x.Plug.Equal(y.Plug) &&
+		!(x.Plug == nil && y.Plug.Equal(&NetworkPortPlug{})) &&
+		!(y.Plug == nil && x.Plug.Equal(&NetworkPortPlug{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkPortPortOptions) Clone() *NetworkPortPortOptions {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkPortPortOptions) Equal(y *NetworkPortPortOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Isolated != y.Isolated {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkPortVLAN) Clone() *NetworkPortVLAN {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Tags != nil {
+		y.Tags = make([]NetworkPortVLANTag, len(x.Tags))
+		copy(y.Tags, x.Tags)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkPortVLAN) Equal(y *NetworkPortVLAN) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Trunk != y.Trunk {
+		return false
+	}
+	if len(x.Tags) != len(y.Tags) {
+		return false
+	}
+	for i := range x.Tags {
+		if !x.Tags[i].Equal(&y.Tags[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkPortVLANTag) Clone() *NetworkPortVLANTag {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkPortVLANTag) Equal(y *NetworkPortVLANTag) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ID != y.ID {
+		return false
+	}
+	if x.NativeMode != y.NativeMode {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkPortOwner) Clone() *NetworkPortOwner {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkPortOwner) Equal(y *NetworkPortOwner) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.UUID != y.UUID {
+		return false
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkPortMAC) Clone() *NetworkPortMAC {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkPortMAC) Equal(y *NetworkPortMAC) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkPortRXFilters) Clone() *NetworkPortRXFilters {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkPortRXFilters) Equal(y *NetworkPortRXFilters) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.TrustGuest != y.TrustGuest {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkPortPlug) Clone() *NetworkPortPlug {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Bridge = x.Bridge.Clone()
+	y.Network = x.Network.Clone()
+	y.Direct = x.Direct.Clone()
+	y.HostDevPCI = x.HostDevPCI.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkPortPlug) Equal(y *NetworkPortPlug) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Bridge.Equal(y.Bridge) &&
+		!(x.Bridge == nil && y.Bridge.Equal(&NetworkPortPlugBridge{})) &&
+		!(y.Bridge == nil && x.Bridge.Equal(&NetworkPortPlugBridge{})) {
+		return false
+	}
+	if !x.Network.Equal(y.Network) &&
+		!(x.Network == nil && y.Network.Equal(&NetworkPortPlugNetwork{})) &&
+		!(y.Network == nil && x.Network.Equal(&NetworkPortPlugNetwork{})) {
+		return false
+	}
+	if !x.Direct.Equal(y.Direct) &&
+		!(x.Direct == nil && y.Direct.Equal(&NetworkPortPlugDirect{})) &&
+		!(y.Direct == nil && x.Direct.Equal(&NetworkPortPlugDirect{})) {
+		return false
+	}
+	if !x.HostDevPCI.Equal(y.HostDevPCI) &&
+		!(x.HostDevPCI == nil && y.HostDevPCI.Equal(&NetworkPortPlugHostDevPCI{})) &&
+		!(y.HostDevPCI == nil && x.HostDevPCI.Equal(&NetworkPortPlugHostDevPCI{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkPortPlugBridge) Clone() *NetworkPortPlugBridge {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkPortPlugBridge) Equal(y *NetworkPortPlugBridge) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Bridge != y.Bridge {
+		return false
+	}
+	if x.MacTableManager != y.MacTableManager {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkPortPlugNetwork) Clone() *NetworkPortPlugNetwork {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkPortPlugNetwork) Equal(y *NetworkPortPlugNetwork) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Bridge != y.Bridge {
+		return false
+	}
+	if x.MacTableManager != y.MacTableManager {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkPortPlugDirect) Clone() *NetworkPortPlugDirect {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkPortPlugDirect) Equal(y *NetworkPortPlugDirect) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Dev != y.Dev {
+		return false
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkPortPlugHostDevPCI) Clone() *NetworkPortPlugHostDevPCI {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Driver = x.Driver.Clone()
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkPortPlugHostDevPCI) Equal(y *NetworkPortPlugHostDevPCI) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Managed != y.Managed {
+		return false
+	}
+	if !x.Driver.Equal(y.Driver) {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&NetworkPortPlugHostDevPCIAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&NetworkPortPlugHostDevPCIAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkPortPlugHostDevPCIDriver) Clone() *NetworkPortPlugHostDevPCIDriver {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkPortPlugHostDevPCIDriver) Equal(y *NetworkPortPlugHostDevPCIDriver) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NetworkPortPlugHostDevPCIAddress) Clone() *NetworkPortPlugHostDevPCIAddress {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Domain != nil {
+		v := *x.Domain
+		y.Domain = &v
+	}
+	if x.Bus != nil {
+		v := *x.Bus
+		y.Bus = &v
+	}
+	if x.Slot != nil {
+		v := *x.Slot
+		y.Slot = &v
+	}
+	if x.Function != nil {
+		v := *x.Function
+		y.Function = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NetworkPortPlugHostDevPCIAddress) Equal(y *NetworkPortPlugHostDevPCIAddress) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.Domain == nil) != (y.Domain == nil) {
+		return false
+	}
+	if x.Domain != nil {
+		if (*x.Domain) != (*y.Domain) {
+			return false
+		}
+	}
+	if (x.Bus == nil) != (y.Bus == nil) {
+		return false
+	}
+	if x.Bus != nil {
+		if (*x.Bus) != (*y.Bus) {
+			return false
+		}
+	}
+	if (x.Slot == nil) != (y.Slot == nil) {
+		return false
+	}
+	if x.Slot != nil {
+		if (*x.Slot) != (*y.Slot) {
+			return false
+		}
+	}
+	if (x.Function == nil) != (y.Function == nil) {
+		return false
+	}
+	if x.Function != nil {
+		if (*x.Function) != (*y.Function) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDevice) Clone() *NodeDevice {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.DevNodes != nil {
+		y.DevNodes = make([]NodeDeviceDevNode, len(x.DevNodes))
+		copy(y.DevNodes, x.DevNodes)
+	}
+	y.Driver = x.Driver.Clone()
+	y.Capability = *x.Capability.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDevice) Equal(y *NodeDevice) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	if len(x.DevNodes) != len(y.DevNodes) {
+		return false
+	}
+	for i := range x.DevNodes {
+		if !x.DevNodes[i].Equal(&y.DevNodes[i]) {
+			return false
+		}
+	}
+	if x.Parent != y.Parent {
+		return false
+	}
+	if !x.Driver.Equal(y.Driver) {
+		return false
+	}
+	if !x.Capability.Equal(&y.Capability) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceDevNode) Clone() *NodeDeviceDevNode {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceDevNode) Equal(y *NodeDeviceDevNode) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceDriver) Clone() *NodeDeviceDriver {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceDriver) Equal(y *NodeDeviceDriver) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceCapability) Clone() *NodeDeviceCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.System = x.System.Clone()
+	y.PCI = x.PCI.Clone()
+	y.USB = x.USB.Clone()
+	y.USBDevice = x.USBDevice.Clone()
+	y.Net = x.Net.Clone()
+	y.SCSIHost = x.SCSIHost.Clone()
+	y.SCSITarget = x.SCSITarget.Clone()
+	y.SCSI = x.SCSI.Clone()
+	y.Storage = x.Storage.Clone()
+	y.DRM = x.DRM.Clone()
+	y.CCW = x.CCW.Clone()
+	y.MDev = x.MDev.Clone()
+	y.CSS = x.CSS.Clone()
+	y.APQueue = x.APQueue.Clone()
+	y.APCard = x.APCard.Clone()
+	y.APMatrix = x.APMatrix.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceCapability) Equal(y *NodeDeviceCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.System.Equal(y.System) {
+		return false
+	}
+	if !x.PCI.Equal(y.PCI) {
+		return false
+	}
+	if !x.USB.Equal(y.USB) {
+		return false
+	}
+	if !x.USBDevice.Equal(y.USBDevice) {
+		return false
+	}
+	if !x.Net.Equal(y.Net) {
+		return false
+	}
+	if !x.SCSIHost.Equal(y.SCSIHost) {
+		return false
+	}
+	if !x.SCSITarget.Equal(y.SCSITarget) {
+		return false
+	}
+	if !x.SCSI.Equal(y.SCSI) {
+		return false
+	}
+	if !x.Storage.Equal(y.Storage) {
+		return false
+	}
+	if !x.DRM.Equal(y.DRM) {
+		return false
+	}
+	if !x.CCW.Equal(y.CCW) &&
+		!(x.CCW == nil && y.CCW.Equal(&NodeDeviceCCWCapability{})) &&
+		!(y.CCW == nil && x.CCW.Equal(&NodeDeviceCCWCapability{})) {
+		return false
+	}
+	if !x.MDev.Equal(y.MDev) {
+		return false
+	}
+	if !x.CSS.Equal(y.CSS) &&
+		!(x.CSS == nil && y.CSS.Equal(&NodeDeviceCSSCapability{})) &&
+		!(y.CSS == nil && x.CSS.Equal(&NodeDeviceCSSCapability{})) {
+		return false
+	}
+	if !x.APQueue.Equal(y.APQueue) {
+		return false
+	}
+	if !x.APCard.Equal(y.APCard) {
+		return false
+	}
+	if !x.APMatrix.Equal(y.APMatrix) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceIDName) Clone() *NodeDeviceIDName {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceIDName) Equal(y *NodeDeviceIDName) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ID != y.ID {
+		return false
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDevicePCIExpress) Clone() *NodeDevicePCIExpress {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Links != nil {
+		y.Links = make([]NodeDevicePCIExpressLink, len(x.Links))
+		copy(y.Links, x.Links)
+		for i := range x.Links {
+			y.Links[i] = *x.Links[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDevicePCIExpress) Equal(y *NodeDevicePCIExpress) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Links) != len(y.Links) {
+		return false
+	}
+	for i := range x.Links {
+		if !x.Links[i].Equal(&y.Links[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDevicePCIExpressLink) Clone() *NodeDevicePCIExpressLink {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Port != nil {
+		v := *x.Port
+		y.Port = &v
+	}
+	if x.Width != nil {
+		v := *x.Width
+		y.Width = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDevicePCIExpressLink) Equal(y *NodeDevicePCIExpressLink) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Validity != y.Validity {
+		return false
+	}
+	if x.Speed != y.Speed {
+		return false
+	}
+	if (x.Port == nil) != (y.Port == nil) {
+		return false
+	}
+	if x.Port != nil {
+		if (*x.Port) != (*y.Port) {
+			return false
+		}
+	}
+	if (x.Width == nil) != (y.Width == nil) {
+		return false
+	}
+	if x.Width != nil {
+		if (*x.Width) != (*y.Width) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceIOMMUGroup) Clone() *NodeDeviceIOMMUGroup {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Address != nil {
+		y.Address = make([]NodeDevicePCIAddress, len(x.Address))
+		copy(y.Address, x.Address)
+		for i := range x.Address {
+			y.Address[i] = *x.Address[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceIOMMUGroup) Equal(y *NodeDeviceIOMMUGroup) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Number != y.Number {
+		return false
+	}
+	if len(x.Address) != len(y.Address) {
+		return false
+	}
+	for i := range x.Address {
+		if !x.Address[i].Equal(&y.Address[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceNUMA) Clone() *NodeDeviceNUMA {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceNUMA) Equal(y *NodeDeviceNUMA) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Node != y.Node {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDevicePCICapability) Clone() *NodeDevicePCICapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Domain != nil {
+		v := *x.Domain
+		y.Domain = &v
+	}
+	if x.Bus != nil {
+		v := *x.Bus
+		y.Bus = &v
+	}
+	if x.Slot != nil {
+		v := *x.Slot
+		y.Slot = &v
+	}
+	if x.Function != nil {
+		v := *x.Function
+		y.Function = &v
+	}
+	y.IOMMUGroup = x.IOMMUGroup.Clone()
+	y.NUMA = x.NUMA.Clone()
+	y.PCIExpress = x.PCIExpress.Clone()
+	if x.Capabilities != nil {
+		y.Capabilities = make([]NodeDevicePCISubCapability, len(x.Capabilities))
+		copy(y.Capabilities, x.Capabilities)
+		for i := range x.Capabilities {
+			y.Capabilities[i] = *x.Capabilities[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDevicePCICapability) Equal(y *NodeDevicePCICapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Class != y.Class {
+		return false
+	}
+	if (x.Domain == nil) != (y.Domain == nil) {
+		return false
+	}
+	if x.Domain != nil {
+		if (*x.Domain) != (*y.Domain) {
+			return false
+		}
+	}
+	if (x.Bus == nil) != (y.Bus == nil) {
+		return false
+	}
+	if x.Bus != nil {
+		if (*x.Bus) != (*y.Bus) {
+			return false
+		}
+	}
+	if (x.Slot == nil) != (y.Slot == nil) {
+		return false
+	}
+	if x.Slot != nil {
+		if (*x.Slot) != (*y.Slot) {
+			return false
+		}
+	}
+	if (x.Function == nil) != (y.Function == nil) {
+		return false
+	}
+	if x.Function != nil {
+		if (*x.Function) != (*y.Function) {
+			return false
+		}
+	}
+	if !x.Product.Equal(&y.Product) {
+		return false
+	}
+	if !x.Vendor.Equal(&y.Vendor) {
+		return false
+	}
+	if !x.IOMMUGroup.Equal(y.IOMMUGroup) {
+		return false
+	}
+	if !x.NUMA.Equal(y.NUMA) {
+		return false
+	}
+	if !x.PCIExpress.Equal(y.PCIExpress) {
+		return false
+	}
+	if len(x.Capabilities) != len(y.Capabilities) {
+		return false
+	}
+	for i := range x.Capabilities {
+		if !x.Capabilities[i].Equal(&y.Capabilities[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDevicePCIAddress) Clone() *NodeDevicePCIAddress {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Domain != nil {
+		v := *x.Domain
+		y.Domain = &v
+	}
+	if x.Bus != nil {
+		v := *x.Bus
+		y.Bus = &v
+	}
+	if x.Slot != nil {
+		v := *x.Slot
+		y.Slot = &v
+	}
+	if x.Function != nil {
+		v := *x.Function
+		y.Function = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDevicePCIAddress) Equal(y *NodeDevicePCIAddress) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.Domain == nil) != (y.Domain == nil) {
+		return false
+	}
+	if x.Domain != nil {
+		if (*x.Domain) != (*y.Domain) {
+			return false
+		}
+	}
+	if (x.Bus == nil) != (y.Bus == nil) {
+		return false
+	}
+	if x.Bus != nil {
+		if (*x.Bus) != (*y.Bus) {
+			return false
+		}
+	}
+	if (x.Slot == nil) != (y.Slot == nil) {
+		return false
+	}
+	if x.Slot != nil {
+		if (*x.Slot) != (*y.Slot) {
+			return false
+		}
+	}
+	if (x.Function == nil) != (y.Function == nil) {
+		return false
+	}
+	if x.Function != nil {
+		if (*x.Function) != (*y.Function) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDevicePCISubCapability) Clone() *NodeDevicePCISubCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.VirtFunctions = x.VirtFunctions.Clone()
+	y.PhysFunction = x.PhysFunction.Clone()
+	y.MDevTypes = x.MDevTypes.Clone()
+	y.Bridge = x.Bridge.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDevicePCISubCapability) Equal(y *NodeDevicePCISubCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.VirtFunctions.Equal(y.VirtFunctions) {
+		return false
+	}
+	if !x.PhysFunction.Equal(y.PhysFunction) {
+		return false
+	}
+	if !x.MDevTypes.Equal(y.MDevTypes) {
+		return false
+	}
+	if !x.Bridge.Equal(y.Bridge) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDevicePCIVirtFunctionsCapability) Clone() *NodeDevicePCIVirtFunctionsCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Address != nil {
+		y.Address = make([]NodeDevicePCIAddress, len(x.Address))
+		copy(y.Address, x.Address)
+		for i := range x.Address {
+			y.Address[i] = *x.Address[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDevicePCIVirtFunctionsCapability) Equal(y *NodeDevicePCIVirtFunctionsCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Address) != len(y.Address) {
+		return false
+	}
+	for i := range x.Address {
+		if !x.Address[i].Equal(&y.Address[i]) {
+			return false
+		}
+	}
+	if x.MaxCount != y.MaxCount {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDevicePCIPhysFunctionCapability) Clone() *NodeDevicePCIPhysFunctionCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Address = *x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDevicePCIPhysFunctionCapability) Equal(y *NodeDevicePCIPhysFunctionCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Address.Equal(&y.Address) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDevicePCIMDevTypesCapability) Clone() *NodeDevicePCIMDevTypesCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Types != nil {
+		y.Types = make([]NodeDeviceMDevType, len(x.Types))
+		copy(y.Types, x.Types)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDevicePCIMDevTypesCapability) Equal(y *NodeDevicePCIMDevTypesCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Types) != len(y.Types) {
+		return false
+	}
+	for i := range x.Types {
+		if !x.Types[i].Equal(&y.Types[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceMDevType) Clone() *NodeDeviceMDevType {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceMDevType) Equal(y *NodeDeviceMDevType) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ID != y.ID {
+		return false
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.DeviceAPI != y.DeviceAPI {
+		return false
+	}
+	if x.AvailableInstances != y.AvailableInstances {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDevicePCIBridgeCapability) Clone() *NodeDevicePCIBridgeCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDevicePCIBridgeCapability) Equal(y *NodeDevicePCIBridgeCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceSystemHardware) Clone() *NodeDeviceSystemHardware {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceSystemHardware) Equal(y *NodeDeviceSystemHardware) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Vendor != y.Vendor {
+		return false
+	}
+	if x.Version != y.Version {
+		return false
+	}
+	if x.Serial != y.Serial {
+		return false
+	}
+	if x.UUID != y.UUID {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceSystemFirmware) Clone() *NodeDeviceSystemFirmware {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceSystemFirmware) Equal(y *NodeDeviceSystemFirmware) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Vendor != y.Vendor {
+		return false
+	}
+	if x.Version != y.Version {
+		return false
+	}
+	if x.ReleaseData != y.ReleaseData {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceSystemCapability) Clone() *NodeDeviceSystemCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Hardware = x.Hardware.Clone()
+	y.Firmware = x.Firmware.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceSystemCapability) Equal(y *NodeDeviceSystemCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Product != y.Product {
+		return false
+	}
+	if !x.Hardware.Equal(y.Hardware) {
+		return false
+	}
+	if !x.Firmware.Equal(y.Firmware) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceUSBDeviceCapability) Clone() *NodeDeviceUSBDeviceCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceUSBDeviceCapability) Equal(y *NodeDeviceUSBDeviceCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Bus != y.Bus {
+		return false
+	}
+	if x.Device != y.Device {
+		return false
+	}
+	if !x.Product.Equal(&y.Product) {
+		return false
+	}
+	if !x.Vendor.Equal(&y.Vendor) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceUSBCapability) Clone() *NodeDeviceUSBCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceUSBCapability) Equal(y *NodeDeviceUSBCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Number != y.Number {
+		return false
+	}
+	if x.Class != y.Class {
+		return false
+	}
+	if x.Subclass != y.Subclass {
+		return false
+	}
+	if x.Protocol != y.Protocol {
+		return false
+	}
+	if x.Description != y.Description {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceNetOffloadFeatures) Clone() *NodeDeviceNetOffloadFeatures {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceNetOffloadFeatures) Equal(y *NodeDeviceNetOffloadFeatures) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceNetLink) Clone() *NodeDeviceNetLink {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceNetLink) Equal(y *NodeDeviceNetLink) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.State != y.State {
+		return false
+	}
+	if x.Speed != y.Speed {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceNetSubCapability) Clone() *NodeDeviceNetSubCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Wireless80211 = x.Wireless80211.Clone()
+	y.Ethernet80203 = x.Ethernet80203.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceNetSubCapability) Equal(y *NodeDeviceNetSubCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Wireless80211.Equal(y.Wireless80211) {
+		return false
+	}
+	if !x.Ethernet80203.Equal(y.Ethernet80203) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceNet80211Capability) Clone() *NodeDeviceNet80211Capability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceNet80211Capability) Equal(y *NodeDeviceNet80211Capability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceNet80203Capability) Clone() *NodeDeviceNet80203Capability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceNet80203Capability) Equal(y *NodeDeviceNet80203Capability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceNetCapability) Clone() *NodeDeviceNetCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Link = x.Link.Clone()
+	if x.Features != nil {
+		y.Features = make([]NodeDeviceNetOffloadFeatures, len(x.Features))
+		copy(y.Features, x.Features)
+	}
+	if x.Capability != nil {
+		y.Capability = make([]NodeDeviceNetSubCapability, len(x.Capability))
+		copy(y.Capability, x.Capability)
+		for i := range x.Capability {
+			y.Capability[i] = *x.Capability[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceNetCapability) Equal(y *NodeDeviceNetCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Interface != y.Interface {
+		return false
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	if !x.Link.Equal(y.Link) {
+		return false
+	}
+	if len(x.Features) != len(y.Features) {
+		return false
+	}
+	for i := range x.Features {
+		if !x.Features[i].Equal(&y.Features[i]) {
+			return false
+		}
+	}
+	if len(x.Capability) != len(y.Capability) {
+		return false
+	}
+	for i := range x.Capability {
+		if !x.Capability[i].Equal(&y.Capability[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceSCSIVPortOpsCapability) Clone() *NodeDeviceSCSIVPortOpsCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceSCSIVPortOpsCapability) Equal(y *NodeDeviceSCSIVPortOpsCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.VPorts != y.VPorts {
+		return false
+	}
+	if x.MaxVPorts != y.MaxVPorts {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceSCSIFCHostCapability) Clone() *NodeDeviceSCSIFCHostCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceSCSIFCHostCapability) Equal(y *NodeDeviceSCSIFCHostCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.WWNN != y.WWNN {
+		return false
+	}
+	if x.WWPN != y.WWPN {
+		return false
+	}
+	if x.FabricWWN != y.FabricWWN {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceSCSIHostSubCapability) Clone() *NodeDeviceSCSIHostSubCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.VPortOps = x.VPortOps.Clone()
+	y.FCHost = x.FCHost.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceSCSIHostSubCapability) Equal(y *NodeDeviceSCSIHostSubCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.VPortOps.Equal(y.VPortOps) {
+		return false
+	}
+	if !x.FCHost.Equal(y.FCHost) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceSCSIHostCapability) Clone() *NodeDeviceSCSIHostCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.UniqueID != nil {
+		v := *x.UniqueID
+		y.UniqueID = &v
+	}
+	if x.Capability != nil {
+		y.Capability = make([]NodeDeviceSCSIHostSubCapability, len(x.Capability))
+		copy(y.Capability, x.Capability)
+		for i := range x.Capability {
+			y.Capability[i] = *x.Capability[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceSCSIHostCapability) Equal(y *NodeDeviceSCSIHostCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Host != y.Host {
+		return false
+	}
+	if (x.UniqueID == nil) != (y.UniqueID == nil) {
+		return false
+	}
+	if x.UniqueID != nil {
+		if (*x.UniqueID) != (*y.UniqueID) {
+			return false
+		}
+	}
+	if len(x.Capability) != len(y.Capability) {
+		return false
+	}
+	for i := range x.Capability {
+		if !x.Capability[i].Equal(&y.Capability[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceSCSITargetCapability) Clone() *NodeDeviceSCSITargetCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Capability != nil {
+		y.Capability = make([]NodeDeviceSCSITargetSubCapability, len(x.Capability))
+		copy(y.Capability, x.Capability)
+		for i := range x.Capability {
+			y.Capability[i] = *x.Capability[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceSCSITargetCapability) Equal(y *NodeDeviceSCSITargetCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Target != y.Target {
+		return false
+	}
+	if len(x.Capability) != len(y.Capability) {
+		return false
+	}
+	for i := range x.Capability {
+		if !x.Capability[i].Equal(&y.Capability[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceSCSITargetSubCapability) Clone() *NodeDeviceSCSITargetSubCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.FCRemotePort = x.FCRemotePort.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceSCSITargetSubCapability) Equal(y *NodeDeviceSCSITargetSubCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.FCRemotePort.Equal(y.FCRemotePort) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceSCSIFCRemotePortCapability) Clone() *NodeDeviceSCSIFCRemotePortCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceSCSIFCRemotePortCapability) Equal(y *NodeDeviceSCSIFCRemotePortCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.RPort != y.RPort {
+		return false
+	}
+	if x.WWPN != y.WWPN {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceSCSICapability) Clone() *NodeDeviceSCSICapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceSCSICapability) Equal(y *NodeDeviceSCSICapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Host != y.Host {
+		return false
+	}
+	if x.Bus != y.Bus {
+		return false
+	}
+	if x.Target != y.Target {
+		return false
+	}
+	if x.Lun != y.Lun {
+		return false
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceStorageSubCapability) Clone() *NodeDeviceStorageSubCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Removable = x.Removable.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceStorageSubCapability) Equal(y *NodeDeviceStorageSubCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Removable.Equal(y.Removable) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceStorageRemovableCapability) Clone() *NodeDeviceStorageRemovableCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.MediaAvailable != nil {
+		v := *x.MediaAvailable
+		y.MediaAvailable = &v
+	}
+	if x.MediaSize != nil {
+		v := *x.MediaSize
+		y.MediaSize = &v
+	}
+	if x.LogicalBlockSize != nil {
+		v := *x.LogicalBlockSize
+		y.LogicalBlockSize = &v
+	}
+	if x.NumBlocks != nil {
+		v := *x.NumBlocks
+		y.NumBlocks = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceStorageRemovableCapability) Equal(y *NodeDeviceStorageRemovableCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.MediaAvailable == nil) != (y.MediaAvailable == nil) {
+		return false
+	}
+	if x.MediaAvailable != nil {
+		if (*x.MediaAvailable) != (*y.MediaAvailable) {
+			return false
+		}
+	}
+	if (x.MediaSize == nil) != (y.MediaSize == nil) {
+		return false
+	}
+	if x.MediaSize != nil {
+		if (*x.MediaSize) != (*y.MediaSize) {
+			return false
+		}
+	}
+	if x.MediaLabel != y.MediaLabel {
+		return false
+	}
+	if (x.LogicalBlockSize == nil) != (y.LogicalBlockSize == nil) {
+		return false
+	}
+	if x.LogicalBlockSize != nil {
+		if (*x.LogicalBlockSize) != (*y.LogicalBlockSize) {
+			return false
+		}
+	}
+	if (x.NumBlocks == nil) != (y.NumBlocks == nil) {
+		return false
+	}
+	if x.NumBlocks != nil {
+		if (*x.NumBlocks) != (*y.NumBlocks) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceStorageCapability) Clone() *NodeDeviceStorageCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Size != nil {
+		v := *x.Size
+		y.Size = &v
+	}
+	if x.LogicalBlockSize != nil {
+		v := *x.LogicalBlockSize
+		y.LogicalBlockSize = &v
+	}
+	if x.NumBlocks != nil {
+		v := *x.NumBlocks
+		y.NumBlocks = &v
+	}
+	if x.Capability != nil {
+		y.Capability = make([]NodeDeviceStorageSubCapability, len(x.Capability))
+		copy(y.Capability, x.Capability)
+		for i := range x.Capability {
+			y.Capability[i] = *x.Capability[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceStorageCapability) Equal(y *NodeDeviceStorageCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Block != y.Block {
+		return false
+	}
+	if x.Bus != y.Bus {
+		return false
+	}
+	if x.DriverType != y.DriverType {
+		return false
+	}
+	if x.Model != y.Model {
+		return false
+	}
+	if x.Vendor != y.Vendor {
+		return false
+	}
+	if x.Serial != y.Serial {
+		return false
+	}
+	if (x.Size == nil) != (y.Size == nil) {
+		return false
+	}
+	if x.Size != nil {
+		if (*x.Size) != (*y.Size) {
+			return false
+		}
+	}
+	if (x.LogicalBlockSize == nil) != (y.LogicalBlockSize == nil) {
+		return false
+	}
+	if x.LogicalBlockSize != nil {
+		if (*x.LogicalBlockSize) != (*y.LogicalBlockSize) {
+			return false
+		}
+	}
+	if (x.NumBlocks == nil) != (y.NumBlocks == nil) {
+		return false
+	}
+	if x.NumBlocks != nil {
+		if (*x.NumBlocks) != (*y.NumBlocks) {
+			return false
+		}
+	}
+	if len(x.Capability) != len(y.Capability) {
+		return false
+	}
+	for i := range x.Capability {
+		if !x.Capability[i].Equal(&y.Capability[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceDRMCapability) Clone() *NodeDeviceDRMCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceDRMCapability) Equal(y *NodeDeviceDRMCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceCCWCapability) Clone() *NodeDeviceCCWCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.CSSID != nil {
+		v := *x.CSSID
+		y.CSSID = &v
+	}
+	if x.SSID != nil {
+		v := *x.SSID
+		y.SSID = &v
+	}
+	if x.DevNo != nil {
+		v := *x.DevNo
+		y.DevNo = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceCCWCapability) Equal(y *NodeDeviceCCWCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.CSSID == nil) != (y.CSSID == nil) {
+		return false
+	}
+	if x.CSSID != nil {
+		if (*x.CSSID) != (*y.CSSID) {
+			return false
+		}
+	}
+	if (x.SSID == nil) != (y.SSID == nil) {
+		return false
+	}
+	if x.SSID != nil {
+		if (*x.SSID) != (*y.SSID) {
+			return false
+		}
+	}
+	if (x.DevNo == nil) != (y.DevNo == nil) {
+		return false
+	}
+	if x.DevNo != nil {
+		if (*x.DevNo) != (*y.DevNo) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceMDevCapability) Clone() *NodeDeviceMDevCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Type = x.Type.Clone()
+	y.IOMMUGroup = x.IOMMUGroup.Clone()
+	if x.Attrs != nil {
+		y.Attrs = make([]NodeDeviceMDevCapabilityAttrs, len(x.Attrs))
+		copy(y.Attrs, x.Attrs)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceMDevCapability) Equal(y *NodeDeviceMDevCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Type.Equal(y.Type) {
+		return false
+	}
+	if !x.IOMMUGroup.Equal(y.IOMMUGroup) {
+		return false
+	}
+	if x.UUID != y.UUID {
+		return false
+	}
+	if len(x.Attrs) != len(y.Attrs) {
+		return false
+	}
+	for i := range x.Attrs {
+		if !x.Attrs[i].Equal(&y.Attrs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceMDevCapabilityType) Clone() *NodeDeviceMDevCapabilityType {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceMDevCapabilityType) Equal(y *NodeDeviceMDevCapabilityType) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.ID != y.ID {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceMDevCapabilityAttrs) Clone() *NodeDeviceMDevCapabilityAttrs {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceMDevCapabilityAttrs) Equal(y *NodeDeviceMDevCapabilityAttrs) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceCSSCapability) Clone() *NodeDeviceCSSCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.CSSID != nil {
+		v := *x.CSSID
+		y.CSSID = &v
+	}
+	if x.SSID != nil {
+		v := *x.SSID
+		y.SSID = &v
+	}
+	if x.DevNo != nil {
+		v := *x.DevNo
+		y.DevNo = &v
+	}
+	if x.Capabilities != nil {
+		y.Capabilities = make([]NodeDeviceCSSSubCapability, len(x.Capabilities))
+		copy(y.Capabilities, x.Capabilities)
+		for i := range x.Capabilities {
+			y.Capabilities[i] = *x.Capabilities[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceCSSCapability) Equal(y *NodeDeviceCSSCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.CSSID == nil) != (y.CSSID == nil) {
+		return false
+	}
+	if x.CSSID != nil {
+		if (*x.CSSID) != (*y.CSSID) {
+			return false
+		}
+	}
+	if (x.SSID == nil) != (y.SSID == nil) {
+		return false
+	}
+	if x.SSID != nil {
+		if (*x.SSID) != (*y.SSID) {
+			return false
+		}
+	}
+	if (x.DevNo == nil) != (y.DevNo == nil) {
+		return false
+	}
+	if x.DevNo != nil {
+		if (*x.DevNo) != (*y.DevNo) {
+			return false
+		}
+	}
+	if len(x.Capabilities) != len(y.Capabilities) {
+		return false
+	}
+	for i := range x.Capabilities {
+		if !x.Capabilities[i].Equal(&y.Capabilities[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceCSSSubCapability) Clone() *NodeDeviceCSSSubCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.MDevTypes = x.MDevTypes.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceCSSSubCapability) Equal(y *NodeDeviceCSSSubCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.MDevTypes.Equal(y.MDevTypes) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceCSSMDevTypesCapability) Clone() *NodeDeviceCSSMDevTypesCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Types != nil {
+		y.Types = make([]NodeDeviceMDevType, len(x.Types))
+		copy(y.Types, x.Types)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceCSSMDevTypesCapability) Equal(y *NodeDeviceCSSMDevTypesCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Types) != len(y.Types) {
+		return false
+	}
+	for i := range x.Types {
+		if !x.Types[i].Equal(&y.Types[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceAPQueueCapability) Clone() *NodeDeviceAPQueueCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceAPQueueCapability) Equal(y *NodeDeviceAPQueueCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.APAdapter != y.APAdapter {
+		return false
+	}
+	if x.APDomain != y.APDomain {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceAPCardCapability) Clone() *NodeDeviceAPCardCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceAPCardCapability) Equal(y *NodeDeviceAPCardCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.APAdapter != y.APAdapter {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceAPMatrixCapability) Clone() *NodeDeviceAPMatrixCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Capabilities != nil {
+		y.Capabilities = make([]NodeDeviceAPMatrixSubCapability, len(x.Capabilities))
+		copy(y.Capabilities, x.Capabilities)
+		for i := range x.Capabilities {
+			y.Capabilities[i] = *x.Capabilities[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceAPMatrixCapability) Equal(y *NodeDeviceAPMatrixCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Capabilities) != len(y.Capabilities) {
+		return false
+	}
+	for i := range x.Capabilities {
+		if !x.Capabilities[i].Equal(&y.Capabilities[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceAPMatrixSubCapability) Clone() *NodeDeviceAPMatrixSubCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.MDevTypes = x.MDevTypes.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceAPMatrixSubCapability) Equal(y *NodeDeviceAPMatrixSubCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.MDevTypes.Equal(y.MDevTypes) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NodeDeviceAPMatrixMDevTypesCapability) Clone() *NodeDeviceAPMatrixMDevTypesCapability {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Types != nil {
+		y.Types = make([]NodeDeviceMDevType, len(x.Types))
+		copy(y.Types, x.Types)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NodeDeviceAPMatrixMDevTypesCapability) Equal(y *NodeDeviceAPMatrixMDevTypesCapability) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Types) != len(y.Types) {
+		return false
+	}
+	for i := range x.Types {
+		if !x.Types[i].Equal(&y.Types[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilter) Clone() *NWFilter {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Entries != nil {
+		y.Entries = make([]NWFilterEntry, len(x.Entries))
+		copy(y.Entries, x.Entries)
+		for i := range x.Entries {
+			y.Entries[i] = *x.Entries[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilter) Equal(y *NWFilter) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.UUID != y.UUID {
+		return false
+	}
+	if x.Chain != y.Chain {
+		return false
+	}
+	if x.Priority != y.Priority {
+		return false
+	}
+	if len(x.Entries) != len(y.Entries) {
+		return false
+	}
+	for i := range x.Entries {
+		if !x.Entries[i].Equal(&y.Entries[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterEntry) Clone() *NWFilterEntry {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Rule = x.Rule.Clone()
+	y.Ref = x.Ref.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterEntry) Equal(y *NWFilterEntry) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Rule.Equal(y.Rule) {
+		return false
+	}
+	if !x.Ref.Equal(y.Ref) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRef) Clone() *NWFilterRef {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Parameters != nil {
+		y.Parameters = make([]NWFilterParameter, len(x.Parameters))
+		copy(y.Parameters, x.Parameters)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRef) Equal(y *NWFilterRef) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Filter != y.Filter {
+		return false
+	}
+	if len(x.Parameters) != len(y.Parameters) {
+		return false
+	}
+	for i := range x.Parameters {
+		if !x.Parameters[i].Equal(&y.Parameters[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterParameter) Clone() *NWFilterParameter {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterParameter) Equal(y *NWFilterParameter) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterField) Clone() *NWFilterField {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Uint != nil {
+		v := *x.Uint
+		y.Uint = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterField) Equal(y *NWFilterField) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Var != y.Var {
+		return false
+	}
+	if x.Str != y.Str {
+		return false
+	}
+	if (x.Uint == nil) != (y.Uint == nil) {
+		return false
+	}
+	if x.Uint != nil {
+		if (*x.Uint) != (*y.Uint) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRule) Clone() *NWFilterRule {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.ARP = x.ARP.Clone()
+	y.RARP = x.RARP.Clone()
+	y.MAC = x.MAC.Clone()
+	y.VLAN = x.VLAN.Clone()
+	y.STP = x.STP.Clone()
+	y.IP = x.IP.Clone()
+	y.IPv6 = x.IPv6.Clone()
+	y.TCP = x.TCP.Clone()
+	y.UDP = x.UDP.Clone()
+	y.UDPLite = x.UDPLite.Clone()
+	y.ESP = x.ESP.Clone()
+	y.AH = x.AH.Clone()
+	y.SCTP = x.SCTP.Clone()
+	y.ICMP = x.ICMP.Clone()
+	y.All = x.All.Clone()
+	y.IGMP = x.IGMP.Clone()
+	y.TCPIPv6 = x.TCPIPv6.Clone()
+	y.UDPIPv6 = x.UDPIPv6.Clone()
+	y.UDPLiteIPv6 = x.UDPLiteIPv6.Clone()
+	y.ESPIPv6 = x.ESPIPv6.Clone()
+	y.AHIPv6 = x.AHIPv6.Clone()
+	y.SCTPIPv6 = x.SCTPIPv6.Clone()
+	y.ICMPv6 = x.ICMPv6.Clone()
+	y.AllIPv6 = x.AllIPv6.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRule) Equal(y *NWFilterRule) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Action != y.Action {
+		return false
+	}
+	if x.Direction != y.Direction {
+		return false
+	}
+	if x.Priority != y.Priority {
+		return false
+	}
+	if x.StateMatch != y.StateMatch {
+		return false
+	}
+	if !x.ARP.Equal(y.ARP) {
+		return false
+	}
+	if !x.RARP.Equal(y.RARP) {
+		return false
+	}
+	if !x.MAC.Equal(y.MAC) {
+		return false
+	}
+	if !x.VLAN.Equal(y.VLAN) {
+		return false
+	}
+	if !x.STP.Equal(y.STP) {
+		return false
+	}
+	if !x.IP.Equal(y.IP) {
+		return false
+	}
+	if !x.IPv6.Equal(y.IPv6) {
+		return false
+	}
+	if !x.TCP.Equal(y.TCP) {
+		return false
+	}
+	if !x.UDP.Equal(y.UDP) {
+		return false
+	}
+	if !x.UDPLite.Equal(y.UDPLite) {
+		return false
+	}
+	if !x.ESP.Equal(y.ESP) {
+		return false
+	}
+	if !x.AH.Equal(y.AH) {
+		return false
+	}
+	if !x.SCTP.Equal(y.SCTP) {
+		return false
+	}
+	if !x.ICMP.Equal(y.ICMP) {
+		return false
+	}
+	if !x.All.Equal(y.All) {
+		return false
+	}
+	if !x.IGMP.Equal(y.IGMP) {
+		return false
+	}
+	if !x.TCPIPv6.Equal(y.TCPIPv6) {
+		return false
+	}
+	if !x.UDPIPv6.Equal(y.UDPIPv6) {
+		return false
+	}
+	if !x.UDPLiteIPv6.Equal(y.UDPLiteIPv6) {
+		return false
+	}
+	if !x.ESPIPv6.Equal(y.ESPIPv6) {
+		return false
+	}
+	if !x.AHIPv6.Equal(y.AHIPv6) {
+		return false
+	}
+	if !x.SCTPIPv6.Equal(y.SCTPIPv6) {
+		return false
+	}
+	if !x.ICMPv6.Equal(y.ICMPv6) {
+		return false
+	}
+	if !x.AllIPv6.Equal(y.AllIPv6) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleCommonMAC) Clone() *NWFilterRuleCommonMAC {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.SrcMACAddr = *x.SrcMACAddr.Clone()
+	y.SrcMACMask = *x.SrcMACMask.Clone()
+	y.DstMACAddr = *x.DstMACAddr.Clone()
+	y.DstMACMask = *x.DstMACMask.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleCommonMAC) Equal(y *NWFilterRuleCommonMAC) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.SrcMACAddr.Equal(&y.SrcMACAddr) {
+		return false
+	}
+	if !x.SrcMACMask.Equal(&y.SrcMACMask) {
+		return false
+	}
+	if !x.DstMACAddr.Equal(&y.DstMACAddr) {
+		return false
+	}
+	if !x.DstMACMask.Equal(&y.DstMACMask) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleCommonIP) Clone() *NWFilterRuleCommonIP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.SrcMACAddr = *x.SrcMACAddr.Clone()
+	y.SrcIPAddr = *x.SrcIPAddr.Clone()
+	y.SrcIPMask = *x.SrcIPMask.Clone()
+	y.DstIPAddr = *x.DstIPAddr.Clone()
+	y.DstIPMask = *x.DstIPMask.Clone()
+	y.SrcIPFrom = *x.SrcIPFrom.Clone()
+	y.SrcIPTo = *x.SrcIPTo.Clone()
+	y.DstIPFrom = *x.DstIPFrom.Clone()
+	y.DstIPTo = *x.DstIPTo.Clone()
+	y.DSCP = *x.DSCP.Clone()
+	y.ConnLimitAbove = *x.ConnLimitAbove.Clone()
+	y.State = *x.State.Clone()
+	y.IPSet = *x.IPSet.Clone()
+	y.IPSetFlags = *x.IPSetFlags.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleCommonIP) Equal(y *NWFilterRuleCommonIP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.SrcMACAddr.Equal(&y.SrcMACAddr) {
+		return false
+	}
+	if !x.SrcIPAddr.Equal(&y.SrcIPAddr) {
+		return false
+	}
+	if !x.SrcIPMask.Equal(&y.SrcIPMask) {
+		return false
+	}
+	if !x.DstIPAddr.Equal(&y.DstIPAddr) {
+		return false
+	}
+	if !x.DstIPMask.Equal(&y.DstIPMask) {
+		return false
+	}
+	if !x.SrcIPFrom.Equal(&y.SrcIPFrom) {
+		return false
+	}
+	if !x.SrcIPTo.Equal(&y.SrcIPTo) {
+		return false
+	}
+	if !x.DstIPFrom.Equal(&y.DstIPFrom) {
+		return false
+	}
+	if !x.DstIPTo.Equal(&y.DstIPTo) {
+		return false
+	}
+	if !x.DSCP.Equal(&y.DSCP) {
+		return false
+	}
+	if !x.ConnLimitAbove.Equal(&y.ConnLimitAbove) {
+		return false
+	}
+	if !x.State.Equal(&y.State) {
+		return false
+	}
+	if !x.IPSet.Equal(&y.IPSet) {
+		return false
+	}
+	if !x.IPSetFlags.Equal(&y.IPSetFlags) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleCommonPort) Clone() *NWFilterRuleCommonPort {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.SrcPortStart = *x.SrcPortStart.Clone()
+	y.SrcPortEnd = *x.SrcPortEnd.Clone()
+	y.DstPortStart = *x.DstPortStart.Clone()
+	y.DstPortEnd = *x.DstPortEnd.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleCommonPort) Equal(y *NWFilterRuleCommonPort) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.SrcPortStart.Equal(&y.SrcPortStart) {
+		return false
+	}
+	if !x.SrcPortEnd.Equal(&y.SrcPortEnd) {
+		return false
+	}
+	if !x.DstPortStart.Equal(&y.DstPortStart) {
+		return false
+	}
+	if !x.DstPortEnd.Equal(&y.DstPortEnd) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleARP) Clone() *NWFilterRuleARP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonMAC = *x.NWFilterRuleCommonMAC.Clone()
+	y.HWType = *x.HWType.Clone()
+	y.ProtocolType = *x.ProtocolType.Clone()
+	y.OpCode = *x.OpCode.Clone()
+	y.ARPSrcMACAddr = *x.ARPSrcMACAddr.Clone()
+	y.ARPDstMACAddr = *x.ARPDstMACAddr.Clone()
+	y.ARPSrcIPAddr = *x.ARPSrcIPAddr.Clone()
+	y.ARPSrcIPMask = *x.ARPSrcIPMask.Clone()
+	y.ARPDstIPAddr = *x.ARPDstIPAddr.Clone()
+	y.ARPDstIPMask = *x.ARPDstIPMask.Clone()
+	y.Gratuitous = *x.Gratuitous.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleARP) Equal(y *NWFilterRuleARP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonMAC.Equal(&y.NWFilterRuleCommonMAC) {
+		return false
+	}
+	if !x.HWType.Equal(&y.HWType) {
+		return false
+	}
+	if !x.ProtocolType.Equal(&y.ProtocolType) {
+		return false
+	}
+	if !x.OpCode.Equal(&y.OpCode) {
+		return false
+	}
+	if !x.ARPSrcMACAddr.Equal(&y.ARPSrcMACAddr) {
+		return false
+	}
+	if !x.ARPDstMACAddr.Equal(&y.ARPDstMACAddr) {
+		return false
+	}
+	if !x.ARPSrcIPAddr.Equal(&y.ARPSrcIPAddr) {
+		return false
+	}
+	if !x.ARPSrcIPMask.Equal(&y.ARPSrcIPMask) {
+		return false
+	}
+	if !x.ARPDstIPAddr.Equal(&y.ARPDstIPAddr) {
+		return false
+	}
+	if !x.ARPDstIPMask.Equal(&y.ARPDstIPMask) {
+		return false
+	}
+	if !x.Gratuitous.Equal(&y.Gratuitous) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleRARP) Clone() *NWFilterRuleRARP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonMAC = *x.NWFilterRuleCommonMAC.Clone()
+	y.HWType = *x.HWType.Clone()
+	y.ProtocolType = *x.ProtocolType.Clone()
+	y.OpCode = *x.OpCode.Clone()
+	y.ARPSrcMACAddr = *x.ARPSrcMACAddr.Clone()
+	y.ARPDstMACAddr = *x.ARPDstMACAddr.Clone()
+	y.ARPSrcIPAddr = *x.ARPSrcIPAddr.Clone()
+	y.ARPSrcIPMask = *x.ARPSrcIPMask.Clone()
+	y.ARPDstIPAddr = *x.ARPDstIPAddr.Clone()
+	y.ARPDstIPMask = *x.ARPDstIPMask.Clone()
+	y.Gratuitous = *x.Gratuitous.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleRARP) Equal(y *NWFilterRuleRARP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonMAC.Equal(&y.NWFilterRuleCommonMAC) {
+		return false
+	}
+	if !x.HWType.Equal(&y.HWType) {
+		return false
+	}
+	if !x.ProtocolType.Equal(&y.ProtocolType) {
+		return false
+	}
+	if !x.OpCode.Equal(&y.OpCode) {
+		return false
+	}
+	if !x.ARPSrcMACAddr.Equal(&y.ARPSrcMACAddr) {
+		return false
+	}
+	if !x.ARPDstMACAddr.Equal(&y.ARPDstMACAddr) {
+		return false
+	}
+	if !x.ARPSrcIPAddr.Equal(&y.ARPSrcIPAddr) {
+		return false
+	}
+	if !x.ARPSrcIPMask.Equal(&y.ARPSrcIPMask) {
+		return false
+	}
+	if !x.ARPDstIPAddr.Equal(&y.ARPDstIPAddr) {
+		return false
+	}
+	if !x.ARPDstIPMask.Equal(&y.ARPDstIPMask) {
+		return false
+	}
+	if !x.Gratuitous.Equal(&y.Gratuitous) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleMAC) Clone() *NWFilterRuleMAC {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonMAC = *x.NWFilterRuleCommonMAC.Clone()
+	y.ProtocolID = *x.ProtocolID.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleMAC) Equal(y *NWFilterRuleMAC) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonMAC.Equal(&y.NWFilterRuleCommonMAC) {
+		return false
+	}
+	if !x.ProtocolID.Equal(&y.ProtocolID) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleVLAN) Clone() *NWFilterRuleVLAN {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonMAC = *x.NWFilterRuleCommonMAC.Clone()
+	y.VLANID = *x.VLANID.Clone()
+	y.EncapProtocol = *x.EncapProtocol.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleVLAN) Equal(y *NWFilterRuleVLAN) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonMAC.Equal(&y.NWFilterRuleCommonMAC) {
+		return false
+	}
+	if !x.VLANID.Equal(&y.VLANID) {
+		return false
+	}
+	if !x.EncapProtocol.Equal(&y.EncapProtocol) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleSTP) Clone() *NWFilterRuleSTP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Match = *x.Match.Clone()
+	y.SrcMACAddr = *x.SrcMACAddr.Clone()
+	y.SrcMACMask = *x.SrcMACMask.Clone()
+	y.Type = *x.Type.Clone()
+	y.Flags = *x.Flags.Clone()
+	y.RootPriority = *x.RootPriority.Clone()
+	y.RootPriorityHi = *x.RootPriorityHi.Clone()
+	y.RootAddress = *x.RootAddress.Clone()
+	y.RootAddressMask = *x.RootAddressMask.Clone()
+	y.RootCost = *x.RootCost.Clone()
+	y.RootCostHi = *x.RootCostHi.Clone()
+	y.SenderPriority = *x.SenderPriority.Clone()
+	y.SenderPriorityHi = *x.SenderPriorityHi.Clone()
+	y.SenderAddress = *x.SenderAddress.Clone()
+	y.SenderAddressMask = *x.SenderAddressMask.Clone()
+	y.Port = *x.Port.Clone()
+	y.PortHi = *x.PortHi.Clone()
+	y.Age = *x.Age.Clone()
+	y.AgeHi = *x.AgeHi.Clone()
+	y.MaxAge = *x.MaxAge.Clone()
+	y.MaxAgeHi = *x.MaxAgeHi.Clone()
+	y.HelloTime = *x.HelloTime.Clone()
+	y.HelloTimeHi = *x.HelloTimeHi.Clone()
+	y.ForwardDelay = *x.ForwardDelay.Clone()
+	y.ForwardDelayHi = *x.ForwardDelayHi.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleSTP) Equal(y *NWFilterRuleSTP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Match.Equal(&y.Match) {
+		return false
+	}
+	if !x.SrcMACAddr.Equal(&y.SrcMACAddr) {
+		return false
+	}
+	if !x.SrcMACMask.Equal(&y.SrcMACMask) {
+		return false
+	}
+	if !x.Type.Equal(&y.Type) {
+		return false
+	}
+	if !x.Flags.Equal(&y.Flags) {
+		return false
+	}
+	if !x.RootPriority.Equal(&y.RootPriority) {
+		return false
+	}
+	if !x.RootPriorityHi.Equal(&y.RootPriorityHi) {
+		return false
+	}
+	if !x.RootAddress.Equal(&y.RootAddress) {
+		return false
+	}
+	if !x.RootAddressMask.Equal(&y.RootAddressMask) {
+		return false
+	}
+	if !x.RootCost.Equal(&y.RootCost) {
+		return false
+	}
+	if !x.RootCostHi.Equal(&y.RootCostHi) {
+		return false
+	}
+	if !x.SenderPriority.Equal(&y.SenderPriority) {
+		return false
+	}
+	if !x.SenderPriorityHi.Equal(&y.SenderPriorityHi) {
+		return false
+	}
+	if !x.SenderAddress.Equal(&y.SenderAddress) {
+		return false
+	}
+	if !x.SenderAddressMask.Equal(&y.SenderAddressMask) {
+		return false
+	}
+	if !x.Port.Equal(&y.Port) {
+		return false
+	}
+	if !x.PortHi.Equal(&y.PortHi) {
+		return false
+	}
+	if !x.Age.Equal(&y.Age) {
+		return false
+	}
+	if !x.AgeHi.Equal(&y.AgeHi) {
+		return false
+	}
+	if !x.MaxAge.Equal(&y.MaxAge) {
+		return false
+	}
+	if !x.MaxAgeHi.Equal(&y.MaxAgeHi) {
+		return false
+	}
+	if !x.HelloTime.Equal(&y.HelloTime) {
+		return false
+	}
+	if !x.HelloTimeHi.Equal(&y.HelloTimeHi) {
+		return false
+	}
+	if !x.ForwardDelay.Equal(&y.ForwardDelay) {
+		return false
+	}
+	if !x.ForwardDelayHi.Equal(&y.ForwardDelayHi) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleIP) Clone() *NWFilterRuleIP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonMAC = *x.NWFilterRuleCommonMAC.Clone()
+	y.SrcIPAddr = *x.SrcIPAddr.Clone()
+	y.SrcIPMask = *x.SrcIPMask.Clone()
+	y.DstIPAddr = *x.DstIPAddr.Clone()
+	y.DstIPMask = *x.DstIPMask.Clone()
+	y.Protocol = *x.Protocol.Clone()
+	y.NWFilterRuleCommonPort = *x.NWFilterRuleCommonPort.Clone()
+	y.DSCP = *x.DSCP.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleIP) Equal(y *NWFilterRuleIP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonMAC.Equal(&y.NWFilterRuleCommonMAC) {
+		return false
+	}
+	if !x.SrcIPAddr.Equal(&y.SrcIPAddr) {
+		return false
+	}
+	if !x.SrcIPMask.Equal(&y.SrcIPMask) {
+		return false
+	}
+	if !x.DstIPAddr.Equal(&y.DstIPAddr) {
+		return false
+	}
+	if !x.DstIPMask.Equal(&y.DstIPMask) {
+		return false
+	}
+	if !x.Protocol.Equal(&y.Protocol) {
+		return false
+	}
+	if !x.NWFilterRuleCommonPort.Equal(&y.NWFilterRuleCommonPort) {
+		return false
+	}
+	if !x.DSCP.Equal(&y.DSCP) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleIPv6) Clone() *NWFilterRuleIPv6 {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonMAC = *x.NWFilterRuleCommonMAC.Clone()
+	y.SrcIPAddr = *x.SrcIPAddr.Clone()
+	y.SrcIPMask = *x.SrcIPMask.Clone()
+	y.DstIPAddr = *x.DstIPAddr.Clone()
+	y.DstIPMask = *x.DstIPMask.Clone()
+	y.Protocol = *x.Protocol.Clone()
+	y.NWFilterRuleCommonPort = *x.NWFilterRuleCommonPort.Clone()
+	y.Type = *x.Type.Clone()
+	y.TypeEnd = *x.TypeEnd.Clone()
+	y.Code = *x.Code.Clone()
+	y.CodeEnd = *x.CodeEnd.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleIPv6) Equal(y *NWFilterRuleIPv6) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonMAC.Equal(&y.NWFilterRuleCommonMAC) {
+		return false
+	}
+	if !x.SrcIPAddr.Equal(&y.SrcIPAddr) {
+		return false
+	}
+	if !x.SrcIPMask.Equal(&y.SrcIPMask) {
+		return false
+	}
+	if !x.DstIPAddr.Equal(&y.DstIPAddr) {
+		return false
+	}
+	if !x.DstIPMask.Equal(&y.DstIPMask) {
+		return false
+	}
+	if !x.Protocol.Equal(&y.Protocol) {
+		return false
+	}
+	if !x.NWFilterRuleCommonPort.Equal(&y.NWFilterRuleCommonPort) {
+		return false
+	}
+	if !x.Type.Equal(&y.Type) {
+		return false
+	}
+	if !x.TypeEnd.Equal(&y.TypeEnd) {
+		return false
+	}
+	if !x.Code.Equal(&y.Code) {
+		return false
+	}
+	if !x.CodeEnd.Equal(&y.CodeEnd) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleTCP) Clone() *NWFilterRuleTCP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonIP = *x.NWFilterRuleCommonIP.Clone()
+	y.NWFilterRuleCommonPort = *x.NWFilterRuleCommonPort.Clone()
+	y.Option = *x.Option.Clone()
+	y.Flags = *x.Flags.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleTCP) Equal(y *NWFilterRuleTCP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonIP.Equal(&y.NWFilterRuleCommonIP) {
+		return false
+	}
+	if !x.NWFilterRuleCommonPort.Equal(&y.NWFilterRuleCommonPort) {
+		return false
+	}
+	if !x.Option.Equal(&y.Option) {
+		return false
+	}
+	if !x.Flags.Equal(&y.Flags) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleUDP) Clone() *NWFilterRuleUDP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonIP = *x.NWFilterRuleCommonIP.Clone()
+	y.NWFilterRuleCommonPort = *x.NWFilterRuleCommonPort.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleUDP) Equal(y *NWFilterRuleUDP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonIP.Equal(&y.NWFilterRuleCommonIP) {
+		return false
+	}
+	if !x.NWFilterRuleCommonPort.Equal(&y.NWFilterRuleCommonPort) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleUDPLite) Clone() *NWFilterRuleUDPLite {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonIP = *x.NWFilterRuleCommonIP.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleUDPLite) Equal(y *NWFilterRuleUDPLite) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonIP.Equal(&y.NWFilterRuleCommonIP) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleESP) Clone() *NWFilterRuleESP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonIP = *x.NWFilterRuleCommonIP.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleESP) Equal(y *NWFilterRuleESP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonIP.Equal(&y.NWFilterRuleCommonIP) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleAH) Clone() *NWFilterRuleAH {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonIP = *x.NWFilterRuleCommonIP.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleAH) Equal(y *NWFilterRuleAH) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonIP.Equal(&y.NWFilterRuleCommonIP) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleSCTP) Clone() *NWFilterRuleSCTP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonIP = *x.NWFilterRuleCommonIP.Clone()
+	y.NWFilterRuleCommonPort = *x.NWFilterRuleCommonPort.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleSCTP) Equal(y *NWFilterRuleSCTP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonIP.Equal(&y.NWFilterRuleCommonIP) {
+		return false
+	}
+	if !x.NWFilterRuleCommonPort.Equal(&y.NWFilterRuleCommonPort) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleICMP) Clone() *NWFilterRuleICMP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonIP = *x.NWFilterRuleCommonIP.Clone()
+	y.Type = *x.Type.Clone()
+	y.Code = *x.Code.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleICMP) Equal(y *NWFilterRuleICMP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonIP.Equal(&y.NWFilterRuleCommonIP) {
+		return false
+	}
+	if !x.Type.Equal(&y.Type) {
+		return false
+	}
+	if !x.Code.Equal(&y.Code) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleAll) Clone() *NWFilterRuleAll {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonIP = *x.NWFilterRuleCommonIP.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleAll) Equal(y *NWFilterRuleAll) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonIP.Equal(&y.NWFilterRuleCommonIP) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleIGMP) Clone() *NWFilterRuleIGMP {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonIP = *x.NWFilterRuleCommonIP.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleIGMP) Equal(y *NWFilterRuleIGMP) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonIP.Equal(&y.NWFilterRuleCommonIP) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleTCPIPv6) Clone() *NWFilterRuleTCPIPv6 {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonIP = *x.NWFilterRuleCommonIP.Clone()
+	y.NWFilterRuleCommonPort = *x.NWFilterRuleCommonPort.Clone()
+	y.Option = *x.Option.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleTCPIPv6) Equal(y *NWFilterRuleTCPIPv6) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonIP.Equal(&y.NWFilterRuleCommonIP) {
+		return false
+	}
+	if !x.NWFilterRuleCommonPort.Equal(&y.NWFilterRuleCommonPort) {
+		return false
+	}
+	if !x.Option.Equal(&y.Option) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleUDPIPv6) Clone() *NWFilterRuleUDPIPv6 {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonIP = *x.NWFilterRuleCommonIP.Clone()
+	y.NWFilterRuleCommonPort = *x.NWFilterRuleCommonPort.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleUDPIPv6) Equal(y *NWFilterRuleUDPIPv6) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonIP.Equal(&y.NWFilterRuleCommonIP) {
+		return false
+	}
+	if !x.NWFilterRuleCommonPort.Equal(&y.NWFilterRuleCommonPort) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleUDPLiteIPv6) Clone() *NWFilterRuleUDPLiteIPv6 {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonIP = *x.NWFilterRuleCommonIP.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleUDPLiteIPv6) Equal(y *NWFilterRuleUDPLiteIPv6) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonIP.Equal(&y.NWFilterRuleCommonIP) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleESPIPv6) Clone() *NWFilterRuleESPIPv6 {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonIP = *x.NWFilterRuleCommonIP.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleESPIPv6) Equal(y *NWFilterRuleESPIPv6) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonIP.Equal(&y.NWFilterRuleCommonIP) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleAHIPv6) Clone() *NWFilterRuleAHIPv6 {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonIP = *x.NWFilterRuleCommonIP.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleAHIPv6) Equal(y *NWFilterRuleAHIPv6) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonIP.Equal(&y.NWFilterRuleCommonIP) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleSCTPIPv6) Clone() *NWFilterRuleSCTPIPv6 {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonIP = *x.NWFilterRuleCommonIP.Clone()
+	y.NWFilterRuleCommonPort = *x.NWFilterRuleCommonPort.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleSCTPIPv6) Equal(y *NWFilterRuleSCTPIPv6) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonIP.Equal(&y.NWFilterRuleCommonIP) {
+		return false
+	}
+	if !x.NWFilterRuleCommonPort.Equal(&y.NWFilterRuleCommonPort) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleICMPIPv6) Clone() *NWFilterRuleICMPIPv6 {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonIP = *x.NWFilterRuleCommonIP.Clone()
+	y.Type = *x.Type.Clone()
+	y.Code = *x.Code.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleICMPIPv6) Equal(y *NWFilterRuleICMPIPv6) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonIP.Equal(&y.NWFilterRuleCommonIP) {
+		return false
+	}
+	if !x.Type.Equal(&y.Type) {
+		return false
+	}
+	if !x.Code.Equal(&y.Code) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterRuleAllIPv6) Clone() *NWFilterRuleAllIPv6 {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.NWFilterRuleCommonIP = *x.NWFilterRuleCommonIP.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterRuleAllIPv6) Equal(y *NWFilterRuleAllIPv6) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Match != y.Match {
+		return false
+	}
+	if !x.NWFilterRuleCommonIP.Equal(&y.NWFilterRuleCommonIP) {
+		return false
+	}
+	if x.Comment != y.Comment {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterBinding) Clone() *NWFilterBinding {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Owner = x.Owner.Clone()
+	y.PortDev = x.PortDev.Clone()
+	y.MAC = x.MAC.Clone()
+	y.FilterRef = x.FilterRef.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterBinding) Equal(y *NWFilterBinding) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Owner.Equal(y.Owner) {
+		return false
+	}
+	if !x.PortDev.Equal(y.PortDev) {
+		return false
+	}
+	if !x.MAC.Equal(y.MAC) {
+		return false
+	}
+	if !x.FilterRef.Equal(y.FilterRef) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterBindingOwner) Clone() *NWFilterBindingOwner {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterBindingOwner) Equal(y *NWFilterBindingOwner) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.UUID != y.UUID {
+		return false
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterBindingPortDev) Clone() *NWFilterBindingPortDev {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterBindingPortDev) Equal(y *NWFilterBindingPortDev) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterBindingMAC) Clone() *NWFilterBindingMAC {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterBindingMAC) Equal(y *NWFilterBindingMAC) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Address != y.Address {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterBindingFilterRef) Clone() *NWFilterBindingFilterRef {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Parameters != nil {
+		y.Parameters = make([]NWFilterBindingFilterParam, len(x.Parameters))
+		copy(y.Parameters, x.Parameters)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterBindingFilterRef) Equal(y *NWFilterBindingFilterRef) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Filter != y.Filter {
+		return false
+	}
+	if len(x.Parameters) != len(y.Parameters) {
+		return false
+	}
+	for i := range x.Parameters {
+		if !x.Parameters[i].Equal(&y.Parameters[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *NWFilterBindingFilterParam) Clone() *NWFilterBindingFilterParam {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *NWFilterBindingFilterParam) Equal(y *NWFilterBindingFilterParam) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *SecretUsage) Clone() *SecretUsage {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *SecretUsage) Equal(y *SecretUsage) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Volume != y.Volume {
+		return false
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Target != y.Target {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *Secret) Clone() *Secret {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Usage = x.Usage.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *Secret) Equal(y *Secret) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Ephemeral != y.Ephemeral {
+		return false
+	}
+	if x.Private != y.Private {
+		return false
+	}
+	if x.Description != y.Description {
+		return false
+	}
+	if x.UUID != y.UUID {
+		return false
+	}
+	if !x.Usage.Equal(y.Usage) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StorageEncryptionSecret) Clone() *StorageEncryptionSecret {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StorageEncryptionSecret) Equal(y *StorageEncryptionSecret) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.UUID != y.UUID {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StorageEncryptionCipher) Clone() *StorageEncryptionCipher {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StorageEncryptionCipher) Equal(y *StorageEncryptionCipher) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Size != y.Size {
+		return false
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	if x.Hash != y.Hash {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StorageEncryptionIvgen) Clone() *StorageEncryptionIvgen {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StorageEncryptionIvgen) Equal(y *StorageEncryptionIvgen) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Hash != y.Hash {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StorageEncryption) Clone() *StorageEncryption {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Secret = x.Secret.Clone()
+	y.Cipher = x.Cipher.Clone()
+	y.Ivgen = x.Ivgen.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StorageEncryption) Equal(y *StorageEncryption) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Format != y.Format {
+		return false
+	}
+	if !x.Secret.Equal(y.Secret) {
+		return false
+	}
+	if !x.Cipher.Equal(y.Cipher) {
+		return false
+	}
+	if !x.Ivgen.Equal(y.Ivgen) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolSize) Clone() *StoragePoolSize {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolSize) Equal(y *StoragePoolSize) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolTargetPermissions) Clone() *StoragePoolTargetPermissions {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolTargetPermissions) Equal(y *StoragePoolTargetPermissions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Owner != y.Owner {
+		return false
+	}
+	if x.Group != y.Group {
+		return false
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	if x.Label != y.Label {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolTargetTimestamps) Clone() *StoragePoolTargetTimestamps {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolTargetTimestamps) Equal(y *StoragePoolTargetTimestamps) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Atime != y.Atime {
+		return false
+	}
+	if x.Mtime != y.Mtime {
+		return false
+	}
+	if x.Ctime != y.Ctime {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolTarget) Clone() *StoragePoolTarget {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Permissions = x.Permissions.Clone()
+	y.Timestamps = x.Timestamps.Clone()
+	y.Encryption = x.Encryption.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolTarget) Equal(y *StoragePoolTarget) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	if !x.Permissions.Equal(y.Permissions) {
+		return false
+	}
+	if !x.Timestamps.Equal(y.Timestamps) {
+		return false
+	}
+	if !x.Encryption.Equal(y.Encryption) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolSourceFormat) Clone() *StoragePoolSourceFormat {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolSourceFormat) Equal(y *StoragePoolSourceFormat) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolSourceProtocol) Clone() *StoragePoolSourceProtocol {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolSourceProtocol) Equal(y *StoragePoolSourceProtocol) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Version != y.Version {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolSourceHost) Clone() *StoragePoolSourceHost {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolSourceHost) Equal(y *StoragePoolSourceHost) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Port != y.Port {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolSourceDevice) Clone() *StoragePoolSourceDevice {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.FreeExtents != nil {
+		y.FreeExtents = make([]StoragePoolSourceDeviceFreeExtent, len(x.FreeExtents))
+		copy(y.FreeExtents, x.FreeExtents)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolSourceDevice) Equal(y *StoragePoolSourceDevice) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	if x.PartSeparator != y.PartSeparator {
+		return false
+	}
+	if len(x.FreeExtents) != len(y.FreeExtents) {
+		return false
+	}
+	for i := range x.FreeExtents {
+		if !x.FreeExtents[i].Equal(&y.FreeExtents[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolSourceDeviceFreeExtent) Clone() *StoragePoolSourceDeviceFreeExtent {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolSourceDeviceFreeExtent) Equal(y *StoragePoolSourceDeviceFreeExtent) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Start != y.Start {
+		return false
+	}
+	if x.End != y.End {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolSourceAuthSecret) Clone() *StoragePoolSourceAuthSecret {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolSourceAuthSecret) Equal(y *StoragePoolSourceAuthSecret) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Usage != y.Usage {
+		return false
+	}
+	if x.UUID != y.UUID {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolSourceAuth) Clone() *StoragePoolSourceAuth {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Secret = x.Secret.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolSourceAuth) Equal(y *StoragePoolSourceAuth) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Username != y.Username {
+		return false
+	}
+	if !x.Secret.Equal(y.Secret) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolSourceVendor) Clone() *StoragePoolSourceVendor {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolSourceVendor) Equal(y *StoragePoolSourceVendor) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolSourceProduct) Clone() *StoragePoolSourceProduct {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolSourceProduct) Equal(y *StoragePoolSourceProduct) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolPCIAddress) Clone() *StoragePoolPCIAddress {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Domain != nil {
+		v := *x.Domain
+		y.Domain = &v
+	}
+	if x.Bus != nil {
+		v := *x.Bus
+		y.Bus = &v
+	}
+	if x.Slot != nil {
+		v := *x.Slot
+		y.Slot = &v
+	}
+	if x.Function != nil {
+		v := *x.Function
+		y.Function = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolPCIAddress) Equal(y *StoragePoolPCIAddress) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.Domain == nil) != (y.Domain == nil) {
+		return false
+	}
+	if x.Domain != nil {
+		if (*x.Domain) != (*y.Domain) {
+			return false
+		}
+	}
+	if (x.Bus == nil) != (y.Bus == nil) {
+		return false
+	}
+	if x.Bus != nil {
+		if (*x.Bus) != (*y.Bus) {
+			return false
+		}
+	}
+	if (x.Slot == nil) != (y.Slot == nil) {
+		return false
+	}
+	if x.Slot != nil {
+		if (*x.Slot) != (*y.Slot) {
+			return false
+		}
+	}
+	if (x.Function == nil) != (y.Function == nil) {
+		return false
+	}
+	if x.Function != nil {
+		if (*x.Function) != (*y.Function) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolSourceAdapterParentAddr) Clone() *StoragePoolSourceAdapterParentAddr {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Address = x.Address.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolSourceAdapterParentAddr) Equal(y *StoragePoolSourceAdapterParentAddr) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.UniqueID != y.UniqueID {
+		return false
+	}
+	if !x.Address.Equal(y.Address) &&
+		!(x.Address == nil && y.Address.Equal(&StoragePoolPCIAddress{})) &&
+		!(y.Address == nil && x.Address.Equal(&StoragePoolPCIAddress{})) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolSourceAdapter) Clone() *StoragePoolSourceAdapter {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.ParentAddr = x.ParentAddr.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolSourceAdapter) Equal(y *StoragePoolSourceAdapter) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Parent != y.Parent {
+		return false
+	}
+	if x.Managed != y.Managed {
+		return false
+	}
+	if x.WWNN != y.WWNN {
+		return false
+	}
+	if x.WWPN != y.WWPN {
+		return false
+	}
+	if !x.ParentAddr.Equal(y.ParentAddr) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolSourceDir) Clone() *StoragePoolSourceDir {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolSourceDir) Equal(y *StoragePoolSourceDir) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolSourceInitiator) Clone() *StoragePoolSourceInitiator {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolSourceInitiator) Equal(y *StoragePoolSourceInitiator) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.IQN.Equal(&y.IQN) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolSourceInitiatorIQN) Clone() *StoragePoolSourceInitiatorIQN {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolSourceInitiatorIQN) Equal(y *StoragePoolSourceInitiatorIQN) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolSource) Clone() *StoragePoolSource {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Dir = x.Dir.Clone()
+	if x.Host != nil {
+		y.Host = make([]StoragePoolSourceHost, len(x.Host))
+		copy(y.Host, x.Host)
+	}
+	if x.Device != nil {
+		y.Device = make([]StoragePoolSourceDevice, len(x.Device))
+		copy(y.Device, x.Device)
+		for i := range x.Device {
+			y.Device[i] = *x.Device[i].Clone()
+		}
+	}
+	y.Auth = x.Auth.Clone()
+	y.Vendor = x.Vendor.Clone()
+	y.Product = x.Product.Clone()
+	y.Format = x.Format.Clone()
+	y.Protocol = x.Protocol.Clone()
+	y.Adapter = x.Adapter.Clone()
+	y.Initiator = x.Initiator.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolSource) Equal(y *StoragePoolSource) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if !x.Dir.Equal(y.Dir) {
+		return false
+	}
+	if len(x.Host) != len(y.Host) {
+		return false
+	}
+	for i := range x.Host {
+		if !x.Host[i].Equal(&y.Host[i]) {
+			return false
+		}
+	}
+	if len(x.Device) != len(y.Device) {
+		return false
+	}
+	for i := range x.Device {
+		if !x.Device[i].Equal(&y.Device[i]) {
+			return false
+		}
+	}
+	if !x.Auth.Equal(y.Auth) {
+		return false
+	}
+	if !x.Vendor.Equal(y.Vendor) {
+		return false
+	}
+	if !x.Product.Equal(y.Product) {
+		return false
+	}
+	if !x.Format.Equal(y.Format) {
+		return false
+	}
+	if !x.Protocol.Equal(y.Protocol) {
+		return false
+	}
+	if !x.Adapter.Equal(y.Adapter) {
+		return false
+	}
+	if !x.Initiator.Equal(y.Initiator) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolRefreshVol) Clone() *StoragePoolRefreshVol {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolRefreshVol) Equal(y *StoragePoolRefreshVol) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Allocation != y.Allocation {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolRefresh) Clone() *StoragePoolRefresh {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolRefresh) Equal(y *StoragePoolRefresh) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.Volume.Equal(&y.Volume) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolFeatures) Clone() *StoragePoolFeatures {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolFeatures) Equal(y *StoragePoolFeatures) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.COW.Equal(&y.COW) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolFeatureCOW) Clone() *StoragePoolFeatureCOW {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolFeatureCOW) Equal(y *StoragePoolFeatureCOW) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.State != y.State {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePool) Clone() *StoragePool {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Allocation = x.Allocation.Clone()
+	y.Capacity = x.Capacity.Clone()
+	y.Available = x.Available.Clone()
+	y.Features = x.Features.Clone()
+	y.Target = x.Target.Clone()
+	y.Source = x.Source.Clone()
+	y.Refresh = x.Refresh.Clone()
+	y.FSCommandline = x.FSCommandline.Clone()
+	y.RBDCommandline = x.RBDCommandline.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePool) Equal(y *StoragePool) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.UUID != y.UUID {
+		return false
+	}
+	if !x.Allocation.Equal(y.Allocation) {
+		return false
+	}
+	if !x.Capacity.Equal(y.Capacity) {
+		return false
+	}
+	if !x.Available.Equal(y.Available) {
+		return false
+	}
+	if !x.Features.Equal(y.Features) {
+		return false
+	}
+	if !x.Target.Equal(y.Target) {
+		return false
+	}
+	if !x.Source.Equal(y.Source) {
+		return false
+	}
+	if !x.Refresh.Equal(y.Refresh) {
+		return false
+	}
+	if !x.FSCommandline.Equal(y.FSCommandline) {
+		return false
+	}
+	if !x.RBDCommandline.Equal(y.RBDCommandline) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolFSCommandlineOption) Clone() *StoragePoolFSCommandlineOption {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolFSCommandlineOption) Equal(y *StoragePoolFSCommandlineOption) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolFSCommandline) Clone() *StoragePoolFSCommandline {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Options != nil {
+		y.Options = make([]StoragePoolFSCommandlineOption, len(x.Options))
+		copy(y.Options, x.Options)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolFSCommandline) Equal(y *StoragePoolFSCommandline) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Options) != len(y.Options) {
+		return false
+	}
+	for i := range x.Options {
+		if !x.Options[i].Equal(&y.Options[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolRBDCommandlineOption) Clone() *StoragePoolRBDCommandlineOption {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolRBDCommandlineOption) Equal(y *StoragePoolRBDCommandlineOption) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolRBDCommandline) Clone() *StoragePoolRBDCommandline {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Options != nil {
+		y.Options = make([]StoragePoolRBDCommandlineOption, len(x.Options))
+		copy(y.Options, x.Options)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolRBDCommandline) Equal(y *StoragePoolRBDCommandline) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.Options) != len(y.Options) {
+		return false
+	}
+	for i := range x.Options {
+		if !x.Options[i].Equal(&y.Options[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolCapabilities) Clone() *StoragePoolCapabilities {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.PoolTypes != nil {
+		y.PoolTypes = make([]StoragePoolCapabilitiesPoolType, len(x.PoolTypes))
+		copy(y.PoolTypes, x.PoolTypes)
+		for i := range x.PoolTypes {
+			y.PoolTypes[i] = *x.PoolTypes[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolCapabilities) Equal(y *StoragePoolCapabilities) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if len(x.PoolTypes) != len(y.PoolTypes) {
+		return false
+	}
+	for i := range x.PoolTypes {
+		if !x.PoolTypes[i].Equal(&y.PoolTypes[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolCapabilitiesPoolType) Clone() *StoragePoolCapabilitiesPoolType {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.PoolOptions = x.PoolOptions.Clone()
+	y.VolOptions = x.VolOptions.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolCapabilitiesPoolType) Equal(y *StoragePoolCapabilitiesPoolType) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Supported != y.Supported {
+		return false
+	}
+	if !x.PoolOptions.Equal(y.PoolOptions) {
+		return false
+	}
+	if !x.VolOptions.Equal(y.VolOptions) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolCapabilitiesPoolOptions) Clone() *StoragePoolCapabilitiesPoolOptions {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.DefaultFormat = x.DefaultFormat.Clone()
+	if x.Enums != nil {
+		y.Enums = make([]StoragePoolCapabilitiesEnum, len(x.Enums))
+		copy(y.Enums, x.Enums)
+		for i := range x.Enums {
+			y.Enums[i] = *x.Enums[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolCapabilitiesPoolOptions) Equal(y *StoragePoolCapabilitiesPoolOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.DefaultFormat.Equal(y.DefaultFormat) {
+		return false
+	}
+	if len(x.Enums) != len(y.Enums) {
+		return false
+	}
+	for i := range x.Enums {
+		if !x.Enums[i].Equal(&y.Enums[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolCapabilitiesVolOptions) Clone() *StoragePoolCapabilitiesVolOptions {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.DefaultFormat = x.DefaultFormat.Clone()
+	if x.Enums != nil {
+		y.Enums = make([]StoragePoolCapabilitiesEnum, len(x.Enums))
+		copy(y.Enums, x.Enums)
+		for i := range x.Enums {
+			y.Enums[i] = *x.Enums[i].Clone()
+		}
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolCapabilitiesVolOptions) Equal(y *StoragePoolCapabilitiesVolOptions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if !x.DefaultFormat.Equal(y.DefaultFormat) {
+		return false
+	}
+	if len(x.Enums) != len(y.Enums) {
+		return false
+	}
+	for i := range x.Enums {
+		if !x.Enums[i].Equal(&y.Enums[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolCapabilitiesDefaultFormat) Clone() *StoragePoolCapabilitiesDefaultFormat {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolCapabilitiesDefaultFormat) Equal(y *StoragePoolCapabilitiesDefaultFormat) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StoragePoolCapabilitiesEnum) Clone() *StoragePoolCapabilitiesEnum {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.Values != nil {
+		y.Values = make([]string, len(x.Values))
+		copy(y.Values, x.Values)
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StoragePoolCapabilitiesEnum) Equal(y *StoragePoolCapabilitiesEnum) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if len(x.Values) != len(y.Values) {
+		return false
+	}
+	for i := range x.Values {
+		if x.Values[i] != y.Values[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StorageVolumeSize) Clone() *StorageVolumeSize {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StorageVolumeSize) Equal(y *StorageVolumeSize) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StorageVolumeTargetPermissions) Clone() *StorageVolumeTargetPermissions {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StorageVolumeTargetPermissions) Equal(y *StorageVolumeTargetPermissions) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Owner != y.Owner {
+		return false
+	}
+	if x.Group != y.Group {
+		return false
+	}
+	if x.Mode != y.Mode {
+		return false
+	}
+	if x.Label != y.Label {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StorageVolumeTargetFeature) Clone() *StorageVolumeTargetFeature {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	if x.LazyRefcounts != nil {
+		v := *x.LazyRefcounts
+		y.LazyRefcounts = &v
+	}
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StorageVolumeTargetFeature) Equal(y *StorageVolumeTargetFeature) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if (x.LazyRefcounts == nil) != (y.LazyRefcounts == nil) {
+		return false
+	}
+	if x.LazyRefcounts != nil {
+		if (*x.LazyRefcounts) != (*y.LazyRefcounts) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StorageVolumeTargetFormat) Clone() *StorageVolumeTargetFormat {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StorageVolumeTargetFormat) Equal(y *StorageVolumeTargetFormat) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StorageVolumeTargetTimestamps) Clone() *StorageVolumeTargetTimestamps {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StorageVolumeTargetTimestamps) Equal(y *StorageVolumeTargetTimestamps) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Atime != y.Atime {
+		return false
+	}
+	if x.Mtime != y.Mtime {
+		return false
+	}
+	if x.Ctime != y.Ctime {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StorageVolumeTargetCluterSize) Clone() *StorageVolumeTargetCluterSize {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StorageVolumeTargetCluterSize) Equal(y *StorageVolumeTargetCluterSize) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Unit != y.Unit {
+		return false
+	}
+	if x.Value != y.Value {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StorageVolumeTarget) Clone() *StorageVolumeTarget {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Format = x.Format.Clone()
+	y.Permissions = x.Permissions.Clone()
+	y.Timestamps = x.Timestamps.Clone()
+	y.ClusterSize = x.ClusterSize.Clone()
+	if x.NoCOW != nil {
+		v := *x.NoCOW
+		y.NoCOW = &v
+	}
+	if x.Features != nil {
+		y.Features = make([]StorageVolumeTargetFeature, len(x.Features))
+		copy(y.Features, x.Features)
+		for i := range x.Features {
+			y.Features[i] = *x.Features[i].Clone()
+		}
+	}
+	y.Encryption = x.Encryption.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StorageVolumeTarget) Equal(y *StorageVolumeTarget) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	if !x.Format.Equal(y.Format) {
+		return false
+	}
+	if !x.Permissions.Equal(y.Permissions) {
+		return false
+	}
+	if !x.Timestamps.Equal(y.Timestamps) {
+		return false
+	}
+	if x.Compat != y.Compat {
+		return false
+	}
+	if !x.ClusterSize.Equal(y.ClusterSize) {
+		return false
+	}
+	if (x.NoCOW == nil) != (y.NoCOW == nil) {
+		return false
+	}
+	if x.NoCOW != nil {
+		if (*x.NoCOW) != (*y.NoCOW) {
+			return false
+		}
+	}
+	if len(x.Features) != len(y.Features) {
+		return false
+	}
+	for i := range x.Features {
+		if !x.Features[i].Equal(&y.Features[i]) {
+			return false
+		}
+	}
+	if !x.Encryption.Equal(y.Encryption) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StorageVolumeBackingStore) Clone() *StorageVolumeBackingStore {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Format = x.Format.Clone()
+	y.Permissions = x.Permissions.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StorageVolumeBackingStore) Equal(y *StorageVolumeBackingStore) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	if !x.Format.Equal(y.Format) {
+		return false
+	}
+	if !x.Permissions.Equal(y.Permissions) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of x
+func (x *StorageVolume) Clone() *StorageVolume {
+	if x == nil {
+		return nil
+	}
+	y := *x
+	y.Allocation = x.Allocation.Clone()
+	y.Capacity = x.Capacity.Clone()
+	y.Physical = x.Physical.Clone()
+	y.Target = x.Target.Clone()
+	y.BackingStore = x.BackingStore.Clone()
+	return &y
+}
+
+// Equal reports whether x and y would marshal to the same XML
+func (x *StorageVolume) Equal(y *StorageVolume) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Key != y.Key {
+		return false
+	}
+	if !x.Allocation.Equal(y.Allocation) {
+		return false
+	}
+	if !x.Capacity.Equal(y.Capacity) {
+		return false
+	}
+	if !x.Physical.Equal(y.Physical) {
+		return false
+	}
+	if !x.Target.Equal(y.Target) {
+		return false
+	}
+	if !x.BackingStore.Equal(y.BackingStore) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,176 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDocumentCloneEqual(t *testing.T) {
+	var docs []Document
+	for _, test := range domainTestData {
+		docs = append(docs, test.Object)
+	}
+	for _, test := range networkTestData {
+		docs = append(docs, test.Object)
+	}
+	for _, test := range storagePoolTestData {
+		docs = append(docs, test.Object)
+	}
+	for _, test := range storageVolumeTestData {
+		docs = append(docs, test.Object)
+	}
+	for _, test := range NodeDeviceTestData {
+		docs = append(docs, test.Object)
+	}
+
+	for _, doc := range docs {
+		obj := reflect.ValueOf(doc)
+		clone := obj.MethodByName("Clone").Call(nil)[0]
+
+		xmldoc, err := doc.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		clonedoc, err := clone.Interface().(Document).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if xmldoc != clonedoc {
+			t.Fatal("Bad clone xml:\n", clonedoc, "\n does not match\n", xmldoc)
+		}
+
+		parsed := reflect.New(obj.Elem().Type())
+		err = parsed.Interface().(Document).Unmarshal(xmldoc)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, other := range []reflect.Value{clone, parsed} {
+			equal := obj.MethodByName("Equal").Call([]reflect.Value{other})[0]
+			if !equal.Bool() {
+				t.Fatal("Expected equal documents for\n", xmldoc)
+			}
+		}
+	}
+}
+
+func TestDomainCloneIsDeep(t *testing.T) {
+	slot := uint(3)
+	dom := &Domain{
+		Name: "demo",
+		Devices: &DomainDeviceList{
+			Disks: []DomainDisk{
+				DomainDisk{
+					Target: &DomainDiskTarget{
+						Dev: "vda",
+					},
+					Address: &DomainAddress{
+						PCI: &DomainAddressPCI{
+							Slot: &slot,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	clone := dom.Clone()
+	if !dom.Equal(clone) {
+		t.Fatal("Expected clone to be equal")
+	}
+
+	clone.Devices.Disks[0].Target.Dev = "vdb"
+	*clone.Devices.Disks[0].Address.PCI.Slot = 4
+	if dom.Devices.Disks[0].Target.Dev != "vda" ||
+		*dom.Devices.Disks[0].Address.PCI.Slot != 3 {
+		t.Fatal("Modifying clone changed the original")
+	}
+	if dom.Equal(clone) {
+		t.Fatal("Expected modified clone to differ")
+	}
+}
+
+func TestDomainEqualSemantic(t *testing.T) {
+	var tests = []struct {
+		A     string
+		B     string
+		Equal bool
+	}{
+		{
+			`<domain type="kvm"><name>demo</name></domain>`,
+			`<domain type='kvm'>
+			   <name>demo</name>
+			   <devices/>
+			 </domain>`,
+			false,
+		},
+		{
+			`<domain type="kvm"><devices><disk type="file"><address type="pci" slot="0x03"/></disk></devices></domain>`,
+			`<domain type="kvm"><devices><disk type="file"><address type="pci" slot="3"/></disk></devices></domain>`,
+			true,
+		},
+		{
+			`<domain type="kvm"><memory unit="KiB">1024</memory></domain>`,
+			`<domain type="kvm"><memory unit="KiB">01024</memory></domain>`,
+			true,
+		},
+		{
+			`<domain type="kvm"><memory unit="KiB">1024</memory></domain>`,
+			`<domain type="kvm"><memory unit="MiB">1</memory></domain>`,
+			false,
+		},
+	}
+
+	for _, test := range tests {
+		a := &Domain{}
+		if err := a.Unmarshal(test.A); err != nil {
+			t.Fatal(err)
+		}
+		b := &Domain{}
+		if err := b.Unmarshal(test.B); err != nil {
+			t.Fatal(err)
+		}
+		if a.Equal(b) != test.Equal {
+			t.Errorf("Expected Equal %v for\n%s\nand\n%s", test.Equal, test.A, test.B)
+		}
+	}
+
+	empty := &Domain{
+		Name: "demo",
+		Devices: &DomainDeviceList{
+			Disks: []DomainDisk{},
+		},
+	}
+	nilslice := &Domain{
+		Name:    "demo",
+		Devices: &DomainDeviceList{},
+	}
+	if !empty.Equal(nilslice) {
+		t.Fatal("Expected empty and nil slices to be equal")
+	}
+}
@@ -0,0 +1,360 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type DocumentChangeKind string
+
+const (
+	DocumentChangeAdded    DocumentChangeKind = "added"
+	DocumentChangeRemoved  DocumentChangeKind = "removed"
+	DocumentChangeModified DocumentChangeKind = "modified"
+)
+
+// DocumentChange describes a single difference between two
+// documents. Path is relative to the document root element, for
+// example "devices/disk[target=vda]/driver/@cache". For changes
+// to attributes and text content, Old and New hold the values
+// before and after the change
+type DocumentChange struct {
+	Kind DocumentChangeKind
+	Path string
+	Old  string
+	New  string
+}
+
+func (c DocumentChange) String() string {
+	switch c.Kind {
+	case DocumentChangeAdded:
+		if c.New != "" {
+			return fmt.Sprintf("+ %s: '%s'", c.Path, c.New)
+		}
+		return fmt.Sprintf("+ %s", c.Path)
+	case DocumentChangeRemoved:
+		if c.Old != "" {
+			return fmt.Sprintf("- %s: '%s'", c.Path, c.Old)
+		}
+		return fmt.Sprintf("- %s", c.Path)
+	default:
+		return fmt.Sprintf("~ %s: '%s' -> '%s'", c.Path, c.Old, c.New)
+	}
+}
+
+// The natural keys used to match up repeated child elements,
+// so that reordering devices does not show up as a change. Each
+// key is a list of alternatives tried in turn, and each
+// alternative is a list of attribute paths which are combined
+var documentDiffKeys = map[string][][]string{
+	"disk":       {{"target/@dev"}, {"@name"}},
+	"interface":  {{"mac/@address"}, {"@dev"}, {"alias/@name"}},
+	"controller": {{"@type", "@index"}},
+	"filesystem": {{"target/@dir"}},
+	"graphics":   {{"@type"}},
+	"channel":    {{"target/@name"}},
+	"serial":     {{"target/@port"}},
+	"console":    {{"target/@port"}},
+	"ip":         {{"@address"}, {"@family"}},
+	"portgroup":  {{"@name"}},
+	"host":       {{"@mac"}, {"@id"}, {"@ip"}, {"@name"}},
+	"range":      {{"@start"}},
+	"forwarder":  {{"@addr"}, {"@domain"}},
+	"txt":        {{"@name"}},
+	"srv":        {{"@service", "@protocol"}},
+	"pf":         {{"@dev"}},
+	"feature":    {{"@name"}},
+	"vcpupin":    {{"@vcpu"}},
+	"iothread":   {{"@id"}},
+	"cell":       {{"@id"}},
+	"event":      {{"@name"}},
+	"entry":      {{"@name"}},
+	"device":     {{"@path"}},
+	"hostdev": {
+		{"source/address/@domain", "source/address/@bus",
+			"source/address/@slot", "source/address/@function"},
+		{"source/address/@uuid"},
+		{"source/address/@bus", "source/address/@device"},
+		{"source/vendor/@id", "source/product/@id"},
+		{"alias/@name"},
+	},
+}
+
+func documentDiffLookup(el *XMLElement, path string) string {
+	parts := strings.Split(path, "/")
+	for _, part := range parts[:len(parts)-1] {
+		var next *XMLElement
+		for _, child := range el.Children {
+			if child.Name == part {
+				next = child
+				break
+			}
+		}
+		if next == nil {
+			return ""
+		}
+		el = next
+	}
+	last := parts[len(parts)-1]
+	if strings.HasPrefix(last, "@") {
+		return el.Attrs[last[1:]]
+	}
+	return ""
+}
+
+func documentDiffKeyLabel(path string) string {
+	if strings.HasPrefix(path, "@") {
+		return path[1:]
+	}
+	return path[0:strings.Index(path, "/")]
+}
+
+// Keys combining several attributes are labelled by attribute
+// name, or by element name where the attribute names repeat
+func documentDiffKeyLabels(alternative []string) []string {
+	if len(alternative) == 1 {
+		return []string{documentDiffKeyLabel(alternative[0])}
+	}
+	labels := make([]string, len(alternative))
+	counts := make(map[string]int)
+	for i, path := range alternative {
+		labels[i] = path[strings.LastIndex(path, "@")+1:]
+		counts[labels[i]]++
+	}
+	for i, path := range alternative {
+		if counts[labels[i]] > 1 {
+			parts := strings.Split(path, "/")
+			labels[i] = parts[len(parts)-2]
+		}
+	}
+	return labels
+}
+
+func documentDiffKey(el *XMLElement) string {
+	alternatives, ok := documentDiffKeys[el.Name]
+	if !ok {
+		alternatives = [][]string{{"alias/@name"}}
+	}
+	for _, alternative := range alternatives {
+		var labels []string
+		names := documentDiffKeyLabels(alternative)
+		for i, path := range alternative {
+			val := documentDiffLookup(el, path)
+			if val == "" {
+				labels = nil
+				break
+			}
+			labels = append(labels, names[i]+"="+val)
+		}
+		if labels != nil {
+			return strings.Join(labels, ",")
+		}
+	}
+	return ""
+}
+
+func documentDiffChildCounts(a, b *XMLElement) map[string]int {
+	counts := make(map[string]int)
+	for _, el := range []*XMLElement{a, b} {
+		elcounts := make(map[string]int)
+		for _, child := range el.Children {
+			elcounts[child.Name]++
+			if elcounts[child.Name] > counts[child.Name] {
+				counts[child.Name] = elcounts[child.Name]
+			}
+		}
+	}
+	return counts
+}
+
+func documentDiffChildCount(el *XMLElement, name string) int {
+	count := 0
+	for _, child := range el.Children {
+		if child.Name == name {
+			count++
+		}
+	}
+	return count
+}
+
+// documentDiffChildNames assigns each child element a path
+// segment which identifies it independently of its position,
+// where possible. Elements which are never repeated are left
+// without any qualifier
+func documentDiffChildNames(el *XMLElement, counts map[string]int) []string {
+	names := make([]string, len(el.Children))
+	seen := make(map[string]int)
+	positions := make(map[string]int)
+	for i, child := range el.Children {
+		key := documentDiffKey(child)
+		var name string
+		if counts[child.Name] <= 1 && key == "" {
+			name = child.Name
+		} else if key != "" {
+			name = child.Name + "[" + key + "]"
+		} else {
+			name = fmt.Sprintf("%s[%d]", child.Name, positions[child.Name])
+			positions[child.Name]++
+		}
+		if n := seen[name]; n > 0 {
+			names[i] = fmt.Sprintf("%s[%d]", name, n)
+		} else {
+			names[i] = name
+		}
+		seen[name]++
+	}
+	return names
+}
+
+func documentDiffJoin(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "/" + name
+}
+
+func documentDiffElement(path string, a, b *XMLElement, changes []DocumentChange) []DocumentChange {
+	var keys []string
+	for key := range a.Attrs {
+		keys = append(keys, key)
+	}
+	for key := range b.Attrs {
+		if _, ok := a.Attrs[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		attrPath := documentDiffJoin(path, "@"+key)
+		aval, aok := a.Attrs[key]
+		bval, bok := b.Attrs[key]
+		if !aok {
+			changes = append(changes, DocumentChange{
+				Kind: DocumentChangeAdded, Path: attrPath, New: bval,
+			})
+		} else if !bok {
+			changes = append(changes, DocumentChange{
+				Kind: DocumentChangeRemoved, Path: attrPath, Old: aval,
+			})
+		} else if !XMLValuesEqual(aval, bval) {
+			changes = append(changes, DocumentChange{
+				Kind: DocumentChangeModified, Path: attrPath, Old: aval, New: bval,
+			})
+		}
+	}
+
+	if !XMLValuesEqual(strings.TrimSpace(a.Content), strings.TrimSpace(b.Content)) {
+		changes = append(changes, DocumentChange{
+			Kind: DocumentChangeModified, Path: path, Old: a.Content, New: b.Content,
+		})
+	}
+
+	counts := documentDiffChildCounts(a, b)
+	anames := documentDiffChildNames(a, counts)
+	bnames := documentDiffChildNames(b, counts)
+	bindex := make(map[string]int)
+	for i, name := range bnames {
+		bindex[name] = i
+	}
+	// An element which is not repeated on either side is matched
+	// by name if only one side has a key, since a live domain has
+	// device aliases which a persistent one lacks
+	bsingle := make(map[string]int)
+	for i, child := range b.Children {
+		if documentDiffChildCount(a, child.Name) == 1 &&
+			documentDiffChildCount(b, child.Name) == 1 {
+			bsingle[child.Name] = i
+		}
+	}
+	used := make(map[string]bool)
+	for i, name := range anames {
+		childPath := documentDiffJoin(path, name)
+		j, ok := bindex[name]
+		if !ok {
+			j, ok = bsingle[a.Children[i].Name]
+			if ok && documentDiffKey(a.Children[i]) != "" &&
+				documentDiffKey(b.Children[j]) != "" {
+				ok = false
+			}
+			if ok {
+				name = bnames[j]
+				childPath = documentDiffJoin(path, a.Children[i].Name)
+			}
+		}
+		if !ok {
+			changes = append(changes, DocumentChange{
+				Kind: DocumentChangeRemoved, Path: childPath,
+			})
+			continue
+		}
+		used[name] = true
+		changes = documentDiffElement(childPath, a.Children[i], b.Children[j], changes)
+	}
+	for _, name := range bnames {
+		if !used[name] {
+			changes = append(changes, DocumentChange{
+				Kind: DocumentChangeAdded, Path: documentDiffJoin(path, name),
+			})
+		}
+	}
+
+	return changes
+}
+
+// DiffDocuments returns the semantic differences between two
+// documents of the same type, such as a running and a persistent
+// Domain. Repeated elements like devices are matched by a natural
+// key (disk target, interface MAC address, controller type and
+// index, device alias) rather than by position
+func DiffDocuments(from, to Document) ([]DocumentChange, error) {
+	fromxml, err := from.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	toxml, err := to.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	fromroot, err := LoadXML(fromxml)
+	if err != nil {
+		return nil, err
+	}
+	toroot, err := LoadXML(toxml)
+	if err != nil {
+		return nil, err
+	}
+
+	if fromroot.Name != toroot.Name {
+		return nil, fmt.Errorf("Cannot compare '%s' document with '%s' document",
+			fromroot.Name, toroot.Name)
+	}
+
+	return documentDiffElement("", fromroot, toroot, nil), nil
+}
@@ -0,0 +1,325 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiffDocumentsDomain(t *testing.T) {
+	from := &Domain{
+		Type: "kvm",
+		Name: "demo",
+		Memory: &DomainMemory{
+			Value: 1048576,
+			Unit:  "KiB",
+		},
+		Devices: &DomainDeviceList{
+			Disks: []DomainDisk{
+				DomainDisk{
+					Device: "disk",
+					Driver: &DomainDiskDriver{
+						Name:  "qemu",
+						Cache: "none",
+					},
+					Source: &DomainDiskSource{
+						File: &DomainDiskSourceFile{
+							File: "/var/lib/libvirt/images/a.qcow2",
+						},
+					},
+					Target: &DomainDiskTarget{
+						Dev: "vda",
+						Bus: "virtio",
+					},
+				},
+				DomainDisk{
+					Device: "disk",
+					Source: &DomainDiskSource{
+						File: &DomainDiskSourceFile{
+							File: "/var/lib/libvirt/images/b.qcow2",
+						},
+					},
+					Target: &DomainDiskTarget{
+						Dev: "vdb",
+						Bus: "virtio",
+					},
+				},
+			},
+			Controllers: []DomainController{
+				DomainController{
+					Type:  "usb",
+					Index: new(uint),
+				},
+			},
+			Interfaces: []DomainInterface{
+				DomainInterface{
+					MAC: &DomainInterfaceMAC{
+						Address: "52:54:00:00:00:01",
+					},
+				},
+			},
+		},
+	}
+
+	to := &Domain{
+		Type: "kvm",
+		Name: "demo",
+		Memory: &DomainMemory{
+			Value: 2097152,
+			Unit:  "KiB",
+		},
+		Devices: &DomainDeviceList{
+			Disks: []DomainDisk{
+				DomainDisk{
+					Device: "disk",
+					Source: &DomainDiskSource{
+						File: &DomainDiskSourceFile{
+							File: "/var/lib/libvirt/images/b.qcow2",
+						},
+					},
+					Target: &DomainDiskTarget{
+						Dev: "vdb",
+						Bus: "virtio",
+					},
+				},
+				DomainDisk{
+					Device: "disk",
+					Driver: &DomainDiskDriver{
+						Name:  "qemu",
+						Cache: "writeback",
+					},
+					Source: &DomainDiskSource{
+						File: &DomainDiskSourceFile{
+							File: "/var/lib/libvirt/images/a.qcow2",
+						},
+					},
+					Target: &DomainDiskTarget{
+						Dev: "vda",
+						Bus: "virtio",
+					},
+				},
+			},
+			Controllers: []DomainController{
+				DomainController{
+					Type:  "usb",
+					Index: new(uint),
+				},
+			},
+			Interfaces: []DomainInterface{
+				DomainInterface{
+					MAC: &DomainInterfaceMAC{
+						Address: "52:54:00:00:00:02",
+					},
+				},
+			},
+		},
+	}
+
+	changes, err := DiffDocuments(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []string{
+		"~ memory: '1048576' -> '2097152'",
+		"~ devices/disk[target=vda]/driver/@cache: 'none' -> 'writeback'",
+		"- devices/interface[mac=52:54:00:00:00:01]",
+		"+ devices/interface[mac=52:54:00:00:00:02]",
+	}
+	if len(changes) != len(expect) {
+		t.Fatalf("Expected %d changes, got %v", len(expect), changes)
+	}
+	for i, change := range changes {
+		if change.String() != expect[i] {
+			t.Fatalf("Expected change '%s', got '%s'", expect[i], change)
+		}
+	}
+
+	changes, err = DiffDocuments(from, from)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Fatalf("Expected no changes, got %v", changes)
+	}
+}
+
+func TestDiffDocumentsAlias(t *testing.T) {
+	from := &Domain{
+		Type: "kvm",
+		Name: "demo",
+		Devices: &DomainDeviceList{
+			Videos: []DomainVideo{
+				DomainVideo{
+					Model: DomainVideoModel{
+						Type: "qxl",
+					},
+				},
+			},
+			Controllers: []DomainController{
+				DomainController{
+					Type:  "usb",
+					Index: new(uint),
+				},
+			},
+		},
+	}
+	to := &Domain{
+		Type: "kvm",
+		Name: "demo",
+		Devices: &DomainDeviceList{
+			Videos: []DomainVideo{
+				DomainVideo{
+					Model: DomainVideoModel{
+						Type: "virtio",
+					},
+					Alias: &DomainAlias{
+						Name: "video0",
+					},
+				},
+			},
+			Controllers: []DomainController{
+				DomainController{
+					Type:  "usb",
+					Index: new(uint),
+					Alias: &DomainAlias{
+						Name: "usb",
+					},
+				},
+			},
+		},
+	}
+
+	changes, err := DiffDocuments(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []string{
+		"+ devices/controller[type=usb,index=0]/alias",
+		"~ devices/video/model/@type: 'qxl' -> 'virtio'",
+		"+ devices/video/alias",
+	}
+	var got []string
+	for _, change := range changes {
+		got = append(got, change.String())
+	}
+	if strings.Join(got, "\n") != strings.Join(expect, "\n") {
+		t.Fatalf("Bad changes:\n%s\n does not match\n%s",
+			strings.Join(got, "\n"), strings.Join(expect, "\n"))
+	}
+}
+
+func TestDiffDocumentsNetwork(t *testing.T) {
+	from := &Network{
+		Name: "default",
+		IPs: []NetworkIP{
+			NetworkIP{
+				Address: "192.168.122.1",
+				Netmask: "255.255.255.0",
+				DHCP: &NetworkDHCP{
+					Hosts: []NetworkDHCPHost{
+						NetworkDHCPHost{
+							MAC: "00:16:3e:77:e2:ed",
+							IP:  "192.168.122.10",
+						},
+					},
+				},
+			},
+		},
+	}
+	to := &Network{
+		Name: "default",
+		IPs: []NetworkIP{
+			NetworkIP{
+				Address: "192.168.122.1",
+				Netmask: "255.255.255.0",
+				DHCP: &NetworkDHCP{
+					Hosts: []NetworkDHCPHost{
+						NetworkDHCPHost{
+							MAC: "00:16:3e:77:e2:ed",
+							IP:  "192.168.122.11",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	changes, err := DiffDocuments(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "~ ip[address=192.168.122.1]/dhcp/host[mac=00:16:3e:77:e2:ed]/@ip: '192.168.122.10' -> '192.168.122.11'"
+	if len(changes) != 1 || changes[0].String() != expect {
+		t.Fatalf("Expected change '%s', got %v", expect, changes)
+	}
+
+	_, err = DiffDocuments(from, &Domain{})
+	if err == nil {
+		t.Fatal("Expected error comparing different document types")
+	}
+}
+
+func TestDiffDocumentsHostdev(t *testing.T) {
+	from := `<domain><devices>` +
+		`<hostdev mode="subsystem" type="usb">` +
+		`<source><vendor id="0x1234"/><product id="0x5678"/></source>` +
+		`</hostdev>` +
+		`<hostdev mode="subsystem" type="pci">` +
+		`<source><address domain="0x0000" bus="0x01" slot="0x00" function="0x0"/></source>` +
+		`</hostdev>` +
+		`</devices></domain>`
+	to := `<domain><devices>` +
+		`<hostdev mode="subsystem" type="pci" managed="yes">` +
+		`<source><address domain="0x0000" bus="0x01" slot="0x00" function="0x0"/></source>` +
+		`</hostdev>` +
+		`</devices></domain>`
+
+	fromroot, err := LoadXML(from)
+	if err != nil {
+		t.Fatal(err)
+	}
+	toroot, err := LoadXML(to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes := documentDiffElement("", fromroot, toroot, nil)
+
+	expect := []string{
+		"- devices/hostdev[vendor=0x1234,product=0x5678]",
+		"+ devices/hostdev[domain=0x0000,bus=0x01,slot=0x00,function=0x0]/@managed: 'yes'",
+	}
+	var got []string
+	for _, change := range changes {
+		got = append(got, change.String())
+	}
+	if strings.Join(got, "\n") != strings.Join(expect, "\n") {
+		t.Fatalf("Bad changes:\n%s\n does not match\n%s",
+			strings.Join(got, "\n"), strings.Join(expect, "\n"))
+	}
+}
@@ -0,0 +1,766 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// The JSON representation of a document mirrors its XML. Object
+// keys are the XML attribute and element names, with the path
+// separator in nested element names written as '.', and character
+// data stored under "value". Fields which are only populated by
+// custom XML marshalling, such as the alternatives of a union like
+// DomainDiskSource, use the lower case Go field name as their key.
+// Where a struct holding such alternatives has no "type" attribute
+// of its own, a "type" key is added naming the alternative in use,
+// so that a disk source is written as
+//
+//   {"type": "file", "file": {"file": "/demo.img"}}
+//
+// Zero valued scalars and empty lists are omitted, while pointers
+// are always written if set, so that converting a document to JSON
+// and back preserves everything that would appear in its XML.
+
+const jsonUnionKey = "type"
+
+type jsonField struct {
+	index   []int
+	key     string
+	attr    bool
+	variant bool
+}
+
+type jsonStruct struct {
+	fields []jsonField
+	byKey  map[string]*jsonField
+	// union is set if the variant alternatives need a
+	// discriminator key adding
+	union bool
+}
+
+var jsonStructCache sync.Map
+
+func jsonFieldInfo(f reflect.StructField, index []int) jsonField {
+	field := jsonField{index: index}
+	tag := f.Tag.Get("xml")
+	if tag == "-" || tag == "" {
+		field.key = strings.ToLower(f.Name)
+		field.variant = f.Type.Kind() == reflect.Ptr &&
+			f.Type.Elem().Kind() == reflect.Struct
+		return field
+	}
+	opts := strings.Split(tag, ",")
+	name := opts[0]
+	for _, opt := range opts[1:] {
+		if opt == "chardata" {
+			field.key = "value"
+			return field
+		} else if opt == "attr" {
+			field.attr = true
+		}
+	}
+	if name == "" {
+		field.key = strings.ToLower(f.Name)
+	} else {
+		field.key = strings.Replace(name, ">", ".", -1)
+	}
+	return field
+}
+
+func jsonStructFields(info *jsonStruct, t reflect.Type, index []int) error {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		fieldIndex := append(append([]int{}, index...), i)
+		if f.PkgPath != "" {
+			continue
+		}
+		if f.Anonymous && f.Tag.Get("xml") == "" && f.Type.Kind() == reflect.Struct {
+			if err := jsonStructFields(info, f.Type, fieldIndex); err != nil {
+				return err
+			}
+			continue
+		}
+		// An element name fixed by the struct tag carries no
+		// information
+		if f.Name == "XMLName" && f.Tag.Get("xml") != "" {
+			continue
+		}
+		field := jsonFieldInfo(f, fieldIndex)
+		if f.Name == "XMLName" {
+			field.key = "element"
+		}
+		info.fields = append(info.fields, field)
+	}
+	return nil
+}
+
+func jsonStructInfo(t reflect.Type) (*jsonStruct, error) {
+	if info, ok := jsonStructCache.Load(t); ok {
+		return info.(*jsonStruct), nil
+	}
+	info := &jsonStruct{
+		byKey: make(map[string]*jsonField),
+	}
+	if err := jsonStructFields(info, t, nil); err != nil {
+		return nil, err
+	}
+	// An attribute sharing its name with a child element, like
+	// the listen attribute of VNC graphics, gets an '@' prefix
+	elements := make(map[string]bool)
+	for _, field := range info.fields {
+		if !field.attr {
+			elements[field.key] = true
+		}
+	}
+	for i := range info.fields {
+		field := &info.fields[i]
+		if field.attr && elements[field.key] {
+			field.key = "@" + field.key
+		}
+	}
+
+	variants := 0
+	for i := range info.fields {
+		field := &info.fields[i]
+		if _, ok := info.byKey[field.key]; ok {
+			return nil, fmt.Errorf("Duplicate JSON key '%s' in %s", field.key, t)
+		}
+		info.byKey[field.key] = field
+		if field.variant {
+			variants++
+		}
+	}
+	_, hasType := info.byKey[jsonUnionKey]
+	info.union = variants > 1 && !hasType
+	jsonStructCache.Store(t, info)
+	return info, nil
+}
+
+// jsonIsEmpty reports whether a field value is omitted
+func jsonIsEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	case reflect.Slice, reflect.Map, reflect.String:
+		return v.Len() == 0
+	case reflect.Struct:
+		return reflect.DeepEqual(v.Interface(), reflect.Zero(v.Type()).Interface())
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	}
+	return false
+}
+
+func jsonEncodeValue(buf *bytes.Buffer, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+		return jsonEncodeValue(buf, v.Elem())
+	case reflect.Struct:
+		return jsonEncodeStruct(buf, v)
+	case reflect.Slice:
+		buf.WriteByte('[')
+		for i := 0; i < v.Len(); i++ {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			if err := jsonEncodeValue(buf, v.Index(i)); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		data, err := json.Marshal(v.Interface())
+		if err != nil {
+			return err
+		}
+		buf.Write(data)
+		return nil
+	}
+	return fmt.Errorf("Cannot encode %s as JSON", v.Type())
+}
+
+func jsonEncodeStruct(buf *bytes.Buffer, v reflect.Value) error {
+	info, err := jsonStructInfo(v.Type())
+	if err != nil {
+		return err
+	}
+
+	buf.WriteByte('{')
+	first := true
+	writeKey := func(key string) {
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		data, _ := json.Marshal(key)
+		buf.Write(data)
+		buf.WriteByte(':')
+	}
+
+	if info.union {
+		var variant string
+		for _, field := range info.fields {
+			if field.variant && !v.FieldByIndex(field.index).IsNil() {
+				if variant != "" {
+					return fmt.Errorf("Both '%s' and '%s' are set in %s",
+						variant, field.key, v.Type())
+				}
+				variant = field.key
+			}
+		}
+		if variant != "" {
+			writeKey(jsonUnionKey)
+			data, _ := json.Marshal(variant)
+			buf.Write(data)
+		}
+	}
+
+	for _, field := range info.fields {
+		fv := v.FieldByIndex(field.index)
+		if jsonIsEmpty(fv) {
+			continue
+		}
+		writeKey(field.key)
+		if err := jsonEncodeValue(buf, fv); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+func jsonDecodeValue(data []byte, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		elem := reflect.New(v.Type().Elem())
+		if err := jsonDecodeValue(data, elem.Elem()); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	case reflect.Struct:
+		return jsonDecodeStruct(data, v)
+	case reflect.Slice:
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return err
+		}
+		if items == nil {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		slice := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := jsonDecodeValue(item, slice.Index(i)); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+		return nil
+	}
+	return json.Unmarshal(data, v.Addr().Interface())
+}
+
+func jsonDecodeStruct(data []byte, v reflect.Value) error {
+	info, err := jsonStructInfo(v.Type())
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+
+	var variant string
+	for key, value := range obj {
+		if info.union && key == jsonUnionKey {
+			if err := json.Unmarshal(value, &variant); err != nil {
+				return err
+			}
+			continue
+		}
+		field, ok := info.byKey[key]
+		if !ok {
+			return fmt.Errorf("Unknown JSON key '%s' for %s", key, v.Type())
+		}
+		if err := jsonDecodeValue(value, v.FieldByIndex(field.index)); err != nil {
+			return fmt.Errorf("%s: %s", key, err)
+		}
+	}
+
+	if variant != "" {
+		field, ok := info.byKey[variant]
+		if !ok || !field.variant {
+			return fmt.Errorf("Unknown %s '%s' for %s", jsonUnionKey, variant, v.Type())
+		}
+		for _, other := range info.fields {
+			fv := v.FieldByIndex(other.index)
+			if other.variant && other.key != variant && !fv.IsNil() {
+				return fmt.Errorf("Key '%s' does not match %s '%s' for %s",
+					other.key, jsonUnionKey, variant, v.Type())
+			}
+		}
+		// Allow {"type": "file"} as shorthand for an empty alternative
+		fv := v.FieldByIndex(field.index)
+		if fv.IsNil() {
+			fv.Set(reflect.New(fv.Type().Elem()))
+		}
+	}
+	return nil
+}
+
+// MarshalDocumentJSON returns the JSON representation of any of
+// the document types
+func MarshalDocumentJSON(doc Document) ([]byte, error) {
+	v := reflect.ValueOf(doc)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("Cannot encode %s as JSON", v.Type())
+	}
+	if v.IsNil() {
+		return []byte("null"), nil
+	}
+	var buf bytes.Buffer
+	if err := jsonEncodeStruct(&buf, v.Elem()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalDocumentJSON populates a document from the JSON
+// representation returned by MarshalDocumentJSON
+func UnmarshalDocumentJSON(data []byte, doc Document) error {
+	v := reflect.ValueOf(doc)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("Cannot decode JSON into %T", doc)
+	}
+	elem := reflect.New(v.Elem().Type())
+	if err := jsonDecodeStruct(data, elem.Elem()); err != nil {
+		return err
+	}
+	v.Elem().Set(elem.Elem())
+	return nil
+}
+
+func (c *Caps) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(c)
+}
+
+func (c *Caps) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, c)
+}
+
+func (c *CapsHostCPU) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(c)
+}
+
+func (c *CapsHostCPU) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, c)
+}
+
+func (c *DomainCaps) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(c)
+}
+
+func (c *DomainCaps) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, c)
+}
+
+func (c *NodeDevice) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(c)
+}
+
+func (c *NodeDevice) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, c)
+}
+
+func (c *StoragePoolCapabilities) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(c)
+}
+
+func (c *StoragePoolCapabilities) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, c)
+}
+
+func (d *Domain) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *Domain) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainCPU) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainCPU) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainChannel) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainChannel) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainConsole) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainConsole) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainController) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainController) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainDisk) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainDisk) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainFilesystem) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainFilesystem) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainGraphic) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainGraphic) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainHostdev) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainHostdev) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainInput) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainInput) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainInterface) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainInterface) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainMemBalloon) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainMemBalloon) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainMemorydev) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainMemorydev) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainParallel) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainParallel) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainRNG) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainRNG) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainRedirDev) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainRedirDev) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainSerial) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainSerial) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainShmem) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainShmem) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainSmartcard) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainSmartcard) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainSound) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainSound) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainTPM) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainTPM) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainVSock) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainVSock) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainVideo) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainVideo) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (d *DomainWatchdog) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(d)
+}
+
+func (d *DomainWatchdog) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, d)
+}
+
+func (s *DomainBackup) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(s)
+}
+
+func (s *DomainBackup) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, s)
+}
+
+func (s *DomainCheckpoint) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(s)
+}
+
+func (s *DomainCheckpoint) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, s)
+}
+
+func (s *DomainSnapshot) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(s)
+}
+
+func (s *DomainSnapshot) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, s)
+}
+
+func (s *Interface) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(s)
+}
+
+func (s *Interface) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, s)
+}
+
+func (s *NWFilter) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(s)
+}
+
+func (s *NWFilter) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, s)
+}
+
+func (s *NWFilterBinding) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(s)
+}
+
+func (s *NWFilterBinding) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, s)
+}
+
+func (s *Network) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(s)
+}
+
+func (s *Network) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, s)
+}
+
+func (s *NetworkDHCPHost) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(s)
+}
+
+func (s *NetworkDHCPHost) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, s)
+}
+
+func (s *NetworkDHCPRange) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(s)
+}
+
+func (s *NetworkDHCPRange) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, s)
+}
+
+func (s *NetworkDNSHost) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(s)
+}
+
+func (s *NetworkDNSHost) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, s)
+}
+
+func (s *NetworkDNSSRV) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(s)
+}
+
+func (s *NetworkDNSSRV) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, s)
+}
+
+func (s *NetworkDNSTXT) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(s)
+}
+
+func (s *NetworkDNSTXT) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, s)
+}
+
+func (s *NetworkForwardInterface) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(s)
+}
+
+func (s *NetworkForwardInterface) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, s)
+}
+
+func (s *NetworkPort) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(s)
+}
+
+func (s *NetworkPort) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, s)
+}
+
+func (s *NetworkPortGroup) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(s)
+}
+
+func (s *NetworkPortGroup) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, s)
+}
+
+func (s *Secret) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(s)
+}
+
+func (s *Secret) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, s)
+}
+
+func (s *StoragePool) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(s)
+}
+
+func (s *StoragePool) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, s)
+}
+
+func (s *StorageVolume) MarshalJSON() ([]byte, error) {
+	return MarshalDocumentJSON(s)
+}
+
+func (s *StorageVolume) UnmarshalJSON(data []byte) error {
+	return UnmarshalDocumentJSON(data, s)
+}
@@ -0,0 +1,192 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestDocumentJSONRoundTrip(t *testing.T) {
+	var docs []Document
+	for _, test := range domainTestData {
+		docs = append(docs, test.Object)
+	}
+	for _, test := range domainSnapshotTestData {
+		docs = append(docs, test.Object)
+	}
+	for _, test := range domainCheckpointTestData {
+		docs = append(docs, test.Object)
+	}
+	for _, test := range domainBackupTestData {
+		docs = append(docs, test.Object)
+	}
+	for _, test := range networkTestData {
+		docs = append(docs, test.Object)
+	}
+	for _, test := range storagePoolTestData {
+		docs = append(docs, test.Object)
+	}
+	for _, test := range storageVolumeTestData {
+		docs = append(docs, test.Object)
+	}
+	for _, test := range secretTestData {
+		docs = append(docs, test.Object)
+	}
+	for _, test := range NodeDeviceTestData {
+		docs = append(docs, test.Object)
+	}
+
+	for _, doc := range docs {
+		expect, err := doc.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data, err := json.Marshal(doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		newdoc := reflect.New(reflect.ValueOf(doc).Elem().Type()).Interface().(Document)
+		err = json.Unmarshal(data, newdoc)
+		if err != nil {
+			t.Fatalf("%s\n%s", err, string(data))
+		}
+
+		xmldoc, err := newdoc.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if xmldoc != expect {
+			t.Fatal("Bad JSON roundtrip xml:\n", xmldoc, "\n does not match\n", expect, "\n")
+		}
+	}
+}
+
+func TestDomainDiskJSON(t *testing.T) {
+	disk := &DomainDisk{
+		Device: "disk",
+		Driver: &DomainDiskDriver{
+			Name: "qemu",
+			Type: "qcow2",
+		},
+		Source: &DomainDiskSource{
+			File: &DomainDiskSourceFile{
+				File: "/var/lib/libvirt/images/demo.qcow2",
+			},
+		},
+		Target: &DomainDiskTarget{
+			Dev: "vda",
+			Bus: "virtio",
+		},
+		Address: &DomainAddress{
+			PCI: &DomainAddressPCI{
+				Domain: &pciDomain,
+				Bus:    &pciBus,
+				Slot:   &pciSlot,
+			},
+		},
+	}
+
+	expect := `{"device":"disk",` +
+		`"driver":{"name":"qemu","type":"qcow2"},` +
+		`"source":{"type":"file","file":{"file":"/var/lib/libvirt/images/demo.qcow2"}},` +
+		`"target":{"dev":"vda","bus":"virtio"},` +
+		`"address":{"type":"pci","pci":{"domain":1,"bus":21,"slot":10}}}`
+
+	data, err := json.Marshal(disk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != expect {
+		t.Fatalf("Bad JSON:\n%s\ndoes not match\n%s", string(data), expect)
+	}
+
+	newdisk := &DomainDisk{}
+	if err := json.Unmarshal(data, newdisk); err != nil {
+		t.Fatal(err)
+	}
+	if !disk.Equal(newdisk) {
+		t.Fatal("Expected JSON roundtrip to preserve disk")
+	}
+}
+
+func TestDocumentJSONErrors(t *testing.T) {
+	var tests = []string{
+		`{"device":"disk","size":1}`,
+		`{"source":{"type":"nbd"}}`,
+		`{"source":{"type":"file","block":{"dev":"/dev/sda"}}}`,
+		`{"target":{"dev":7}}`,
+	}
+
+	for _, test := range tests {
+		disk := &DomainDisk{}
+		if err := json.Unmarshal([]byte(test), disk); err == nil {
+			t.Errorf("Expected error decoding %s", test)
+		}
+	}
+
+	disk := &DomainDisk{}
+	if err := json.Unmarshal([]byte(`{"source":{"type":"file"}}`), disk); err != nil {
+		t.Fatal(err)
+	}
+	if disk.Source == nil || disk.Source.File == nil {
+		t.Fatal("Expected empty file source")
+	}
+}
+
+func testJSONStructKeys(t *testing.T, typ reflect.Type, seen map[reflect.Type]bool) {
+	for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct || seen[typ] {
+		return
+	}
+	seen[typ] = true
+	info, err := jsonStructInfo(typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, field := range info.fields {
+		testJSONStructKeys(t, typ.FieldByIndex(field.index).Type, seen)
+	}
+}
+
+func TestDocumentJSONKeys(t *testing.T) {
+	docs := []Document{
+		&Caps{}, &DomainCaps{}, &Domain{}, &DomainBackup{},
+		&DomainCheckpoint{}, &DomainSnapshot{}, &Interface{},
+		&Network{}, &NetworkPort{}, &NodeDevice{}, &NWFilter{},
+		&NWFilterBinding{}, &Secret{}, &StoragePool{},
+		&StoragePoolCapabilities{}, &StorageVolume{},
+	}
+	seen := make(map[reflect.Type]bool)
+	for _, doc := range docs {
+		testJSONStructKeys(t, reflect.TypeOf(doc), seen)
+	}
+}
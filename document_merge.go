@@ -0,0 +1,479 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// MergeNamespace is the XML namespace of the attribute which
+// controls how an element in an overlay is merged. For example
+//
+//	<disk xmlns:merge="http://libvirt.org/schemas/go-xml/merge/1.0"
+//	      merge:action="remove">
+//	  <target dev="vdb"/>
+//	</disk>
+//
+// removes the disk with target vdb from the base document
+const MergeNamespace = "http://libvirt.org/schemas/go-xml/merge/1.0"
+
+// MergeAction is the value of the action attribute which controls
+// how an element in an overlay is merged into the base document
+type MergeAction string
+
+const (
+	// Merge the attributes and children of the element into
+	// the matching element in the base document. This is the
+	// default for elements without an action attribute
+	MergeActionMerge MergeAction = "merge"
+	// Replace the matching element in the base document
+	MergeActionReplace MergeAction = "replace"
+	// Remove the matching element from the base document
+	MergeActionRemove MergeAction = "remove"
+)
+
+// MergeOptions controls additional changes made when merging
+type MergeOptions struct {
+	// Remove lists elements to remove from the base document
+	// before the overlay is applied, as paths in the format used
+	// by DocumentChange, for example
+	// "devices/controller[type=usb,index=0]" or
+	// "ip[address=192.168.122.1]/dhcp/host[mac=52:54:00:00:00:02]".
+	// The key may be omitted from the last element of the path if
+	// there is only one element with that name
+	Remove []string
+}
+
+var mergeActionName = xmlName(MergeNamespace, xml.Name{Local: "action"})
+
+func mergeElementAction(el *XMLElement) (MergeAction, error) {
+	action, ok := el.Attrs[mergeActionName]
+	if !ok {
+		return MergeActionMerge, nil
+	}
+	switch MergeAction(action) {
+	case MergeActionMerge, MergeActionReplace, MergeActionRemove:
+		return MergeAction(action), nil
+	}
+	return "", fmt.Errorf("Unknown merge action '%s' on element '%s'", action, el.Name)
+}
+
+// mergeStripActions removes the merge attributes from an element
+// being copied from the overlay into the result
+func mergeStripActions(el *XMLElement) {
+	delete(el.Attrs, mergeActionName)
+	for _, child := range el.Children {
+		mergeStripActions(child)
+	}
+}
+
+// mergeElement applies the overlay element onto the base element.
+// Non-empty attributes and content in the overlay replace those in
+// the base.
+// Child elements with a natural key, such as devices, are matched
+// up by key, while other children are matched by name. Where
+// either side has several unkeyed children with the same name,
+// those in the overlay replace all of those in the base
+func mergeElement(path string, base, overlay *XMLElement) error {
+	for key, val := range overlay.Attrs {
+		// Structs marshal some unset fields as empty attributes
+		if key == mergeActionName || val == "" {
+			continue
+		}
+		base.Attrs[key] = val
+	}
+	if strings.TrimSpace(overlay.Content) != "" {
+		base.Content = overlay.Content
+	}
+
+	counts := make(map[string]int)
+	for _, child := range base.Children {
+		if documentDiffKey(child) == "" {
+			counts[child.Name]++
+		}
+	}
+	overlayCounts := make(map[string]int)
+	for _, child := range overlay.Children {
+		if documentDiffKey(child) == "" {
+			overlayCounts[child.Name]++
+		}
+	}
+
+	replaced := make(map[string]bool)
+	for _, child := range overlay.Children {
+		action, err := mergeElementAction(child)
+		if err != nil {
+			return err
+		}
+		key := documentDiffKey(child)
+		name := child.Name
+		if key != "" {
+			name += "[" + key + "]"
+		}
+		childPath := documentDiffJoin(path, name)
+
+		if key == "" && (counts[child.Name] > 1 || overlayCounts[child.Name] > 1 ||
+			action == MergeActionRemove) {
+			if !replaced[child.Name] {
+				base.Children = mergeRemoveChildren(base.Children, child.Name)
+				replaced[child.Name] = true
+			}
+			if action != MergeActionRemove {
+				mergeStripActions(child)
+				base.Children = mergeInsertChild(base.Children, child)
+			}
+			continue
+		}
+
+		idx := -1
+		matches := 0
+		for i, basechild := range base.Children {
+			if basechild.Name != child.Name {
+				continue
+			}
+			matches++
+			if idx == -1 && documentDiffKey(basechild) == key {
+				idx = i
+			}
+		}
+		// A singleton device in a live domain may have an alias
+		// which a hand written overlay lacks
+		if idx == -1 && key == "" && matches == 1 {
+			for i, basechild := range base.Children {
+				if basechild.Name == child.Name {
+					idx = i
+				}
+			}
+		}
+
+		switch {
+		case action == MergeActionRemove:
+			if idx == -1 {
+				return fmt.Errorf("Cannot remove '%s', no such element", childPath)
+			}
+			base.Children = append(base.Children[:idx], base.Children[idx+1:]...)
+		case idx == -1:
+			mergeStripActions(child)
+			base.Children = mergeInsertChild(base.Children, child)
+		case action == MergeActionReplace:
+			mergeStripActions(child)
+			base.Children[idx] = child
+		default:
+			if err := mergeElement(childPath, base.Children[idx], child); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// mergeRemovePaths removes the descendants of el which are listed
+// in remove, recording the paths which matched in removed
+func mergeRemovePaths(path string, el *XMLElement, remove, removed map[string]bool) {
+	var children []*XMLElement
+	for _, child := range el.Children {
+		name := child.Name
+		if key := documentDiffKey(child); key != "" {
+			name += "[" + key + "]"
+		}
+		childPath := documentDiffJoin(path, name)
+		plainPath := documentDiffJoin(path, child.Name)
+
+		if remove[childPath] {
+			removed[childPath] = true
+			continue
+		}
+		if remove[plainPath] &&
+			(plainPath == childPath || documentDiffChildCount(el, child.Name) == 1) {
+			removed[plainPath] = true
+			continue
+		}
+		mergeRemovePaths(childPath, child, remove, removed)
+		children = append(children, child)
+	}
+	el.Children = children
+}
+
+func mergeRemoveChildren(children []*XMLElement, name string) []*XMLElement {
+	var ret []*XMLElement
+	for _, child := range children {
+		if child.Name != name {
+			ret = append(ret, child)
+		}
+	}
+	return ret
+}
+
+// mergeInsertChild adds a child after any existing children with
+// the same name, keeping elements such as disks grouped together
+func mergeInsertChild(children []*XMLElement, child *XMLElement) []*XMLElement {
+	idx := len(children)
+	for i, other := range children {
+		if other.Name == child.Name {
+			idx = i + 1
+		}
+	}
+	children = append(children, nil)
+	copy(children[idx+1:], children[idx:])
+	children[idx] = child
+	return children
+}
+
+type xmlWriter struct {
+	buf      bytes.Buffer
+	prefixes map[string]string
+	// As for xml.Encoder.Indent, left empty for compact output
+	prefix string
+	indent string
+	depth  int
+}
+
+func (w *xmlWriter) newline() {
+	if w.prefix == "" && w.indent == "" {
+		return
+	}
+	w.buf.WriteString("\n" + w.prefix + strings.Repeat(w.indent, w.depth))
+}
+
+func (w *xmlWriter) qualify(name string, scope map[string]bool, decls *[]string) string {
+	ns, local := splitXMLName(name)
+	if ns == "" {
+		return local
+	}
+	prefix, ok := w.prefixes[ns]
+	if !ok {
+		prefix = fmt.Sprintf("ns%d", len(w.prefixes))
+		w.prefixes[ns] = prefix
+	}
+	if !scope[ns] {
+		scope[ns] = true
+		*decls = append(*decls, ns)
+	}
+	return prefix + ":" + local
+}
+
+func (w *xmlWriter) writeElement(el *XMLElement, parentScope map[string]bool) {
+	scope := make(map[string]bool)
+	for ns := range parentScope {
+		scope[ns] = true
+	}
+	var decls []string
+	name := w.qualify(el.Name, scope, &decls)
+
+	var keys []string
+	for key := range el.Attrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var attrs []string
+	for _, key := range keys {
+		var val bytes.Buffer
+		xml.EscapeText(&val, []byte(el.Attrs[key]))
+		attrs = append(attrs, fmt.Sprintf(" %s=\"%s\"",
+			w.qualify(key, scope, &decls), val.String()))
+	}
+
+	w.buf.WriteString("<" + name)
+	for _, ns := range decls {
+		var val bytes.Buffer
+		xml.EscapeText(&val, []byte(ns))
+		fmt.Fprintf(&w.buf, " xmlns:%s=\"%s\"", w.prefixes[ns], val.String())
+	}
+	for _, attr := range attrs {
+		w.buf.WriteString(attr)
+	}
+	w.buf.WriteString(">")
+	if len(el.Children) == 0 {
+		xml.EscapeText(&w.buf, []byte(el.Content))
+	}
+	w.depth++
+	for _, child := range el.Children {
+		w.newline()
+		w.writeElement(child, scope)
+	}
+	w.depth--
+	if len(el.Children) != 0 {
+		w.newline()
+	}
+	w.buf.WriteString("</" + name + ">")
+}
+
+// String formats the element tree as an XML document. Namespaces
+// are given generated prefixes, declared where first used
+func (e *XMLElement) String() string {
+	w := &xmlWriter{
+		prefixes: make(map[string]string),
+	}
+	w.writeElement(e, nil)
+	return w.buf.String()
+}
+
+func mergeDocumentXML(base Document, overlayroot *XMLElement, opts *MergeOptions) error {
+	basexml, err := base.Marshal()
+	if err != nil {
+		return err
+	}
+	baseroot, err := LoadXML(basexml)
+	if err != nil {
+		return err
+	}
+	if baseroot.Name != overlayroot.Name {
+		return fmt.Errorf("Cannot merge '%s' document into '%s' document",
+			overlayroot.Name, baseroot.Name)
+	}
+
+	if opts != nil && len(opts.Remove) != 0 {
+		remove := make(map[string]bool)
+		for _, path := range opts.Remove {
+			remove[path] = true
+		}
+		removed := make(map[string]bool)
+		mergeRemovePaths("", baseroot, remove, removed)
+		for _, path := range opts.Remove {
+			if !removed[path] {
+				return fmt.Errorf("Cannot remove '%s', no such element", path)
+			}
+		}
+	}
+
+	if err := mergeElement("", baseroot, overlayroot); err != nil {
+		return err
+	}
+
+	// Unmarshal appends to lists, so the document must be
+	// emptied first
+	val := reflect.ValueOf(base).Elem()
+	result := reflect.New(val.Type())
+	if err := result.Interface().(Document).Unmarshal(baseroot.String()); err != nil {
+		return err
+	}
+	val.Set(result.Elem())
+	return nil
+}
+
+// MergeDocumentXML applies a partial document in XML format onto
+// a base document of the same type. Attributes and text content
+// in the overlay replace those in the base, while child elements
+// are merged recursively. Repeated elements with a natural key,
+// like disks by target device, interfaces by MAC address and
+// controllers by type and index, are merged with the element
+// having the same key, or added if there is none. An element can
+// be removed or replaced, rather than merged, by giving it an
+// action attribute in the MergeNamespace, or removed by listing it
+// in opts, which may be nil
+func MergeDocumentXML(base Document, overlay string, opts *MergeOptions) error {
+	overlayroot, err := LoadXML(overlay)
+	if err != nil {
+		return err
+	}
+	return mergeDocumentXML(base, overlayroot, opts)
+}
+
+// pruneUnset removes the attributes, text and elements which
+// marshalling a struct produces for fields left unset, such as the
+// "0" for an unset number. They are found as the ones which can be
+// removed without changing the decoded value
+func (p *xmlProbe) pruneUnset(el *XMLElement) {
+	var keys []string
+	for key := range el.Attrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		val := el.Attrs[key]
+		delete(el.Attrs, key)
+		if p.changed() {
+			el.Attrs[key] = val
+		}
+	}
+	if content := el.Content; content != "" {
+		el.Content = ""
+		if p.changed() {
+			el.Content = content
+		}
+	}
+
+	children := el.Children
+	for idx := 0; idx < len(el.Children); idx++ {
+		child := el.Children[idx]
+		el.Children = append(append([]*XMLElement{}, children[:idx]...), children[idx+1:]...)
+		if !p.changed() {
+			children = el.Children
+			idx--
+			continue
+		}
+		el.Children = children
+		p.pruneUnset(child)
+	}
+}
+
+// MergeDocuments applies a partial document onto a base document
+// of the same type, as described for MergeDocumentXML. Only the
+// fields set in the overlay change the base document, and elements
+// can only be removed by listing them in opts
+func MergeDocuments(base, overlay Document, opts *MergeOptions) error {
+	overlayxml, err := overlay.Marshal()
+	if err != nil {
+		return err
+	}
+	overlayroot, err := LoadXML(overlayxml)
+	if err != nil {
+		return err
+	}
+
+	p := &xmlProbe{
+		typ:  reflect.TypeOf(overlay).Elem(),
+		root: overlayroot,
+	}
+	if p.base, err = p.decode(); err != nil {
+		return err
+	}
+	p.pruneUnset(overlayroot)
+	return mergeDocumentXML(base, overlayroot, opts)
+}
+
+// Merge applies a partial domain onto this domain, as described
+// for MergeDocumentXML
+func (d *Domain) Merge(overlay *Domain, opts *MergeOptions) error {
+	return MergeDocuments(d, overlay, opts)
+}
+
+// Merge applies a partial network onto this network, as described
+// for MergeDocumentXML
+func (s *Network) Merge(overlay *Network, opts *MergeOptions) error {
+	return MergeDocuments(s, overlay, opts)
+}
+
+// Merge applies a partial storage pool onto this storage pool, as
+// described for MergeDocumentXML
+func (s *StoragePool) Merge(overlay *StoragePool, opts *MergeOptions) error {
+	return MergeDocuments(s, overlay, opts)
+}
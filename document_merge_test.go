@@ -0,0 +1,525 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+var mergeBaseDomain = strings.Join([]string{
+	`<domain type="kvm">`,
+	`  <name>template</name>`,
+	`  <memory unit="KiB">1048576</memory>`,
+	`  <vcpu>1</vcpu>`,
+	`  <os>`,
+	`    <type arch="x86_64" machine="q35">hvm</type>`,
+	`    <boot dev="hd"></boot>`,
+	`  </os>`,
+	`  <devices>`,
+	`    <disk type="file" device="disk">`,
+	`      <driver name="qemu" type="qcow2"></driver>`,
+	`      <source file="/images/base.qcow2"></source>`,
+	`      <target dev="vda" bus="virtio"></target>`,
+	`    </disk>`,
+	`    <disk type="file" device="cdrom">`,
+	`      <source file="/images/install.iso"></source>`,
+	`      <target dev="sda" bus="sata"></target>`,
+	`    </disk>`,
+	`    <controller type="usb" index="0" model="qemu-xhci"></controller>`,
+	`    <controller type="pci" index="0" model="pcie-root"></controller>`,
+	`    <interface type="network">`,
+	`      <mac address="52:54:00:00:00:01"></mac>`,
+	`      <source network="default"></source>`,
+	`    </interface>`,
+	`    <memballoon model="virtio">`,
+	`      <alias name="balloon0"></alias>`,
+	`    </memballoon>`,
+	`  </devices>`,
+	`</domain>`,
+}, "\n")
+
+var mergeDomainTests = []struct {
+	Overlay  string
+	Expected []string
+}{
+	{
+		Overlay: strings.Join([]string{
+			`<domain type="kvm">`,
+			`  <name>demo</name>`,
+			`  <memory unit="KiB">2097152</memory>`,
+			`  <memtune>`,
+			`    <hard_limit unit="KiB">4194304</hard_limit>`,
+			`  </memtune>`,
+			`  <os>`,
+			`    <boot dev="cdrom"></boot>`,
+			`    <boot dev="hd"></boot>`,
+			`  </os>`,
+			`</domain>`,
+		}, "\n"),
+		Expected: []string{
+			`<domain type="kvm">`,
+			`  <name>demo</name>`,
+			`  <memory unit="KiB">2097152</memory>`,
+			`  <memtune>`,
+			`    <hard_limit unit="KiB">4194304</hard_limit>`,
+			`  </memtune>`,
+			`  <vcpu>1</vcpu>`,
+			`  <os>`,
+			`    <type arch="x86_64" machine="q35">hvm</type>`,
+			`    <boot dev="cdrom"></boot>`,
+			`    <boot dev="hd"></boot>`,
+			`  </os>`,
+		},
+	},
+	{
+		Overlay: strings.Join([]string{
+			`<domain xmlns:merge="http://libvirt.org/schemas/go-xml/merge/1.0">`,
+			`  <devices>`,
+			`    <disk>`,
+			`      <driver cache="none"></driver>`,
+			`      <target dev="vda"></target>`,
+			`    </disk>`,
+			`    <disk type="block" device="disk">`,
+			`      <source dev="/dev/sdb"></source>`,
+			`      <target dev="vdb" bus="virtio"></target>`,
+			`    </disk>`,
+			`    <disk merge:action="remove">`,
+			`      <target dev="sda"></target>`,
+			`    </disk>`,
+			`    <controller type="usb" index="0" model="ich9-ehci1" merge:action="replace"></controller>`,
+			`    <interface type="bridge">`,
+			`      <mac address="52:54:00:00:00:02"></mac>`,
+			`      <source bridge="br0"></source>`,
+			`    </interface>`,
+			`    <memballoon model="none"></memballoon>`,
+			`  </devices>`,
+			`</domain>`,
+		}, "\n"),
+		Expected: []string{
+			`  <devices>`,
+			`    <disk type="file" device="disk">`,
+			`      <driver name="qemu" type="qcow2" cache="none"></driver>`,
+			`      <source file="/images/base.qcow2"></source>`,
+			`      <target dev="vda" bus="virtio"></target>`,
+			`    </disk>`,
+			`    <disk type="block" device="disk">`,
+			`      <source dev="/dev/sdb"></source>`,
+			`      <target dev="vdb" bus="virtio"></target>`,
+			`    </disk>`,
+			`    <controller type="usb" index="0" model="ich9-ehci1"></controller>`,
+			`    <controller type="pci" index="0" model="pcie-root"></controller>`,
+			`    <interface type="network">`,
+			`      <mac address="52:54:00:00:00:01"></mac>`,
+			`      <source network="default"></source>`,
+			`    </interface>`,
+			`    <interface type="bridge">`,
+			`      <mac address="52:54:00:00:00:02"></mac>`,
+			`      <source bridge="br0"></source>`,
+			`    </interface>`,
+			`    <memballoon model="none">`,
+			`      <alias name="balloon0"></alias>`,
+			`    </memballoon>`,
+			`  </devices>`,
+			`</domain>`,
+		},
+	},
+}
+
+func TestMergeDocumentXML(t *testing.T) {
+	for _, test := range mergeDomainTests {
+		dom := &Domain{}
+		if err := dom.Unmarshal(mergeBaseDomain); err != nil {
+			t.Fatal(err)
+		}
+		if err := MergeDocumentXML(dom, test.Overlay, nil); err != nil {
+			t.Fatal(err)
+		}
+		doc, err := dom.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect := strings.Join(test.Expected, "\n")
+		if !strings.Contains(doc, expect) {
+			t.Fatal("Bad merged xml:\n", doc, "\n does not contain\n", expect)
+		}
+	}
+}
+
+func TestDomainMerge(t *testing.T) {
+	dom := &Domain{}
+	if err := dom.Unmarshal(mergeBaseDomain); err != nil {
+		t.Fatal(err)
+	}
+
+	overlay := &Domain{
+		VCPU: &DomainVCPU{
+			Value: 4,
+		},
+		Devices: &DomainDeviceList{
+			Disks: []DomainDisk{
+				DomainDisk{
+					Target: &DomainDiskTarget{
+						Dev: "vdb",
+						Bus: "virtio",
+					},
+				},
+			},
+			Controllers: []DomainController{
+				DomainController{
+					Type:  "scsi",
+					Index: &pciDomain,
+					Model: "virtio-scsi",
+				},
+			},
+		},
+	}
+	opts := &MergeOptions{
+		Remove: []string{"devices/disk[target=vda]"},
+	}
+	if err := dom.Merge(overlay, opts); err != nil {
+		t.Fatal(err)
+	}
+
+	if dom.Name != "template" || dom.VCPU.Value != 4 {
+		t.Fatalf("Unexpected name %s or vcpus %d", dom.Name, dom.VCPU.Value)
+	}
+	var disks []string
+	for _, disk := range dom.Devices.Disks {
+		disks = append(disks, disk.Target.Dev)
+	}
+	if strings.Join(disks, ",") != "sda,vdb" {
+		t.Fatalf("Expected disks sda and vdb, got %v", disks)
+	}
+	if len(dom.Devices.Controllers) != 3 || dom.Devices.Controllers[2].Type != "scsi" {
+		t.Fatalf("Expected scsi controller to be added")
+	}
+}
+
+func TestDomainMergeUnset(t *testing.T) {
+	dom := &Domain{}
+	err := dom.Unmarshal(strings.Join([]string{
+		`<domain type="kvm">`,
+		`  <name>demo</name>`,
+		`  <vcpu placement="static">4</vcpu>`,
+		`</domain>`,
+	}, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	overlay := &Domain{
+		VCPU: &DomainVCPU{
+			Current: 2,
+		},
+	}
+	if err := dom.Merge(overlay, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := dom.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := `<vcpu placement="static" current="2">4</vcpu>`
+	if !strings.Contains(doc, expect) {
+		t.Fatal("Bad merged xml:\n", doc, "\n does not contain\n", expect)
+	}
+	if dom.Type != "kvm" || dom.Name != "demo" {
+		t.Fatalf("Unexpected type %s or name %s", dom.Type, dom.Name)
+	}
+}
+
+func TestNetworkMerge(t *testing.T) {
+	net := &Network{}
+	err := net.Unmarshal(strings.Join([]string{
+		`<network>`,
+		`  <name>default</name>`,
+		`  <forward mode="nat"></forward>`,
+		`  <portgroup name="engineering" default="yes"></portgroup>`,
+		`  <ip address="192.168.122.1" netmask="255.255.255.0">`,
+		`    <dhcp>`,
+		`      <range start="192.168.122.2" end="192.168.122.254"></range>`,
+		`      <host mac="52:54:00:00:00:01" ip="192.168.122.10"></host>`,
+		`    </dhcp>`,
+		`  </ip>`,
+		`</network>`,
+	}, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	overlay := &Network{
+		PortGroups: []NetworkPortGroup{
+			NetworkPortGroup{
+				Name: "sales",
+			},
+		},
+		IPs: []NetworkIP{
+			NetworkIP{
+				Address: "192.168.122.1",
+				DHCP: &NetworkDHCP{
+					Hosts: []NetworkDHCPHost{
+						NetworkDHCPHost{
+							MAC: "52:54:00:00:00:01",
+							IP:  "192.168.122.11",
+						},
+						NetworkDHCPHost{
+							MAC: "52:54:00:00:00:02",
+							IP:  "192.168.122.12",
+						},
+					},
+				},
+			},
+			NetworkIP{
+				Family:  "ipv6",
+				Address: "2001:db8:ca2:2::1",
+				Prefix:  64,
+			},
+		},
+	}
+	if err := net.Merge(overlay, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := net.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := strings.Join([]string{
+		`<network>`,
+		`  <name>default</name>`,
+		`  <forward mode="nat"></forward>`,
+		`  <ip address="192.168.122.1" netmask="255.255.255.0">`,
+		`    <dhcp>`,
+		`      <range start="192.168.122.2" end="192.168.122.254"></range>`,
+		`      <host mac="52:54:00:00:00:01" ip="192.168.122.11"></host>`,
+		`      <host mac="52:54:00:00:00:02" ip="192.168.122.12"></host>`,
+		`    </dhcp>`,
+		`  </ip>`,
+		`  <ip address="2001:db8:ca2:2::1" family="ipv6" prefix="64"></ip>`,
+		`  <portgroup name="engineering" default="yes"></portgroup>`,
+		`  <portgroup name="sales"></portgroup>`,
+		`</network>`,
+	}, "\n")
+	if doc != expect {
+		t.Fatal("Bad merged xml:\n", doc, "\n does not match\n", expect)
+	}
+}
+
+func TestStoragePoolMerge(t *testing.T) {
+	pool := &StoragePool{
+		Type: "dir",
+		Name: "images",
+		Target: &StoragePoolTarget{
+			Path: "/var/lib/libvirt/images",
+		},
+	}
+	overlay := &StoragePool{
+		Target: &StoragePoolTarget{
+			Path: "/srv/images",
+		},
+	}
+	if err := pool.Merge(overlay, nil); err != nil {
+		t.Fatal(err)
+	}
+	if pool.Type != "dir" || pool.Name != "images" || pool.Target.Path != "/srv/images" {
+		t.Fatalf("Unexpected merged pool %v", pool)
+	}
+}
+
+func TestMergeRemove(t *testing.T) {
+	dom := &Domain{}
+	err := dom.Unmarshal(strings.Join([]string{
+		`<domain type="kvm">`,
+		`  <name>demo</name>`,
+		`  <devices>`,
+		`    <controller type="usb" index="0" model="qemu-xhci"></controller>`,
+		`    <controller type="pci" index="0" model="pcie-root"></controller>`,
+		`    <hostdev mode="subsystem" type="pci" managed="yes">`,
+		`      <source>`,
+		`        <address domain="0x0000" bus="0x01" slot="0x00" function="0x0"></address>`,
+		`      </source>`,
+		`    </hostdev>`,
+		`    <hostdev mode="subsystem" type="pci" managed="yes">`,
+		`      <source>`,
+		`        <address domain="0x0000" bus="0x02" slot="0x00" function="0x0"></address>`,
+		`      </source>`,
+		`    </hostdev>`,
+		`    <memballoon model="virtio"></memballoon>`,
+		`  </devices>`,
+		`</domain>`,
+	}, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = dom.Merge(&Domain{}, &MergeOptions{
+		Remove: []string{
+			"devices/controller[type=usb,index=0]",
+			"devices/hostdev[domain=0x0000,bus=0x02,slot=0x00,function=0x0]",
+			"devices/memballoon",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := dom.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := strings.Join([]string{
+		`<domain type="kvm">`,
+		`  <name>demo</name>`,
+		`  <devices>`,
+		`    <controller type="pci" index="0" model="pcie-root"></controller>`,
+		`    <hostdev mode="subsystem" type="pci" managed="yes">`,
+		`      <source>`,
+		`        <address domain="0x0000" bus="0x01" slot="0x00" function="0x0"></address>`,
+		`      </source>`,
+		`    </hostdev>`,
+		`  </devices>`,
+		`</domain>`,
+	}, "\n")
+	if doc != expect {
+		t.Fatal("Bad merged xml:\n", doc, "\n does not match\n", expect)
+	}
+
+	net := &Network{}
+	err = net.Unmarshal(strings.Join([]string{
+		`<network>`,
+		`  <name>default</name>`,
+		`  <portgroup name="engineering"></portgroup>`,
+		`  <portgroup name="sales"></portgroup>`,
+		`  <ip address="192.168.122.1" netmask="255.255.255.0">`,
+		`    <dhcp>`,
+		`      <host mac="52:54:00:00:00:01" ip="192.168.122.10"></host>`,
+		`      <host mac="52:54:00:00:00:02" ip="192.168.122.11"></host>`,
+		`    </dhcp>`,
+		`  </ip>`,
+		`  <ip family="ipv6" address="2001:db8:ca2:2::1" prefix="64"></ip>`,
+		`</network>`,
+	}, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = net.Merge(&Network{}, &MergeOptions{
+		Remove: []string{
+			"portgroup[name=sales]",
+			"ip[address=192.168.122.1]/dhcp/host[mac=52:54:00:00:00:02]",
+			"ip[address=2001:db8:ca2:2::1]",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err = net.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect = strings.Join([]string{
+		`<network>`,
+		`  <name>default</name>`,
+		`  <ip address="192.168.122.1" netmask="255.255.255.0">`,
+		`    <dhcp>`,
+		`      <host mac="52:54:00:00:00:01" ip="192.168.122.10"></host>`,
+		`    </dhcp>`,
+		`  </ip>`,
+		`  <portgroup name="engineering"></portgroup>`,
+		`</network>`,
+	}, "\n")
+	if doc != expect {
+		t.Fatal("Bad merged xml:\n", doc, "\n does not match\n", expect)
+	}
+
+	err = net.Merge(&Network{}, &MergeOptions{
+		Remove: []string{"portgroup[name=sales]"},
+	})
+	if err == nil {
+		t.Fatal("Expected error removing missing portgroup")
+	}
+}
+
+func TestMergeErrors(t *testing.T) {
+	var tests = []string{
+		`<network><name>demo</name></network>`,
+		`<domain xmlns:m="http://libvirt.org/schemas/go-xml/merge/1.0">` +
+			`<devices><disk m:action="remove"><target dev="vdz"/></disk></devices></domain>`,
+		`<domain xmlns:m="http://libvirt.org/schemas/go-xml/merge/1.0">` +
+			`<vcpu m:action="delete">2</vcpu></domain>`,
+	}
+	for _, test := range tests {
+		dom := &Domain{}
+		if err := dom.Unmarshal(mergeBaseDomain); err != nil {
+			t.Fatal(err)
+		}
+		if err := MergeDocumentXML(dom, test, nil); err == nil {
+			t.Errorf("Expected error merging %s", test)
+		}
+	}
+}
+
+func TestMergeNamespaces(t *testing.T) {
+	dom := &Domain{}
+	err := dom.Unmarshal(strings.Join([]string{
+		`<domain type="kvm">`,
+		`  <name>demo</name>`,
+		`  <metadata>`,
+		`    <app:info xmlns:app="http://example.org/app" app:version="2">demo</app:info>`,
+		`  </metadata>`,
+		`</domain>`,
+	}, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = MergeDocumentXML(dom, `<domain><title>Demo</title></domain>`, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if dom.Title != "Demo" || dom.Metadata == nil {
+		t.Fatal("Expected title and metadata in merged domain")
+	}
+	info, err := LoadXML(dom.Metadata.XML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mismatches := CompareXMLElements(&XMLElement{
+		XMLNS: "http://example.org/app",
+		Name:  "info(http://example.org/app)",
+		Attrs: map[string]string{
+			"version(http://example.org/app)": "2",
+		},
+		Content: "demo",
+	}, info, nil)
+	if len(mismatches) != 0 {
+		t.Fatalf("Unexpected metadata %s", dom.Metadata.XML)
+	}
+}
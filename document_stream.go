@@ -0,0 +1,69 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+// EncodeOptions controls the formatting applied by Encode. A nil
+// value produces the same output as the Marshal method
+type EncodeOptions struct {
+	// Prefix is written at the start of every indented line
+	Prefix string
+	// Indent is repeated once per nesting level. If both Prefix
+	// and Indent are empty the document is written on one line
+	Indent string
+	// Header requests a leading <?xml ...?> declaration
+	Header bool
+}
+
+var defaultEncodeOptions = EncodeOptions{
+	Indent: "  ",
+}
+
+// Decode reads a single document from r into doc, with the same
+// result as calling doc.Unmarshal on the complete input
+func Decode(r io.Reader, doc Document) error {
+	return xml.NewDecoder(r).Decode(doc)
+}
+
+// Encode writes doc to w. With nil opts the bytes written are
+// identical to the string returned by doc.Marshal
+func Encode(w io.Writer, doc Document, opts *EncodeOptions) error {
+	if opts == nil {
+		opts = &defaultEncodeOptions
+	}
+	if opts.Header {
+		if _, err := io.WriteString(w, xml.Header); err != nil {
+			return err
+		}
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent(opts.Prefix, opts.Indent)
+	return enc.Encode(doc)
+}
@@ -0,0 +1,126 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEncodeMatchesMarshal(t *testing.T) {
+	var tests []Document
+	for _, test := range domainTestData {
+		tests = append(tests, test.Object)
+	}
+	for _, test := range networkTestData {
+		tests = append(tests, test.Object)
+	}
+	for _, obj := range tests {
+		expect, err := obj.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var buf bytes.Buffer
+		err = Encode(&buf, obj, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != expect {
+			t.Fatal("Encoded xml:\n", buf.String(), "\n does not match\n", expect, "\n")
+		}
+
+		newobj := reflect.New(reflect.ValueOf(obj).Elem().Type()).Interface().(Document)
+		err = Decode(strings.NewReader(expect), newobj)
+		if err != nil {
+			t.Fatal(err)
+		}
+		doc, err := newobj.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if doc != expect {
+			t.Fatal("Decoded xml:\n", doc, "\n does not match\n", expect, "\n")
+		}
+	}
+}
+
+func TestEncodeOptions(t *testing.T) {
+	net := &Network{
+		Name:   "default",
+		Bridge: &NetworkBridge{Name: "virbr0"},
+	}
+
+	var buf bytes.Buffer
+	err := Encode(&buf, net, &EncodeOptions{Header: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := xml.Header + `<network><name>default</name><bridge name="virbr0"></bridge></network>`
+	if buf.String() != expect {
+		t.Fatal("Encoded xml:\n", buf.String(), "\n does not match\n", expect, "\n")
+	}
+
+	buf.Reset()
+	err = Encode(&buf, net, &EncodeOptions{Prefix: "#", Indent: "\t"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect = strings.Join([]string{
+		`#<network>`,
+		`#	<name>default</name>`,
+		`#	<bridge name="virbr0"></bridge>`,
+		`#</network>`,
+	}, "\n")
+	if buf.String() != expect {
+		t.Fatal("Encoded xml:\n", buf.String(), "\n does not match\n", expect, "\n")
+	}
+}
+
+func TestDecodeDiscardsUnknownXML(t *testing.T) {
+	doc := strings.Join([]string{
+		`<domain type="kvm" foo="bar">`,
+		`  <name>demo</name>`,
+		`  <frobnicate/>`,
+		`</domain>`,
+	}, "\n")
+
+	var viaDecode, viaUnmarshal Domain
+	err := Decode(strings.NewReader(doc), &viaDecode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = viaUnmarshal.Unmarshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(viaDecode, viaUnmarshal) {
+		t.Fatalf("Decode result %#v does not match Unmarshal result %#v", viaDecode, viaUnmarshal)
+	}
+}
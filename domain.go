@@ -2806,6 +2806,10 @@ func (d *Domain) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *Domain) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *Domain) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -3018,6 +3022,10 @@ func (d *DomainGraphic) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainGraphic) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainGraphic) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -3030,6 +3038,10 @@ func (d *DomainController) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainController) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainController) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -3493,6 +3505,10 @@ func (d *DomainDisk) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainDisk) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainDisk) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -3691,6 +3707,10 @@ func (d *DomainFilesystem) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainFilesystem) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainFilesystem) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -4041,6 +4061,10 @@ func (d *DomainInterface) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainInterface) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainInterface) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -4103,6 +4127,10 @@ func (d *DomainSmartcard) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainSmartcard) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainSmartcard) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -4160,6 +4188,10 @@ func (d *DomainTPM) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainTPM) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainTPM) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -4172,6 +4204,10 @@ func (d *DomainShmem) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainShmem) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainShmem) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -4415,6 +4451,10 @@ func (d *DomainConsole) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainConsole) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainConsole) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -4458,6 +4498,10 @@ func (d *DomainSerial) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainSerial) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainSerial) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -4501,6 +4545,10 @@ func (d *DomainParallel) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainParallel) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainParallel) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -4513,6 +4561,10 @@ func (d *DomainInput) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainInput) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainInput) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -4525,6 +4577,10 @@ func (d *DomainVideo) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainVideo) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainVideo) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -4609,6 +4665,10 @@ func (d *DomainChannel) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainChannel) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainChannel) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -4693,6 +4753,10 @@ func (d *DomainRedirDev) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainRedirDev) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainRedirDev) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -4705,6 +4769,10 @@ func (d *DomainMemBalloon) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainMemBalloon) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainMemBalloon) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -4717,6 +4785,10 @@ func (d *DomainVSock) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainVSock) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainVSock) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -4729,6 +4801,10 @@ func (d *DomainSound) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainSound) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainSound) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -4820,6 +4896,10 @@ func (d *DomainRNG) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainRNG) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainRNG) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -5097,6 +5177,10 @@ func (d *DomainHostdev) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainHostdev) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainHostdev) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -5452,6 +5536,10 @@ func (d *DomainMemorydev) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainMemorydev) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainMemorydev) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -5464,6 +5552,10 @@ func (d *DomainWatchdog) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainWatchdog) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainWatchdog) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
@@ -5978,6 +6070,10 @@ func (d *DomainCPU) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), d)
 }
 
+func (d *DomainCPU) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(d, doc)
+}
+
 func (d *DomainCPU) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(d, "", "  ")
 	if err != nil {
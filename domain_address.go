@@ -0,0 +1,1322 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PCIAddressOptions controls the behaviour of AssignPCIAddresses
+type PCIAddressOptions struct {
+	// HotplugSlots is the number of empty slots to leave for
+	// devices hotplugged later. On PCI Express machines these
+	// are provided by empty pcie-root-port controllers
+	HotplugSlots uint
+}
+
+// Ways in which a device or controller can be plugged into a bus
+const (
+	pciConnectPCI = 1 << iota
+	pciConnectPCIe
+	pciConnectRootPort
+	// Straight into a slot of its own on the root bus
+	pciConnectRootBus
+)
+
+const pciSlotFull = 0xff
+
+type pciAllocBus struct {
+	index   uint
+	model   string
+	minSlot uint
+	maxSlot uint
+	accept  uint
+	hotplug bool
+	// Bitmask of functions in use for each slot
+	slots [32]uint8
+}
+
+func newPCIAllocBus(index uint, model string) *pciAllocBus {
+	bus := &pciAllocBus{index: index, model: model}
+	switch model {
+	case "pci-root":
+		bus.minSlot, bus.maxSlot = 1, 31
+		bus.accept = pciConnectPCI | pciConnectRootBus
+		bus.hotplug = true
+	case "pci-bridge", "pcie-to-pci-bridge":
+		bus.minSlot, bus.maxSlot = 1, 31
+		bus.accept = pciConnectPCI
+		bus.hotplug = true
+	case "pcie-root":
+		bus.minSlot, bus.maxSlot = 1, 31
+		bus.accept = pciConnectRootPort | pciConnectRootBus
+	case "pcie-root-port", "pcie-switch-downstream-port":
+		bus.minSlot, bus.maxSlot = 0, 0
+		bus.accept = pciConnectPCIe
+		bus.hotplug = true
+	case "dmi-to-pci-bridge", "pci-expander-bus":
+		bus.minSlot, bus.maxSlot = 0, 31
+		bus.accept = pciConnectPCI
+	default:
+		// Switch upstream ports and expander buses only
+		// take specific controller models, which are
+		// never allocated automatically
+		bus.minSlot, bus.maxSlot = 1, 0
+	}
+	return bus
+}
+
+func (b *pciAllocBus) freeSlots() uint {
+	count := uint(0)
+	for slot := b.minSlot; slot <= b.maxSlot; slot++ {
+		if b.slots[slot] == 0 {
+			count++
+		}
+	}
+	return count
+}
+
+type pciDevice struct {
+	addr    **DomainAddress
+	connect uint
+	// Slot and function on bus 0 which the machine type
+	// dictates for this device, if any
+	fixed         bool
+	fixedSlot     uint
+	fixedFunction uint
+}
+
+type pciAllocator struct {
+	q35       bool
+	i440fx    bool
+	pcie      bool
+	buses     map[uint]*pciAllocBus
+	nextIndex uint
+	// Slot on bus 0 currently being filled with root ports
+	portSlot     uint
+	portFunction uint
+	videoSlot    uint
+	videoFree    bool
+	controllers  []DomainController
+}
+
+func pciAddress(bus, slot, function uint, multifunction bool) *DomainAddress {
+	domain := uint(0)
+	addr := &DomainAddress{
+		PCI: &DomainAddressPCI{
+			Domain:   &domain,
+			Bus:      &bus,
+			Slot:     &slot,
+			Function: &function,
+		},
+	}
+	if multifunction {
+		addr.PCI.MultiFunction = "on"
+	}
+	return addr
+}
+
+func hasPCIAddress(addr *DomainAddress) bool {
+	return addr != nil && addr.PCI != nil && addr.PCI.Slot != nil
+}
+
+func (a *pciAllocator) sortedBuses() []*pciAllocBus {
+	var buses []*pciAllocBus
+	for _, bus := range a.buses {
+		buses = append(buses, bus)
+	}
+	sort.Slice(buses, func(i, j int) bool {
+		return buses[i].index < buses[j].index
+	})
+	return buses
+}
+
+func (a *pciAllocator) markAddress(addr *DomainAddress) {
+	if !hasPCIAddress(addr) {
+		return
+	}
+	busIndex := uint(0)
+	if addr.PCI.Bus != nil {
+		busIndex = *addr.PCI.Bus
+	}
+	bus, ok := a.buses[busIndex]
+	if !ok || *addr.PCI.Slot > 31 {
+		return
+	}
+	function := uint(0)
+	if addr.PCI.Function != nil {
+		function = *addr.PCI.Function
+	}
+	if function == 0 && addr.PCI.MultiFunction != "on" {
+		bus.slots[*addr.PCI.Slot] = pciSlotFull
+	} else if function < 8 {
+		bus.slots[*addr.PCI.Slot] |= 1 << function
+	}
+}
+
+func (a *pciAllocator) freeSlots(connect uint, hotplug bool) uint {
+	count := uint(0)
+	for _, bus := range a.buses {
+		if bus.accept&connect == 0 || (hotplug && !bus.hotplug) {
+			continue
+		}
+		count += bus.freeSlots()
+	}
+	return count
+}
+
+func (a *pciAllocator) findSlot(connect uint) (uint, uint, bool) {
+	for _, bus := range a.sortedBuses() {
+		if bus.accept&connect == 0 {
+			continue
+		}
+		for slot := bus.minSlot; slot <= bus.maxSlot; slot++ {
+			if bus.slots[slot] == 0 {
+				bus.slots[slot] = pciSlotFull
+				return bus.index, slot, true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// rootPortAddress returns the address for a new root port. Root
+// ports are packed eight to a slot on pcie-root as libvirt does
+func (a *pciAllocator) rootPortAddress() (*DomainAddress, error) {
+	if a.portFunction > 0 && a.portFunction < 8 {
+		a.portFunction++
+		return pciAddress(0, a.portSlot, a.portFunction-1, false), nil
+	}
+	_, slot, ok := a.findSlot(pciConnectRootPort)
+	if !ok {
+		return nil, fmt.Errorf("No free slot on pcie-root for a pcie-root-port")
+	}
+	a.portSlot = slot
+	a.portFunction = 1
+	return pciAddress(0, slot, 0, true), nil
+}
+
+func (a *pciAllocator) addController(model string, addr *DomainAddress) *pciAllocBus {
+	index := a.nextIndex
+	a.nextIndex++
+	a.controllers = append(a.controllers, DomainController{
+		Type:    "pci",
+		Index:   &index,
+		Model:   model,
+		Address: addr,
+	})
+	bus := newPCIAllocBus(index, model)
+	a.buses[index] = bus
+	return bus
+}
+
+func (a *pciAllocator) addRootPort() (*pciAllocBus, error) {
+	addr, err := a.rootPortAddress()
+	if err != nil {
+		return nil, err
+	}
+	return a.addController("pcie-root-port", addr), nil
+}
+
+func (a *pciAllocator) addBridge() error {
+	if a.pcie {
+		port, err := a.addRootPort()
+		if err != nil {
+			return err
+		}
+		port.slots[0] = pciSlotFull
+		a.addController("pcie-to-pci-bridge", pciAddress(port.index, 0, 0, false))
+		return nil
+	}
+	bus, slot, ok := a.findSlot(pciConnectPCI)
+	if !ok {
+		return fmt.Errorf("No free PCI slot for a pci-bridge")
+	}
+	a.addController("pci-bridge", pciAddress(bus, slot, 0, false))
+	return nil
+}
+
+func (a *pciAllocator) allocate(connect uint) (*DomainAddress, error) {
+	if connect == pciConnectRootPort {
+		return a.rootPortAddress()
+	}
+	if connect == pciConnectRootBus {
+		bus, slot, ok := a.findSlot(connect)
+		if !ok {
+			return nil, fmt.Errorf("No free slot on the PCI root bus")
+		}
+		return pciAddress(bus, slot, 0, false), nil
+	}
+	if !a.pcie {
+		connect = pciConnectPCI
+		// Keep the last free slot for a bridge to extend
+		// the topology with
+		if a.freeSlots(pciConnectPCI, false) == 1 {
+			if err := a.addBridge(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if bus, slot, ok := a.findSlot(connect); ok {
+		return pciAddress(bus, slot, 0, false), nil
+	}
+	if connect == pciConnectPCIe {
+		port, err := a.addRootPort()
+		if err != nil {
+			return nil, err
+		}
+		port.slots[0] = pciSlotFull
+		return pciAddress(port.index, 0, 0, false), nil
+	}
+	if a.pcie {
+		if err := a.addBridge(); err != nil {
+			return nil, err
+		}
+		if bus, slot, ok := a.findSlot(connect); ok {
+			return pciAddress(bus, slot, 0, false), nil
+		}
+	}
+	return nil, fmt.Errorf("No free PCI slot available")
+}
+
+func (a *pciAllocator) reserveHotplug(count uint) error {
+	if a.pcie {
+		free := a.freeSlots(pciConnectPCIe, true)
+		for ; free < count; free++ {
+			if _, err := a.addRootPort(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for a.freeSlots(pciConnectPCI, true) < count {
+		if err := a.addBridge(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *pciAllocator) controllerDevice(c *DomainController) *pciDevice {
+	dev := &pciDevice{addr: &c.Address}
+	index := uint(0)
+	if c.Index != nil {
+		index = *c.Index
+	}
+	switch c.Type {
+	case "pci":
+		switch c.Model {
+		case "pci-bridge":
+			dev.connect = pciConnectPCI
+		case "pcie-root-port":
+			dev.connect = pciConnectRootPort
+		case "dmi-to-pci-bridge", "pcie-expander-bus", "pci-expander-bus":
+			dev.connect = pciConnectRootBus
+		case "pcie-to-pci-bridge", "pcie-switch-upstream-port":
+			dev.connect = pciConnectPCIe
+		default:
+			return nil
+		}
+	case "usb":
+		switch {
+		case c.Model == "none":
+			return nil
+		case a.i440fx && index == 0 && (c.Model == "" || c.Model == "piix3-uhci"):
+			dev.fixed, dev.fixedSlot, dev.fixedFunction = true, 1, 2
+		case a.q35 && index == 0 && c.Model == "ich9-ehci1":
+			dev.fixed, dev.fixedSlot, dev.fixedFunction = true, 0x1d, 7
+		case a.q35 && index == 0 && strings.HasPrefix(c.Model, "ich9-uhci"):
+			function := uint(c.Model[len(c.Model)-1] - '1')
+			dev.fixed, dev.fixedSlot, dev.fixedFunction = true, 0x1d, function
+		case c.Model == "qemu-xhci" || c.Model == "nec-xhci" || c.Model == "":
+			dev.connect = pciConnectPCIe
+		default:
+			dev.connect = pciConnectPCI
+		}
+	case "ide":
+		if !a.i440fx || index != 0 {
+			return nil
+		}
+		dev.fixed, dev.fixedSlot, dev.fixedFunction = true, 1, 1
+	case "sata":
+		if a.q35 && index == 0 {
+			dev.fixed, dev.fixedSlot, dev.fixedFunction = true, 0x1f, 2
+		} else {
+			dev.connect = pciConnectPCIe
+		}
+	case "scsi":
+		if c.Model == "virtio-scsi" || c.Model == "" {
+			dev.connect = pciConnectPCIe
+		} else {
+			dev.connect = pciConnectPCI
+		}
+	case "virtio-serial":
+		dev.connect = pciConnectPCIe
+	default:
+		return nil
+	}
+	return dev
+}
+
+// devices lists everything which needs a PCI address, roughly in
+// the order libvirt assigns them
+func (a *pciAllocator) devices(devs *DomainDeviceList) []*pciDevice {
+	var list []*pciDevice
+	add := func(addr **DomainAddress, connect uint) {
+		list = append(list, &pciDevice{addr: addr, connect: connect})
+	}
+
+	for i := range devs.Controllers {
+		if dev := a.controllerDevice(&devs.Controllers[i]); dev != nil {
+			list = append(list, dev)
+		}
+	}
+	for i := range devs.Filesystems {
+		add(&devs.Filesystems[i].Address, pciConnectPCIe)
+	}
+	for i := range devs.Interfaces {
+		iface := &devs.Interfaces[i]
+		connect := uint(pciConnectPCIe)
+		if iface.Model != nil {
+			switch iface.Model.Type {
+			case "virtio", "virtio-transitional", "e1000e", "vmxnet3":
+			case "spapr-vlan":
+				continue
+			default:
+				connect = pciConnectPCI
+			}
+		}
+		add(&iface.Address, connect)
+	}
+	for i := range devs.Sounds {
+		sound := &devs.Sounds[i]
+		switch sound.Model {
+		case "ich9":
+			if a.q35 {
+				list = append(list, &pciDevice{
+					addr: &sound.Address, fixed: true, fixedSlot: 0x1b,
+				})
+			} else {
+				add(&sound.Address, pciConnectPCIe)
+			}
+		case "ac97", "es1370", "ich6", "ich7":
+			add(&sound.Address, pciConnectPCI)
+		}
+	}
+	for i := range devs.Hostdevs {
+		if devs.Hostdevs[i].SubsysPCI != nil {
+			add(&devs.Hostdevs[i].Address, pciConnectPCIe)
+		}
+	}
+	if devs.MemBalloon != nil && devs.MemBalloon.Model != "none" {
+		add(&devs.MemBalloon.Address, pciConnectPCIe)
+	}
+	for i := range devs.RNGs {
+		add(&devs.RNGs[i].Address, pciConnectPCIe)
+	}
+	if devs.Watchdog != nil && devs.Watchdog.Model == "i6300esb" {
+		add(&devs.Watchdog.Address, pciConnectPCI)
+	}
+	for i := range devs.Videos {
+		video := &devs.Videos[i]
+		if video.Model.Type == "none" {
+			continue
+		}
+		if i == 0 && a.videoFree {
+			list = append(list, &pciDevice{
+				addr: &video.Address, fixed: true, fixedSlot: a.videoSlot,
+			})
+		} else if strings.HasPrefix(video.Model.Type, "virtio") {
+			add(&video.Address, pciConnectPCIe)
+		} else {
+			add(&video.Address, pciConnectPCI)
+		}
+	}
+	for i := range devs.Inputs {
+		if devs.Inputs[i].Bus == "virtio" {
+			add(&devs.Inputs[i].Address, pciConnectPCIe)
+		}
+	}
+	for i := range devs.Disks {
+		if devs.Disks[i].Target != nil && devs.Disks[i].Target.Bus == "virtio" {
+			add(&devs.Disks[i].Address, pciConnectPCIe)
+		}
+	}
+	if devs.VSock != nil {
+		add(&devs.VSock.Address, pciConnectPCIe)
+	}
+	for i := range devs.Shmems {
+		add(&devs.Shmems[i].Address, pciConnectPCI)
+	}
+	for i := range devs.Serials {
+		serial := &devs.Serials[i]
+		if serial.Target != nil && serial.Target.Type == "pci-serial" {
+			add(&serial.Address, pciConnectPCI)
+		}
+	}
+	return list
+}
+
+func (a *pciAllocator) reserveSlot(slot uint) bool {
+	root := a.buses[0]
+	if root.slots[slot] != 0 {
+		return false
+	}
+	root.slots[slot] = pciSlotFull
+	return true
+}
+
+// AssignPCIAddresses gives every device which lives on the PCI bus,
+// and does not already have one, an explicit PCI address. Existing
+// addresses are left untouched. The pcie-root-port, pci-bridge and
+// pcie-to-pci-bridge controllers needed to hold the devices are
+// added to the domain. The layout follows the rules libvirt uses for
+// the q35 and i440fx machine types, and the generic rules for other
+// machines with PCI or PCI Express buses
+func (d *Domain) AssignPCIAddresses(opts *PCIAddressOptions) error {
+	if opts == nil {
+		opts = &PCIAddressOptions{}
+	}
+
+	arch, machine := d.archMachine()
+	if strings.HasPrefix(arch, "s390") {
+		// CCW addresses are used instead
+		return nil
+	}
+	x86 := isX86Arch(arch)
+
+	if d.Devices == nil {
+		d.Devices = &DomainDeviceList{}
+	}
+	devs := d.Devices
+
+	a := &pciAllocator{
+		buses: make(map[uint]*pciAllocBus),
+	}
+	rootModel := ""
+	for _, c := range devs.Controllers {
+		if c.Type != "pci" {
+			continue
+		}
+		index := uint(0)
+		if c.Index != nil {
+			index = *c.Index
+		}
+		a.buses[index] = newPCIAllocBus(index, c.Model)
+		if index >= a.nextIndex {
+			a.nextIndex = index + 1
+		}
+		if index == 0 {
+			rootModel = c.Model
+		}
+	}
+
+	if rootModel == "" {
+		if _, ok := a.buses[0]; ok {
+			return fmt.Errorf("PCI controller with index 0 must be pci-root or pcie-root")
+		}
+		rootModel = defaultPCIRootModel(arch, machine)
+		index := uint(0)
+		devs.Controllers = append([]DomainController{
+			DomainController{Type: "pci", Index: &index, Model: rootModel},
+		}, devs.Controllers...)
+		a.buses[0] = newPCIAllocBus(0, rootModel)
+		if a.nextIndex == 0 {
+			a.nextIndex = 1
+		}
+	}
+
+	a.pcie = rootModel == "pcie-root"
+	a.q35 = x86 && a.pcie
+	a.i440fx = x86 && !a.pcie
+
+	list := a.devices(devs)
+
+	// Slots the machine type hardwires for its own devices, which
+	// nothing else may use
+	reserved := make(map[uint]bool)
+	if a.q35 {
+		reserved[0x1f] = true
+		a.videoSlot = 1
+	} else if a.i440fx {
+		reserved[1] = true
+		a.videoSlot = 2
+	}
+	for _, dev := range list {
+		if dev.fixed && !hasPCIAddress(*dev.addr) {
+			reserved[dev.fixedSlot] = true
+		}
+	}
+	for _, dev := range list {
+		addr := *dev.addr
+		if hasPCIAddress(addr) && (addr.PCI.Bus == nil || *addr.PCI.Bus == 0) &&
+			reserved[*addr.PCI.Slot] && (!dev.fixed || dev.fixedSlot != *addr.PCI.Slot) {
+			return fmt.Errorf("PCI slot 0x%02x on bus 0 is reserved for the machine's own devices",
+				*addr.PCI.Slot)
+		}
+		a.markAddress(addr)
+	}
+	for slot := range reserved {
+		a.reserveSlot(slot)
+	}
+
+	if a.videoSlot != 0 {
+		a.videoFree = a.reserveSlot(a.videoSlot)
+		// The device list depends on whether the video
+		// slot is available
+		list = a.devices(devs)
+	}
+
+	for _, dev := range list {
+		if hasPCIAddress(*dev.addr) {
+			continue
+		}
+		if *dev.addr != nil && (*dev.addr).PCI == nil {
+			// Device is on some other bus type
+			continue
+		}
+		var addr *DomainAddress
+		if dev.fixed {
+			addr = pciAddress(0, dev.fixedSlot, dev.fixedFunction,
+				dev.fixedSlot == 0x1d && dev.fixedFunction == 0)
+		} else {
+			var err error
+			addr, err = a.allocate(dev.connect)
+			if err != nil {
+				return err
+			}
+		}
+		if *dev.addr != nil {
+			// Preserve any zPCI extension
+			addr.PCI.ZPCI = (*dev.addr).PCI.ZPCI
+		}
+		*dev.addr = addr
+	}
+
+	if err := a.reserveHotplug(opts.HotplugSlots); err != nil {
+		return err
+	}
+
+	devs.Controllers = append(devs.Controllers, a.controllers...)
+	return nil
+}
+
+// diskTargetName returns the name of the disk with the given
+// zero based index, using the same scheme as libvirt, ie
+// vda...vdz, vdaa...vdaz, vdba...
+func diskTargetName(prefix string, index int) string {
+	name := ""
+	for i := index; i >= 0; i = i/26 - 1 {
+		name = string(rune('a'+i%26)) + name
+	}
+	return prefix + name
+}
+
+func diskTargetPrefix(bus string) string {
+	switch bus {
+	case "virtio":
+		return "vd"
+	case "ide":
+		return "hd"
+	case "fdc":
+		return "fd"
+	case "xen":
+		return "xvd"
+	}
+	return "sd"
+}
+
+// unusedDiskTargetName returns the first name for a disk on the given
+// bus which is not already present in used, and records it as used
+func unusedDiskTargetName(used map[string]bool, bus string) string {
+	prefix := diskTargetPrefix(bus)
+	for i := 0; ; i++ {
+		name := diskTargetName(prefix, i)
+		if !used[name] {
+			used[name] = true
+			return name
+		}
+	}
+}
+
+// AssignDiskTargets gives every disk without a target device name
+// the first free name for its bus type, eg vda for virtio, sda for
+// scsi, sata and usb, or hda for ide. An error is reported if two
+// disks already share a name
+func (d *Domain) AssignDiskTargets() error {
+	if d.Devices == nil {
+		return nil
+	}
+	disks := d.Devices.Disks
+	used := make(map[string]bool)
+	for _, disk := range disks {
+		if disk.Target == nil || disk.Target.Dev == "" {
+			continue
+		}
+		if used[disk.Target.Dev] {
+			return fmt.Errorf("Duplicate disk target '%s'", disk.Target.Dev)
+		}
+		used[disk.Target.Dev] = true
+	}
+	for i := range disks {
+		target := disks[i].Target
+		if target != nil && target.Dev != "" {
+			continue
+		}
+		if target == nil || target.Bus == "" {
+			return fmt.Errorf("Disk %d has no target bus", i)
+		}
+		target.Dev = unusedDiskTargetName(used, target.Bus)
+	}
+	return nil
+}
+
+// diskTargetIndex is the inverse of diskTargetName, returning the
+// zero based index of a disk name such as sdc
+func diskTargetIndex(prefix string, name string) (uint, bool) {
+	if !strings.HasPrefix(name, prefix) || len(name) == len(prefix) {
+		return 0, false
+	}
+	index := uint(0)
+	for i, c := range name[len(prefix):] {
+		if c < 'a' || c > 'z' {
+			return 0, false
+		}
+		if i > 0 {
+			index++
+		}
+		index = index*26 + uint(c-'a')
+	}
+	return index, true
+}
+
+// driveIndexAddress returns the drive address libvirt gives the disk
+// with the given index on a bus of type typ
+func driveIndexAddress(typ string, index uint) (uint, uint, uint) {
+	switch typ {
+	case "ide":
+		// Two units per bus, two buses per controller
+		return index / 4, (index % 4) / 2, index % 2
+	case "sata":
+		return index / 6, 0, index % 6
+	case "fdc":
+		return index / 2, 0, index % 2
+	}
+	// SCSI buses are wide, with 16 units of which unit 7 is the
+	// controller itself
+	unit := index % 15
+	if unit >= 7 {
+		unit++
+	}
+	return index / 15, 0, unit
+}
+
+type driveAllocController struct {
+	index uint
+	buses uint
+	units uint
+	// Unit number taken by the controller itself on wide SCSI buses
+	reserved int
+	used     map[[2]uint]bool
+}
+
+func newDriveAllocController(typ, model string, index uint) *driveAllocController {
+	c := &driveAllocController{
+		index:    index,
+		buses:    1,
+		reserved: -1,
+		used:     make(map[[2]uint]bool),
+	}
+	switch typ {
+	case "ide":
+		c.buses, c.units = 2, 2
+	case "sata":
+		c.units = 6
+	case "fdc":
+		c.units = 2
+	case "scsi":
+		// The limits QEMU places on each controller model
+		switch model {
+		case "virtio-scsi", "virtio-transitional", "virtio-non-transitional",
+			"lsisas1078":
+			c.units = 16
+		case "lsisas1068":
+			c.units = 2
+		default:
+			c.units, c.reserved = 16, 7
+		}
+	}
+	return c
+}
+
+func (c *driveAllocController) isUsed(bus, unit uint) bool {
+	return bus >= c.buses || unit >= c.units || int(unit) == c.reserved ||
+		c.used[[2]uint{bus, unit}]
+}
+
+// claim marks the unit as used, if it is free
+func (c *driveAllocController) claim(bus, unit uint) bool {
+	if c.isUsed(bus, unit) {
+		return false
+	}
+	c.used[[2]uint{bus, unit}] = true
+	return true
+}
+
+func (c *driveAllocController) allocate() (uint, uint, bool) {
+	for bus := uint(0); bus < c.buses; bus++ {
+		for unit := uint(0); unit < c.units; unit++ {
+			if c.claim(bus, unit) {
+				return bus, unit, true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+type driveDevice struct {
+	typ  string
+	addr **DomainAddress
+	// Target device name of a disk, which determines its address
+	dev string
+}
+
+func needsAddress(addr *DomainAddress, assigned func(*DomainAddress) (bool, bool)) bool {
+	if addr == nil {
+		return true
+	}
+	matches, complete := assigned(addr)
+	return matches && !complete
+}
+
+func driveAddressState(addr *DomainAddress) (bool, bool) {
+	return addr.Drive != nil, addr.Drive != nil && addr.Drive.Unit != nil
+}
+
+// AssignDriveAddresses gives every disk on an scsi, sata, ide or fdc
+// bus, and every SCSI host device, a drive address on one of the
+// domain's controllers of that type. As in libvirt, a disk's address
+// follows from its target device name, so sdc is unit 2 of the first
+// controller, unless that unit is taken or not valid for the
+// controller model. Other devices get the first free unit. Existing
+// drive addresses are left untouched, and further controllers are
+// added when the existing ones are full
+func (d *Domain) AssignDriveAddresses() error {
+	if d.Devices == nil {
+		return nil
+	}
+	devs := d.Devices
+
+	controllers := make(map[string][]*driveAllocController)
+	nextIndex := make(map[string]uint)
+	models := make(map[string]string)
+	for _, c := range devs.Controllers {
+		if c.Type != "scsi" && c.Type != "sata" && c.Type != "ide" && c.Type != "fdc" {
+			continue
+		}
+		index := uint(0)
+		if c.Index != nil {
+			index = *c.Index
+		}
+		controllers[c.Type] = append(controllers[c.Type],
+			newDriveAllocController(c.Type, c.Model, index))
+		if index >= nextIndex[c.Type] {
+			nextIndex[c.Type] = index + 1
+		}
+		models[c.Type] = c.Model
+	}
+	for _, list := range controllers {
+		sort.Slice(list, func(i, j int) bool {
+			return list[i].index < list[j].index
+		})
+	}
+
+	var added []DomainController
+	// controller returns the controller with the given index, adding
+	// it and any missing ones before it, as libvirt does
+	controller := func(typ string, index uint) *driveAllocController {
+		for ; nextIndex[typ] <= index; nextIndex[typ]++ {
+			model := models[typ]
+			if typ == "scsi" && model == "" {
+				model = "virtio-scsi"
+				models[typ] = model
+			}
+			ctrlIndex := nextIndex[typ]
+			controllers[typ] = append(controllers[typ],
+				newDriveAllocController(typ, model, ctrlIndex))
+			added = append(added, DomainController{
+				Type:  typ,
+				Index: &ctrlIndex,
+				Model: model,
+			})
+		}
+		for _, c := range controllers[typ] {
+			if c.index == index {
+				return c
+			}
+		}
+		return nil
+	}
+
+	var list []driveDevice
+	for i := range devs.Disks {
+		disk := &devs.Disks[i]
+		if disk.Target == nil {
+			continue
+		}
+		switch disk.Target.Bus {
+		case "scsi", "sata", "ide", "fdc":
+			list = append(list, driveDevice{disk.Target.Bus, &disk.Address, disk.Target.Dev})
+		}
+	}
+	for i := range devs.Hostdevs {
+		if devs.Hostdevs[i].SubsysSCSI != nil {
+			list = append(list, driveDevice{"scsi", &devs.Hostdevs[i].Address, ""})
+		}
+	}
+
+	for _, dev := range list {
+		addr := *dev.addr
+		if addr == nil || addr.Drive == nil || addr.Drive.Unit == nil {
+			continue
+		}
+		index, bus := uint(0), uint(0)
+		if addr.Drive.Controller != nil {
+			index = *addr.Drive.Controller
+		}
+		if addr.Drive.Bus != nil {
+			bus = *addr.Drive.Bus
+		}
+		for _, c := range controllers[dev.typ] {
+			if c.index == index {
+				c.used[[2]uint{bus, *addr.Drive.Unit}] = true
+			}
+		}
+	}
+
+	for _, dev := range list {
+		if !needsAddress(*dev.addr, driveAddressState) {
+			continue
+		}
+		var index, bus, unit uint
+		found := false
+		if diskIndex, ok := diskTargetIndex(diskTargetPrefix(dev.typ), dev.dev); ok {
+			index, bus, unit = driveIndexAddress(dev.typ, diskIndex)
+			// Only a single floppy controller is supported
+			if dev.typ != "fdc" || index == 0 {
+				c := controller(dev.typ, index)
+				found = c != nil && c.claim(bus, unit)
+			}
+		}
+		if !found {
+			for _, c := range controllers[dev.typ] {
+				if bus, unit, found = c.allocate(); found {
+					index = c.index
+					break
+				}
+			}
+		}
+		if !found {
+			if dev.typ == "fdc" && len(controllers[dev.typ]) > 0 {
+				return fmt.Errorf("No free fdc drive address available")
+			}
+			index = nextIndex[dev.typ]
+			bus, unit, _ = controller(dev.typ, index).allocate()
+		}
+		target := uint(0)
+		*dev.addr = &DomainAddress{
+			Drive: &DomainAddressDrive{
+				Controller: &index,
+				Bus:        &bus,
+				Target:     &target,
+				Unit:       &unit,
+			},
+		}
+	}
+
+	devs.Controllers = append(devs.Controllers, added...)
+	return nil
+}
+
+type usbAllocBus struct {
+	index uint
+	ports []string
+	used  map[string]bool
+}
+
+func (b *usbAllocBus) addHub(port string) {
+	for i := 1; i <= 8; i++ {
+		b.ports = append(b.ports, fmt.Sprintf("%s.%d", port, i))
+	}
+}
+
+func usbControllerPorts(c *DomainController) uint {
+	if c.USB != nil && c.USB.Port != nil {
+		return *c.USB.Port
+	}
+	switch c.Model {
+	case "ehci", "ich9-ehci1":
+		return 6
+	case "pci-ohci":
+		return 3
+	case "nec-xhci", "qusb1", "qusb2":
+		return 4
+	case "qemu-xhci":
+		return 15
+	}
+	return 2
+}
+
+type usbAllocator struct {
+	buses []*usbAllocBus
+	hubs  []DomainHub
+}
+
+func (a *usbAllocator) free() int {
+	count := 0
+	for _, bus := range a.buses {
+		for _, port := range bus.ports {
+			if !bus.used[port] {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func (a *usbAllocator) find() (*usbAllocBus, string, bool) {
+	for _, bus := range a.buses {
+		for _, port := range bus.ports {
+			if !bus.used[port] {
+				bus.used[port] = true
+				return bus, port, true
+			}
+		}
+	}
+	return nil, "", false
+}
+
+func usbAddress(bus uint, port string) *DomainAddress {
+	return &DomainAddress{
+		USB: &DomainAddressUSB{
+			Bus:  &bus,
+			Port: port,
+		},
+	}
+}
+
+func (a *usbAllocator) allocate(hub bool) (*DomainAddress, error) {
+	// Keep the last free port for a hub to extend the bus with
+	if !hub && a.free() == 1 {
+		bus, port, _ := a.find()
+		bus.addHub(port)
+		a.hubs = append(a.hubs, DomainHub{
+			Type:    "usb",
+			Address: usbAddress(bus.index, port),
+		})
+	}
+	bus, port, ok := a.find()
+	if !ok {
+		return nil, fmt.Errorf("No free USB port available")
+	}
+	if hub {
+		bus.addHub(port)
+	}
+	return usbAddress(bus.index, port), nil
+}
+
+func usbAddressState(addr *DomainAddress) (bool, bool) {
+	return addr.USB != nil, addr.USB != nil && addr.USB.Port != ""
+}
+
+// AssignUSBAddresses gives every USB device a port on one of the
+// domain's USB controllers. Existing USB addresses are left untouched,
+// and usb hubs are added when the controllers run out of ports
+func (d *Domain) AssignUSBAddresses() error {
+	if d.Devices == nil {
+		return nil
+	}
+	devs := d.Devices
+
+	a := &usbAllocator{}
+	for i := range devs.Controllers {
+		c := &devs.Controllers[i]
+		if c.Type != "usb" || c.Model == "none" ||
+			(c.USB != nil && c.USB.Master != nil) {
+			continue
+		}
+		index := uint(0)
+		if c.Index != nil {
+			index = *c.Index
+		}
+		bus := &usbAllocBus{index: index, used: make(map[string]bool)}
+		for port := uint(1); port <= usbControllerPorts(c); port++ {
+			bus.ports = append(bus.ports, fmt.Sprint(port))
+		}
+		a.buses = append(a.buses, bus)
+	}
+	sort.Slice(a.buses, func(i, j int) bool {
+		return a.buses[i].index < a.buses[j].index
+	})
+
+	var hubs, list []**DomainAddress
+	for i := range devs.Hubs {
+		if devs.Hubs[i].Type == "usb" {
+			hubs = append(hubs, &devs.Hubs[i].Address)
+		}
+	}
+	for i := range devs.Disks {
+		if devs.Disks[i].Target != nil && devs.Disks[i].Target.Bus == "usb" {
+			list = append(list, &devs.Disks[i].Address)
+		}
+	}
+	for i := range devs.Inputs {
+		if devs.Inputs[i].Bus == "usb" {
+			list = append(list, &devs.Inputs[i].Address)
+		}
+	}
+	for i := range devs.Hostdevs {
+		if devs.Hostdevs[i].SubsysUSB != nil {
+			list = append(list, &devs.Hostdevs[i].Address)
+		}
+	}
+	for i := range devs.RedirDevs {
+		if devs.RedirDevs[i].Bus == "usb" {
+			list = append(list, &devs.RedirDevs[i].Address)
+		}
+	}
+	for i := range devs.Sounds {
+		if devs.Sounds[i].Model == "usb" {
+			list = append(list, &devs.Sounds[i].Address)
+		}
+	}
+	for i := range devs.Controllers {
+		if devs.Controllers[i].Type == "ccid" {
+			list = append(list, &devs.Controllers[i].Address)
+		}
+	}
+
+	mark := func(addr *DomainAddress, hub bool) {
+		if addr == nil || addr.USB == nil || addr.USB.Port == "" {
+			return
+		}
+		index := uint(0)
+		if addr.USB.Bus != nil {
+			index = *addr.USB.Bus
+		}
+		for _, bus := range a.buses {
+			if bus.index == index {
+				bus.used[addr.USB.Port] = true
+				if hub {
+					bus.addHub(addr.USB.Port)
+				}
+			}
+		}
+	}
+	for _, addr := range hubs {
+		mark(*addr, true)
+	}
+	for _, addr := range list {
+		mark(*addr, false)
+	}
+
+	for _, addr := range hubs {
+		if !needsAddress(*addr, usbAddressState) {
+			continue
+		}
+		newaddr, err := a.allocate(true)
+		if err != nil {
+			return err
+		}
+		*addr = newaddr
+	}
+	for _, addr := range list {
+		if !needsAddress(*addr, usbAddressState) {
+			continue
+		}
+		newaddr, err := a.allocate(false)
+		if err != nil {
+			return err
+		}
+		*addr = newaddr
+	}
+
+	devs.Hubs = append(devs.Hubs, a.hubs...)
+	return nil
+}
+
+type virtioSerialAllocBus struct {
+	index uint
+	ports uint
+	used  map[uint]bool
+}
+
+func (b *virtioSerialAllocBus) allocate(allowZero bool) (uint, bool) {
+	port := uint(1)
+	if allowZero {
+		port = 0
+	}
+	for ; port < b.ports; port++ {
+		if !b.used[port] {
+			b.used[port] = true
+			return port, true
+		}
+	}
+	return 0, false
+}
+
+func virtioSerialAddressState(addr *DomainAddress) (bool, bool) {
+	return addr.VirtioSerial != nil,
+		addr.VirtioSerial != nil && addr.VirtioSerial.Port != nil
+}
+
+// AssignVirtioSerialAddresses gives every virtio console and channel
+// a port on one of the domain's virtio-serial controllers. Port zero
+// is only used for consoles. Existing addresses are left untouched,
+// and virtio-serial controllers are added when the existing ones are
+// full
+func (d *Domain) AssignVirtioSerialAddresses() error {
+	if d.Devices == nil {
+		return nil
+	}
+	devs := d.Devices
+
+	var buses []*virtioSerialAllocBus
+	nextIndex := uint(0)
+	for _, c := range devs.Controllers {
+		if c.Type != "virtio-serial" {
+			continue
+		}
+		bus := &virtioSerialAllocBus{ports: 31, used: make(map[uint]bool)}
+		if c.Index != nil {
+			bus.index = *c.Index
+		}
+		if c.VirtIOSerial != nil && c.VirtIOSerial.Ports != nil {
+			bus.ports = *c.VirtIOSerial.Ports
+		}
+		buses = append(buses, bus)
+		if bus.index >= nextIndex {
+			nextIndex = bus.index + 1
+		}
+	}
+	sort.Slice(buses, func(i, j int) bool {
+		return buses[i].index < buses[j].index
+	})
+
+	var consoles, channels []**DomainAddress
+	for i := range devs.Consoles {
+		if devs.Consoles[i].Target != nil && devs.Consoles[i].Target.Type == "virtio" {
+			consoles = append(consoles, &devs.Consoles[i].Address)
+		}
+	}
+	for i := range devs.Channels {
+		if devs.Channels[i].Target != nil && devs.Channels[i].Target.VirtIO != nil {
+			channels = append(channels, &devs.Channels[i].Address)
+		}
+	}
+
+	for _, addr := range append(consoles, channels...) {
+		if *addr == nil || (*addr).VirtioSerial == nil || (*addr).VirtioSerial.Port == nil {
+			continue
+		}
+		index := uint(0)
+		if (*addr).VirtioSerial.Controller != nil {
+			index = *(*addr).VirtioSerial.Controller
+		}
+		for _, bus := range buses {
+			if bus.index == index {
+				bus.used[*(*addr).VirtioSerial.Port] = true
+			}
+		}
+	}
+
+	var added []DomainController
+	assign := func(addrs []**DomainAddress, allowZero bool) {
+		for _, addr := range addrs {
+			if !needsAddress(*addr, virtioSerialAddressState) {
+				continue
+			}
+			var index, port uint
+			found := false
+			for _, bus := range buses {
+				if port, found = bus.allocate(allowZero); found {
+					index = bus.index
+					break
+				}
+			}
+			if !found {
+				bus := &virtioSerialAllocBus{
+					index: nextIndex,
+					ports: 31,
+					used:  make(map[uint]bool),
+				}
+				nextIndex++
+				buses = append(buses, bus)
+				index = bus.index
+				ctrlIndex := index
+				added = append(added, DomainController{
+					Type:  "virtio-serial",
+					Index: &ctrlIndex,
+				})
+				port, _ = bus.allocate(allowZero)
+			}
+			zero := uint(0)
+			*addr = &DomainAddress{
+				VirtioSerial: &DomainAddressVirtioSerial{
+					Controller: &index,
+					Bus:        &zero,
+					Port:       &port,
+				},
+			}
+		}
+	}
+	assign(consoles, true)
+	assign(channels, false)
+
+	devs.Controllers = append(devs.Controllers, added...)
+	return nil
+}
+
+// AssignAddresses names all disks and assigns addresses to all
+// devices, adding any controllers needed. The PCI addresses are
+// assigned last so that they cover the added controllers
+func (d *Domain) AssignAddresses(opts *PCIAddressOptions) error {
+	if err := d.AssignDiskTargets(); err != nil {
+		return err
+	}
+	if err := d.AssignDriveAddresses(); err != nil {
+		return err
+	}
+	if err := d.AssignUSBAddresses(); err != nil {
+		return err
+	}
+	if err := d.AssignVirtioSerialAddresses(); err != nil {
+		return err
+	}
+	return d.AssignPCIAddresses(opts)
+}
@@ -0,0 +1,559 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"testing"
+)
+
+func pciAddressString(addr *DomainAddress) string {
+	if addr == nil || addr.PCI == nil {
+		return "none"
+	}
+	val := func(v *uint) uint {
+		if v == nil {
+			return 0
+		}
+		return *v
+	}
+	s := fmt.Sprintf("%02x:%02x.%x", val(addr.PCI.Bus), val(addr.PCI.Slot), val(addr.PCI.Function))
+	if addr.PCI.MultiFunction == "on" {
+		s += "+"
+	}
+	return s
+}
+
+func TestAssignPCIAddressesQ35(t *testing.T) {
+	dom, err := NewDomainBuilder("demo", DomainProfileX86_64Q35).
+		AddDisk("/var/lib/libvirt/images/demo.qcow2").
+		AddNetworkInterface("default").
+		AddGraphics("vnc").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = dom.AssignPCIAddresses(&PCIAddressOptions{HotplugSlots: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	devs := dom.Devices
+	var actual []string
+	for _, c := range devs.Controllers {
+		actual = append(actual, c.Type+"/"+c.Model+" "+pciAddressString(c.Address))
+	}
+	actual = append(actual,
+		"interface "+pciAddressString(devs.Interfaces[0].Address),
+		"video "+pciAddressString(devs.Videos[0].Address),
+		"memballoon "+pciAddressString(devs.MemBalloon.Address),
+		"rng "+pciAddressString(devs.RNGs[0].Address),
+		"disk "+pciAddressString(devs.Disks[0].Address))
+
+	expected := []string{
+		"pci/pcie-root none",
+		"usb/qemu-xhci 01:00.0",
+		"virtio-serial/ 02:00.0",
+		"pci/pcie-root-port 00:02.0+",
+		"pci/pcie-root-port 00:02.1",
+		"pci/pcie-root-port 00:02.2",
+		"pci/pcie-root-port 00:02.3",
+		"pci/pcie-root-port 00:02.4",
+		"pci/pcie-root-port 00:02.5",
+		"pci/pcie-root-port 00:02.6",
+		"pci/pcie-root-port 00:02.7",
+		"interface 03:00.0",
+		"video 00:01.0",
+		"memballoon 04:00.0",
+		"rng 05:00.0",
+		"disk 06:00.0",
+	}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected %d addresses, got %d: %v", len(expected), len(actual), actual)
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Errorf("Expected '%s' got '%s'", expected[i], actual[i])
+		}
+	}
+}
+
+func TestAssignPCIAddressesI440FX(t *testing.T) {
+	slot := uint(3)
+	function := uint(1)
+	dom := &Domain{
+		OS: &DomainOS{
+			Type: &DomainOSType{Arch: "x86_64", Machine: "pc-i440fx-5.0", Type: "hvm"},
+		},
+		Devices: &DomainDeviceList{
+			Controllers: []DomainController{
+				DomainController{Type: "ide", Index: new(uint)},
+				DomainController{Type: "usb", Index: new(uint), Model: "piix3-uhci"},
+			},
+			Videos: []DomainVideo{
+				DomainVideo{Model: DomainVideoModel{Type: "cirrus"}},
+			},
+		},
+	}
+	// An existing device using function 1 of slot 3 keeps the
+	// whole slot out of use for new devices
+	dom.Devices.Disks = append(dom.Devices.Disks, DomainDisk{
+		Target: &DomainDiskTarget{Dev: "vda", Bus: "virtio"},
+		Address: &DomainAddress{
+			PCI: &DomainAddressPCI{Slot: &slot, Function: &function},
+		},
+	})
+	for i := 1; i < 30; i++ {
+		dom.Devices.Disks = append(dom.Devices.Disks, DomainDisk{
+			Target: &DomainDiskTarget{Dev: diskTargetName("vd", i), Bus: "virtio"},
+		})
+	}
+
+	err := dom.AssignPCIAddresses(&PCIAddressOptions{HotplugSlots: 40})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	devs := dom.Devices
+	var tests = []struct {
+		Name     string
+		Address  *DomainAddress
+		Expected string
+	}{
+		{"ide", devs.Controllers[1].Address, "00:01.1"},
+		{"usb", devs.Controllers[2].Address, "00:01.2"},
+		{"video", devs.Videos[0].Address, "00:02.0"},
+		{"vda", devs.Disks[0].Address, "00:03.1"},
+		{"vdb", devs.Disks[1].Address, "00:04.0"},
+		{"vdab", devs.Disks[27].Address, "00:1e.0"},
+		{"vdac", devs.Disks[28].Address, "01:01.0"},
+		{"vdad", devs.Disks[29].Address, "01:02.0"},
+	}
+	for _, test := range tests {
+		addr := pciAddressString(test.Address)
+		if addr != test.Expected {
+			t.Errorf("%s: expected '%s' got '%s'", test.Name, test.Expected, addr)
+		}
+	}
+
+	var bridges []string
+	for _, c := range devs.Controllers {
+		if c.Type == "pci" {
+			bridges = append(bridges, c.Model+" "+pciAddressString(c.Address))
+		}
+	}
+	expected := []string{
+		"pci-root none",
+		"pci-bridge 00:1f.0",
+		"pci-bridge 01:03.0",
+	}
+	if fmt.Sprint(bridges) != fmt.Sprint(expected) {
+		t.Errorf("Expected controllers %v got %v", expected, bridges)
+	}
+	// The machine's own devices may keep their slots
+	if err := dom.AssignPCIAddresses(nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAssignPCIAddressesRootBus(t *testing.T) {
+	var tests = []struct {
+		Machine  string
+		Models   []string
+		Expected []string
+	}{
+		{
+			Machine: "q35",
+			Models:  []string{"pcie-root", "dmi-to-pci-bridge", "pcie-expander-bus", "pcie-root-port"},
+			Expected: []string{
+				"pcie-root none",
+				"dmi-to-pci-bridge 00:02.0",
+				"pcie-expander-bus 00:03.0",
+				"pcie-root-port 00:04.0+",
+			},
+		},
+		{
+			Machine: "pc",
+			Models:  []string{"pci-root", "pci-expander-bus"},
+			Expected: []string{
+				"pci-root none",
+				"pci-expander-bus 00:03.0",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		dom := &Domain{
+			OS: &DomainOS{
+				Type: &DomainOSType{Arch: "x86_64", Machine: test.Machine, Type: "hvm"},
+			},
+			Devices: &DomainDeviceList{},
+		}
+		for i, model := range test.Models {
+			index := uint(i)
+			dom.Devices.Controllers = append(dom.Devices.Controllers, DomainController{
+				Type: "pci", Index: &index, Model: model,
+			})
+		}
+
+		if err := dom.AssignPCIAddresses(nil); err != nil {
+			t.Fatal(err)
+		}
+
+		var actual []string
+		for _, c := range dom.Devices.Controllers {
+			actual = append(actual, c.Model+" "+pciAddressString(c.Address))
+		}
+		if fmt.Sprint(actual) != fmt.Sprint(test.Expected) {
+			t.Errorf("Expected controllers %v got %v", test.Expected, actual)
+		}
+	}
+}
+
+func TestAssignPCIAddressesReserved(t *testing.T) {
+	var tests = []struct {
+		Machine string
+		Slot    uint
+	}{
+		{"q35", 0x1f},
+		{"pc", 1},
+	}
+
+	for _, test := range tests {
+		slot := test.Slot
+		dom := &Domain{
+			OS: &DomainOS{
+				Type: &DomainOSType{Arch: "x86_64", Machine: test.Machine, Type: "hvm"},
+			},
+			Devices: &DomainDeviceList{
+				Disks: []DomainDisk{
+					DomainDisk{
+						Target: &DomainDiskTarget{Dev: "vda", Bus: "virtio"},
+						Address: &DomainAddress{
+							PCI: &DomainAddressPCI{Slot: &slot},
+						},
+					},
+				},
+			},
+		}
+		if err := dom.AssignPCIAddresses(nil); err == nil {
+			t.Errorf("Expected error for a disk in slot %d on %s", slot, test.Machine)
+		}
+	}
+}
+
+func TestAssignPCIAddressesCCW(t *testing.T) {
+	dom, err := NewDomainBuilder("demo", DomainProfileS390XCCW).
+		AddDisk("/var/lib/libvirt/images/demo.qcow2").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = dom.AssignPCIAddresses(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dom.Devices.Disks[0].Address != nil {
+		t.Error("Unexpected PCI address on s390x")
+	}
+}
+
+func TestAssignDiskTargets(t *testing.T) {
+	dom := &Domain{
+		Devices: &DomainDeviceList{
+			Disks: []DomainDisk{
+				DomainDisk{Target: &DomainDiskTarget{Dev: "vda", Bus: "virtio"}},
+				DomainDisk{Target: &DomainDiskTarget{Bus: "virtio"}},
+				DomainDisk{Target: &DomainDiskTarget{Bus: "sata"}},
+				DomainDisk{Target: &DomainDiskTarget{Dev: "sda", Bus: "scsi"}},
+				DomainDisk{Target: &DomainDiskTarget{Bus: "ide"}},
+				DomainDisk{Target: &DomainDiskTarget{Bus: "usb"}},
+			},
+		},
+	}
+	for i := 0; i < 26; i++ {
+		dom.Devices.Disks = append(dom.Devices.Disks, DomainDisk{
+			Target: &DomainDiskTarget{Bus: "virtio"},
+		})
+	}
+
+	err := dom.AssignDiskTargets()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		Index    int
+		Expected string
+	}{
+		{0, "vda"},
+		{1, "vdb"},
+		{2, "sdb"},
+		{3, "sda"},
+		{4, "hda"},
+		{5, "sdc"},
+		{6, "vdc"},
+		{29, "vdz"},
+		{30, "vdaa"},
+		{31, "vdab"},
+	}
+	for _, test := range tests {
+		dev := dom.Devices.Disks[test.Index].Target.Dev
+		if dev != test.Expected {
+			t.Errorf("Disk %d: expected '%s' got '%s'", test.Index, test.Expected, dev)
+		}
+	}
+
+	dom.Devices.Disks[1].Target.Dev = "vda"
+	if err := dom.AssignDiskTargets(); err == nil {
+		t.Fatal("Expected error for duplicate disk target")
+	}
+}
+
+func driveAddressString(addr *DomainAddress) string {
+	if addr == nil || addr.Drive == nil {
+		return "none"
+	}
+	return fmt.Sprintf("%d:%d:%d:%d", *addr.Drive.Controller, *addr.Drive.Bus,
+		*addr.Drive.Target, *addr.Drive.Unit)
+}
+
+func TestAssignDriveAddresses(t *testing.T) {
+	unit := uint(0)
+	dom := &Domain{
+		Devices: &DomainDeviceList{
+			Controllers: []DomainController{
+				DomainController{Type: "sata", Index: new(uint)},
+			},
+			Disks: []DomainDisk{
+				DomainDisk{
+					Target: &DomainDiskTarget{Dev: "sda", Bus: "sata"},
+					Address: &DomainAddress{
+						Drive: &DomainAddressDrive{Unit: &unit},
+					},
+				},
+			},
+		},
+	}
+	for i := 1; i < 8; i++ {
+		dom.Devices.Disks = append(dom.Devices.Disks, DomainDisk{
+			Target: &DomainDiskTarget{Dev: diskTargetName("sd", i), Bus: "sata"},
+		})
+	}
+	for i := 0; i < 9; i++ {
+		dom.Devices.Disks = append(dom.Devices.Disks, DomainDisk{
+			Target: &DomainDiskTarget{Dev: diskTargetName("sd", i+8), Bus: "scsi"},
+		})
+	}
+
+	err := dom.AssignDriveAddresses()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var actual []string
+	for _, disk := range dom.Devices.Disks[1:] {
+		actual = append(actual, driveAddressString(disk.Address))
+	}
+	expected := []string{
+		"0:0:0:1", "0:0:0:2", "0:0:0:3", "0:0:0:4", "0:0:0:5",
+		"1:0:0:0", "1:0:0:1",
+		"0:0:0:9", "0:0:0:10", "0:0:0:11", "0:0:0:12", "0:0:0:13",
+		"0:0:0:14", "0:0:0:15", "1:0:0:0", "1:0:0:1",
+	}
+	if fmt.Sprint(actual) != fmt.Sprint(expected) {
+		t.Errorf("Expected addresses %v got %v", expected, actual)
+	}
+
+	var controllers []string
+	for _, c := range dom.Devices.Controllers {
+		controllers = append(controllers, fmt.Sprintf("%s/%d/%s", c.Type, *c.Index, c.Model))
+	}
+	expected = []string{"sata/0/", "sata/1/", "scsi/0/virtio-scsi", "scsi/1/virtio-scsi"}
+	if fmt.Sprint(controllers) != fmt.Sprint(expected) {
+		t.Errorf("Expected controllers %v got %v", expected, controllers)
+	}
+	if dom.Devices.Controllers[1].Index == dom.Devices.Disks[6].Address.Drive.Controller {
+		t.Errorf("Expected added controller and drive address not to share an index")
+	}
+}
+
+func TestAssignDriveAddressesSCSI(t *testing.T) {
+	var tests = []struct {
+		Model   string
+		Used    []uint
+		Disk    string
+		Hostdev string
+	}{
+		{"virtio-scsi", []uint{0, 1, 3, 4, 5, 6}, "0:0:0:2", "0:0:0:7"},
+		{"lsilogic", []uint{0, 1, 3, 4, 5, 6}, "0:0:0:2", "0:0:0:8"},
+		{"ibmvscsi", []uint{0, 1, 3, 4, 5, 6}, "0:0:0:2", "0:0:0:8"},
+		{"lsisas1068", []uint{0, 1}, "1:0:0:0", "1:0:0:1"},
+	}
+
+	for _, test := range tests {
+		dom := &Domain{
+			Devices: &DomainDeviceList{
+				Controllers: []DomainController{
+					DomainController{Type: "scsi", Index: new(uint), Model: test.Model},
+				},
+				Hostdevs: []DomainHostdev{
+					DomainHostdev{
+						SubsysSCSI: &DomainHostdevSubsysSCSI{},
+					},
+				},
+			},
+		}
+		for _, unit := range test.Used {
+			unit := unit
+			dom.Devices.Disks = append(dom.Devices.Disks, DomainDisk{
+				Target: &DomainDiskTarget{Dev: diskTargetName("sd", 10+int(unit)), Bus: "scsi"},
+				Address: &DomainAddress{
+					Drive: &DomainAddressDrive{Controller: new(uint), Unit: &unit},
+				},
+			})
+		}
+		dom.Devices.Disks = append(dom.Devices.Disks, DomainDisk{
+			Target: &DomainDiskTarget{Dev: "sdc", Bus: "scsi"},
+		})
+
+		if err := dom.AssignDriveAddresses(); err != nil {
+			t.Fatal(err)
+		}
+
+		disk := driveAddressString(dom.Devices.Disks[len(test.Used)].Address)
+		if disk != test.Disk {
+			t.Errorf("Expected %s disk sdc at %s got %s", test.Model, test.Disk, disk)
+		}
+		hostdev := driveAddressString(dom.Devices.Hostdevs[0].Address)
+		if hostdev != test.Hostdev {
+			t.Errorf("Expected %s hostdev at %s got %s", test.Model, test.Hostdev, hostdev)
+		}
+	}
+}
+
+func TestAssignUSBAddresses(t *testing.T) {
+	dom := &Domain{
+		Devices: &DomainDeviceList{
+			Controllers: []DomainController{
+				DomainController{Type: "usb", Index: new(uint), Model: "piix3-uhci"},
+			},
+			Inputs: []DomainInput{
+				DomainInput{Type: "tablet", Bus: "usb"},
+				DomainInput{Type: "keyboard", Bus: "usb"},
+				DomainInput{Type: "mouse", Bus: "ps2"},
+			},
+			Disks: []DomainDisk{
+				DomainDisk{Target: &DomainDiskTarget{Dev: "sda", Bus: "usb"}},
+			},
+		},
+	}
+
+	err := dom.AssignUSBAddresses()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var actual []string
+	for _, addr := range []*DomainAddress{
+		dom.Devices.Disks[0].Address,
+		dom.Devices.Inputs[0].Address,
+		dom.Devices.Inputs[1].Address,
+		dom.Devices.Inputs[2].Address,
+	} {
+		if addr == nil {
+			actual = append(actual, "none")
+		} else {
+			actual = append(actual, fmt.Sprintf("%d/%s", *addr.USB.Bus, addr.USB.Port))
+		}
+	}
+	expected := []string{"0/1", "0/2.1", "0/2.2", "none"}
+	if fmt.Sprint(actual) != fmt.Sprint(expected) {
+		t.Errorf("Expected addresses %v got %v", expected, actual)
+	}
+	if len(dom.Devices.Hubs) != 1 || dom.Devices.Hubs[0].Address.USB.Port != "2" {
+		t.Errorf("Expected a usb hub on port 2, got %v", dom.Devices.Hubs)
+	}
+
+	dom.Devices.Controllers[0].Model = "none"
+	dom.Devices.Inputs[0].Address = nil
+	if err := dom.AssignUSBAddresses(); err == nil {
+		t.Fatal("Expected error without a usb controller")
+	}
+}
+
+func TestAssignVirtioSerialAddresses(t *testing.T) {
+	ports := uint(3)
+	dom := &Domain{
+		Devices: &DomainDeviceList{
+			Controllers: []DomainController{
+				DomainController{
+					Type:         "virtio-serial",
+					Index:        new(uint),
+					VirtIOSerial: &DomainControllerVirtIOSerial{Ports: &ports},
+				},
+			},
+			Consoles: []DomainConsole{
+				DomainConsole{Target: &DomainConsoleTarget{Type: "virtio"}},
+			},
+		},
+	}
+	for i := 0; i < 3; i++ {
+		dom.Devices.Channels = append(dom.Devices.Channels, DomainChannel{
+			Target: &DomainChannelTarget{
+				VirtIO: &DomainChannelTargetVirtIO{Name: fmt.Sprintf("org.example.%d", i)},
+			},
+		})
+	}
+
+	err := dom.AssignVirtioSerialAddresses()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var actual []string
+	addrs := []*DomainAddress{dom.Devices.Consoles[0].Address}
+	for _, channel := range dom.Devices.Channels {
+		addrs = append(addrs, channel.Address)
+	}
+	for _, addr := range addrs {
+		actual = append(actual, fmt.Sprintf("%d.%d.%d",
+			*addr.VirtioSerial.Controller, *addr.VirtioSerial.Bus, *addr.VirtioSerial.Port))
+	}
+	expected := []string{"0.0.0", "0.0.1", "0.0.2", "1.0.1"}
+	if fmt.Sprint(actual) != fmt.Sprint(expected) {
+		t.Errorf("Expected addresses %v got %v", expected, actual)
+	}
+	if len(dom.Devices.Controllers) != 2 {
+		t.Fatalf("Expected an extra virtio-serial controller")
+	}
+	if dom.Devices.Controllers[1].Index == dom.Devices.Channels[2].Address.VirtioSerial.Controller {
+		t.Errorf("Expected added controller and virtio-serial address not to share an index")
+	}
+}
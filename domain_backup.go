@@ -0,0 +1,157 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"encoding/xml"
+)
+
+type DomainBackupServer struct {
+	Transport string `xml:"transport,attr,omitempty"`
+	Socket    string `xml:"socket,attr,omitempty"`
+	Name      string `xml:"name,attr,omitempty"`
+	Port      string `xml:"port,attr,omitempty"`
+	TLS       string `xml:"tls,attr,omitempty"`
+}
+
+type DomainBackupDisk struct {
+	Name         string            `xml:"name,attr"`
+	Backup       string            `xml:"backup,attr,omitempty"`
+	BackupMode   string            `xml:"backupmode,attr,omitempty"`
+	Incremental  string            `xml:"incremental,attr,omitempty"`
+	ExportName   string            `xml:"exportname,attr,omitempty"`
+	ExportBitmap string            `xml:"exportbitmap,attr,omitempty"`
+	Driver       *DomainDiskDriver `xml:"driver"`
+	Target       *DomainDiskSource `xml:"target"`
+	Scratch      *DomainDiskSource `xml:"scratch"`
+}
+
+type DomainBackupDisks struct {
+	Disks []DomainBackupDisk `xml:"disk"`
+}
+
+type DomainBackup struct {
+	XMLName     xml.Name            `xml:"domainbackup"`
+	Mode        string              `xml:"mode,attr,omitempty"`
+	Incremental string              `xml:"incremental,omitempty"`
+	Server      *DomainBackupServer `xml:"server"`
+	Disks       *DomainBackupDisks  `xml:"disks"`
+}
+
+type domainBackupDisk DomainBackupDisk
+
+func newDomainBackupDiskSource(typ string) *DomainDiskSource {
+	src := &DomainDiskSource{}
+	if typ == "file" {
+		src.File = &DomainDiskSourceFile{}
+	} else if typ == "block" {
+		src.Block = &DomainDiskSourceBlock{}
+	} else if typ == "network" {
+		src.Network = &DomainDiskSourceNetwork{}
+	}
+	return src
+}
+
+func (a *DomainBackupDisk) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = "disk"
+	src := a.Target
+	if src == nil {
+		src = a.Scratch
+	}
+	if src != nil {
+		if src.File != nil {
+			start.Attr = append(start.Attr, xml.Attr{
+				Name: xml.Name{Local: "type"}, Value: "file",
+			})
+		} else if src.Block != nil {
+			start.Attr = append(start.Attr, xml.Attr{
+				Name: xml.Name{Local: "type"}, Value: "block",
+			})
+		} else if src.Network != nil {
+			start.Attr = append(start.Attr, xml.Attr{
+				Name: xml.Name{Local: "type"}, Value: "network",
+			})
+		}
+	}
+	disk := domainBackupDisk(*a)
+	return e.EncodeElement(disk, start)
+}
+
+// domainBackupDiskSource decodes a target or scratch element into
+// a source of the disk type, so that it is only set when present
+type domainBackupDiskSource struct {
+	typ    string
+	source *DomainDiskSource
+}
+
+func (a *domainBackupDiskSource) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	a.source = newDomainBackupDiskSource(a.typ)
+	return d.DecodeElement(a.source, &start)
+}
+
+type domainBackupDiskUnmarshal struct {
+	domainBackupDisk
+	Target  domainBackupDiskSource `xml:"target"`
+	Scratch domainBackupDiskSource `xml:"scratch"`
+}
+
+func (a *DomainBackupDisk) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	typ, ok := getAttr(start.Attr, "type")
+	if !ok {
+		typ = "file"
+	}
+	disk := domainBackupDiskUnmarshal{
+		domainBackupDisk: domainBackupDisk(*a),
+		Target:           domainBackupDiskSource{typ: typ},
+		Scratch:          domainBackupDiskSource{typ: typ},
+	}
+	err := d.DecodeElement(&disk, &start)
+	if err != nil {
+		return err
+	}
+	*a = DomainBackupDisk(disk.domainBackupDisk)
+	// Only one of target / scratch is used depending on
+	// the backup mode
+	a.Target = disk.Target.source
+	a.Scratch = disk.Scratch.source
+	return nil
+}
+
+func (s *DomainBackup) Unmarshal(doc string) error {
+	return xml.Unmarshal([]byte(doc), s)
+}
+
+func (s *DomainBackup) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(s, doc)
+}
+
+func (s *DomainBackup) Marshal() (string, error) {
+	doc, err := xml.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(doc), nil
+}
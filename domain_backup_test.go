@@ -0,0 +1,193 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2019 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+var domainBackupTestData = []struct {
+	Object   *DomainBackup
+	Expected []string
+}{
+	{
+		Object: &DomainBackup{
+			Mode:        "push",
+			Incremental: "1525889631",
+			Disks: &DomainBackupDisks{
+				Disks: []DomainBackupDisk{
+					DomainBackupDisk{
+						Name:   "vda",
+						Backup: "yes",
+						Driver: &DomainDiskDriver{
+							Type: "raw",
+						},
+						Target: &DomainDiskSource{
+							File: &DomainDiskSourceFile{
+								File: "/path/to/vda.backup",
+							},
+						},
+					},
+					DomainBackupDisk{
+						Name:   "vdb",
+						Backup: "yes",
+						Target: &DomainDiskSource{
+							Block: &DomainDiskSourceBlock{
+								Dev: "/dev/vg0/vdb-backup",
+							},
+						},
+					},
+					DomainBackupDisk{
+						Name:   "vdc",
+						Backup: "no",
+					},
+				},
+			},
+		},
+		Expected: []string{
+			`<domainbackup mode="push">`,
+			`  <incremental>1525889631</incremental>`,
+			`  <disks>`,
+			`    <disk type="file" name="vda" backup="yes">`,
+			`      <driver type="raw"></driver>`,
+			`      <target file="/path/to/vda.backup"></target>`,
+			`    </disk>`,
+			`    <disk type="block" name="vdb" backup="yes">`,
+			`      <target dev="/dev/vg0/vdb-backup"></target>`,
+			`    </disk>`,
+			`    <disk name="vdc" backup="no"></disk>`,
+			`  </disks>`,
+			`</domainbackup>`,
+		},
+	},
+	{
+		Object: &DomainBackup{
+			Mode: "pull",
+			Server: &DomainBackupServer{
+				Transport: "tcp",
+				Name:      "localhost",
+				Port:      "10809",
+				TLS:       "yes",
+			},
+			Disks: &DomainBackupDisks{
+				Disks: []DomainBackupDisk{
+					DomainBackupDisk{
+						Name:         "vda",
+						Backup:       "yes",
+						ExportName:   "vda-export",
+						ExportBitmap: "vda-bitmap",
+						Scratch: &DomainDiskSource{
+							File: &DomainDiskSourceFile{
+								File: "/path/to/vda.scratch",
+							},
+						},
+					},
+				},
+			},
+		},
+		Expected: []string{
+			`<domainbackup mode="pull">`,
+			`  <server transport="tcp" name="localhost" port="10809" tls="yes"></server>`,
+			`  <disks>`,
+			`    <disk type="file" name="vda" backup="yes" exportname="vda-export" exportbitmap="vda-bitmap">`,
+			`      <scratch file="/path/to/vda.scratch"></scratch>`,
+			`    </disk>`,
+			`  </disks>`,
+			`</domainbackup>`,
+		},
+	},
+	{
+		Object: &DomainBackup{
+			Mode: "pull",
+			Server: &DomainBackupServer{
+				Transport: "unix",
+				Socket:    "/run/backup.sock",
+			},
+		},
+		Expected: []string{
+			`<domainbackup mode="pull">`,
+			`  <server transport="unix" socket="/run/backup.sock"></server>`,
+			`</domainbackup>`,
+		},
+	},
+}
+
+func TestDomainBackup(t *testing.T) {
+	for _, test := range domainBackupTestData {
+		doc, err := test.Object.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expect := strings.Join(test.Expected, "\n")
+
+		if doc != expect {
+			t.Fatal("Bad xml:\n", string(doc), "\n does not match\n", expect, "\n")
+		}
+
+		obj := &DomainBackup{}
+		err = obj.Unmarshal(doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		doc, err = obj.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if doc != expect {
+			t.Fatal("Bad xml:\n", string(doc), "\n does not match\n", expect, "\n")
+		}
+	}
+}
+
+func TestDomainBackupDiskPresence(t *testing.T) {
+	obj := &DomainBackup{}
+	err := obj.Unmarshal(strings.Join([]string{
+		`<domainbackup mode="pull">`,
+		`  <disks>`,
+		`    <disk name="vda" type="file">`,
+		`      <scratch></scratch>`,
+		`    </disk>`,
+		`    <disk name="vdb" backup="no"></disk>`,
+		`  </disks>`,
+		`</domainbackup>`,
+	}, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vda := obj.Disks.Disks[0]
+	if vda.Target != nil || vda.Scratch == nil || vda.Scratch.File == nil {
+		t.Fatalf("Expected only a file scratch for vda, got %v %v", vda.Target, vda.Scratch)
+	}
+	vdb := obj.Disks.Disks[1]
+	if vdb.Target != nil || vdb.Scratch != nil {
+		t.Fatalf("Expected no target or scratch for vdb, got %v %v", vdb.Target, vdb.Scratch)
+	}
+}
@@ -0,0 +1,493 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DomainProfile describes the devices and settings which make up a
+// sensible guest for a particular architecture and machine type
+type DomainProfile struct {
+	Arch     string
+	Machine  string
+	Firmware string
+	CPUMode  string
+
+	// Model of the root PCI controller, or empty if the machine
+	// has no PCI bus by default
+	PCIRoot string
+	// Model of the USB controller, "none" if USB is not wanted
+	USBModel string
+
+	DiskBus  string
+	CDROMBus string
+
+	// Serial target type and model. If SerialType is empty the
+	// console is attached directly using ConsoleType instead
+	SerialType  string
+	SerialModel string
+	ConsoleType string
+
+	VideoModel string
+	// Bus used for the tablet and keyboard of graphical guests
+	InputBus string
+	// Keyboard is set when the machine has no implicit PS/2
+	// keyboard for graphical guests
+	Keyboard bool
+
+	ACPI bool
+	APIC bool
+	GIC  bool
+
+	Timers []DomainTimer
+}
+
+var DomainProfileX86_64Q35 = DomainProfile{
+	Arch:        "x86_64",
+	Machine:     "q35",
+	CPUMode:     "host-model",
+	PCIRoot:     "pcie-root",
+	USBModel:    "qemu-xhci",
+	DiskBus:     "virtio",
+	CDROMBus:    "sata",
+	SerialType:  "isa-serial",
+	SerialModel: "isa-serial",
+	VideoModel:  "virtio",
+	InputBus:    "usb",
+	ACPI:        true,
+	APIC:        true,
+	Timers: []DomainTimer{
+		{Name: "rtc", TickPolicy: "catchup"},
+		{Name: "pit", TickPolicy: "delay"},
+		{Name: "hpet", Present: "no"},
+	},
+}
+
+var DomainProfileAArch64Virt = DomainProfile{
+	Arch:        "aarch64",
+	Machine:     "virt",
+	Firmware:    "efi",
+	CPUMode:     "host-passthrough",
+	PCIRoot:     "pcie-root",
+	USBModel:    "qemu-xhci",
+	DiskBus:     "virtio",
+	CDROMBus:    "scsi",
+	SerialType:  "system-serial",
+	SerialModel: "pl011",
+	VideoModel:  "virtio",
+	InputBus:    "usb",
+	Keyboard:    true,
+	ACPI:        true,
+	GIC:         true,
+}
+
+var DomainProfilePPC64PSeries = DomainProfile{
+	Arch:        "ppc64",
+	Machine:     "pseries",
+	CPUMode:     "host-model",
+	PCIRoot:     "pci-root",
+	USBModel:    "qemu-xhci",
+	DiskBus:     "virtio",
+	CDROMBus:    "scsi",
+	SerialType:  "spapr-vio-serial",
+	SerialModel: "spapr-vty",
+	VideoModel:  "vga",
+	InputBus:    "usb",
+	Keyboard:    true,
+}
+
+var DomainProfileS390XCCW = DomainProfile{
+	Arch:        "s390x",
+	Machine:     "s390-ccw-virtio",
+	CPUMode:     "host-model",
+	USBModel:    "none",
+	DiskBus:     "virtio",
+	CDROMBus:    "scsi",
+	ConsoleType: "sclp",
+	VideoModel:  "virtio",
+	InputBus:    "virtio",
+	Keyboard:    true,
+}
+
+// DomainBuilder assembles a complete Domain from a handful of
+// calls, filling in the controllers and devices required by
+// the chosen DomainProfile
+type DomainBuilder struct {
+	profile    DomainProfile
+	typ        string
+	name       string
+	uuid       string
+	memory     uint
+	memoryUnit string
+	vcpus      uint
+	cpuMode    string
+	disks      []DomainDisk
+	interfaces []DomainInterface
+	graphics   []DomainGraphic
+	err        error
+}
+
+func NewDomainBuilder(name string, profile DomainProfile) *DomainBuilder {
+	return &DomainBuilder{
+		profile:    profile,
+		typ:        "kvm",
+		name:       name,
+		memory:     1,
+		memoryUnit: "GiB",
+		vcpus:      1,
+		cpuMode:    profile.CPUMode,
+	}
+}
+
+func (b *DomainBuilder) Type(typ string) *DomainBuilder {
+	b.typ = typ
+	return b
+}
+
+func (b *DomainBuilder) UUID(uuid string) *DomainBuilder {
+	b.uuid = uuid
+	return b
+}
+
+func (b *DomainBuilder) Memory(size uint, unit string) *DomainBuilder {
+	b.memory = size
+	b.memoryUnit = unit
+	return b
+}
+
+func (b *DomainBuilder) VCPUs(count uint) *DomainBuilder {
+	b.vcpus = count
+	return b
+}
+
+func (b *DomainBuilder) CPUMode(mode string) *DomainBuilder {
+	b.cpuMode = mode
+	return b
+}
+
+func (b *DomainBuilder) addDisk(device, bus, source, format string) {
+	used := make(map[string]bool)
+	for _, disk := range b.disks {
+		used[disk.Target.Dev] = true
+	}
+
+	disk := DomainDisk{
+		Device: device,
+		Driver: &DomainDiskDriver{
+			Name: "qemu",
+			Type: format,
+		},
+		Target: &DomainDiskTarget{
+			Dev: unusedDiskTargetName(used, bus),
+			Bus: bus,
+		},
+	}
+	if strings.HasPrefix(source, "/dev/") {
+		disk.Source = &DomainDiskSource{
+			Block: &DomainDiskSourceBlock{Dev: source},
+		}
+	} else if source != "" {
+		disk.Source = &DomainDiskSource{
+			File: &DomainDiskSourceFile{File: source},
+		}
+	}
+	if device == "cdrom" {
+		disk.ReadOnly = &DomainDiskReadOnly{}
+	}
+	b.disks = append(b.disks, disk)
+}
+
+// AddDisk attaches a disk backed by a file or, if the path is
+// under /dev, a block device. Paths ending in .qcow2 are assumed
+// to be qcow2 images, anything else raw
+func (b *DomainBuilder) AddDisk(source string) *DomainBuilder {
+	format := "raw"
+	if strings.HasSuffix(source, ".qcow2") {
+		format = "qcow2"
+	}
+	b.addDisk("disk", b.profile.DiskBus, source, format)
+	return b
+}
+
+// AddCDROM attaches a CDROM drive with the given ISO image, which
+// may be empty to leave the drive without media
+func (b *DomainBuilder) AddCDROM(source string) *DomainBuilder {
+	b.addDisk("cdrom", b.profile.CDROMBus, source, "raw")
+	return b
+}
+
+// AddNetworkInterface attaches a NIC to a libvirt virtual network
+func (b *DomainBuilder) AddNetworkInterface(network string) *DomainBuilder {
+	b.interfaces = append(b.interfaces, DomainInterface{
+		Source: &DomainInterfaceSource{
+			Network: &DomainInterfaceSourceNetwork{Network: network},
+		},
+		Model: &DomainInterfaceModel{Type: "virtio"},
+	})
+	return b
+}
+
+// AddBridgeInterface attaches a NIC to a host bridge device
+func (b *DomainBuilder) AddBridgeInterface(bridge string) *DomainBuilder {
+	b.interfaces = append(b.interfaces, DomainInterface{
+		Source: &DomainInterfaceSource{
+			Bridge: &DomainInterfaceSourceBridge{Bridge: bridge},
+		},
+		Model: &DomainInterfaceModel{Type: "virtio"},
+	})
+	return b
+}
+
+// AddGraphics attaches a "vnc" or "spice" display, along with the
+// video and input devices needed to use it
+func (b *DomainBuilder) AddGraphics(typ string) *DomainBuilder {
+	if typ == "vnc" {
+		b.graphics = append(b.graphics, DomainGraphic{
+			VNC: &DomainGraphicVNC{AutoPort: "yes"},
+		})
+	} else if typ == "spice" {
+		b.graphics = append(b.graphics, DomainGraphic{
+			Spice: &DomainGraphicSpice{AutoPort: "yes"},
+		})
+	} else if b.err == nil {
+		b.err = fmt.Errorf("Unsupported graphics type '%s'", typ)
+	}
+	return b
+}
+
+func (b *DomainBuilder) hasDiskBus(bus string) bool {
+	for _, disk := range b.disks {
+		if disk.Target.Bus == bus {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *DomainBuilder) controllers() []DomainController {
+	var controllers []DomainController
+	add := func(typ, model string) {
+		index := uint(0)
+		controllers = append(controllers, DomainController{
+			Type:  typ,
+			Index: &index,
+			Model: model,
+		})
+	}
+
+	if b.profile.PCIRoot != "" {
+		add("pci", b.profile.PCIRoot)
+	}
+	if b.profile.USBModel != "" {
+		add("usb", b.profile.USBModel)
+	}
+	if b.hasDiskBus("sata") {
+		add("sata", "")
+	}
+	if b.hasDiskBus("scsi") {
+		add("scsi", "virtio-scsi")
+	}
+	add("virtio-serial", "")
+	return controllers
+}
+
+func (b *DomainBuilder) devices() *DomainDeviceList {
+	p := &b.profile
+	serialPort := uint(0)
+	consolePort := uint(0)
+	devs := &DomainDeviceList{
+		Controllers: b.controllers(),
+		Channels: []DomainChannel{
+			DomainChannel{
+				Source: &DomainChardevSource{
+					UNIX: &DomainChardevSourceUNIX{Mode: "bind"},
+				},
+				Target: &DomainChannelTarget{
+					VirtIO: &DomainChannelTargetVirtIO{
+						Name: "org.qemu.guest_agent.0",
+					},
+				},
+			},
+		},
+		MemBalloon: &DomainMemBalloon{
+			Model: "virtio",
+		},
+		RNGs: []DomainRNG{
+			DomainRNG{
+				Model: "virtio",
+				Backend: &DomainRNGBackend{
+					Random: &DomainRNGBackendRandom{Device: "/dev/urandom"},
+				},
+			},
+		},
+	}
+	// Clone the devices added to the builder, so that later
+	// changes to either do not affect the other
+	for i := range b.disks {
+		devs.Disks = append(devs.Disks, *b.disks[i].Clone())
+	}
+	for i := range b.interfaces {
+		devs.Interfaces = append(devs.Interfaces, *b.interfaces[i].Clone())
+	}
+
+	if p.SerialType != "" {
+		devs.Serials = []DomainSerial{
+			DomainSerial{
+				Source: &DomainChardevSource{
+					Pty: &DomainChardevSourcePty{},
+				},
+				Target: &DomainSerialTarget{
+					Type: p.SerialType,
+					Port: &serialPort,
+					Model: &DomainSerialTargetModel{
+						Name: p.SerialModel,
+					},
+				},
+			},
+		}
+		devs.Consoles = []DomainConsole{
+			DomainConsole{
+				Source: &DomainChardevSource{
+					Pty: &DomainChardevSourcePty{},
+				},
+				Target: &DomainConsoleTarget{
+					Type: "serial",
+					Port: &consolePort,
+				},
+			},
+		}
+	} else {
+		devs.Consoles = []DomainConsole{
+			DomainConsole{
+				Source: &DomainChardevSource{
+					Pty: &DomainChardevSourcePty{},
+				},
+				Target: &DomainConsoleTarget{
+					Type: p.ConsoleType,
+					Port: &consolePort,
+				},
+			},
+		}
+	}
+
+	if len(b.graphics) > 0 {
+		devs.Inputs = []DomainInput{
+			DomainInput{Type: "tablet", Bus: p.InputBus},
+		}
+		if p.Keyboard {
+			devs.Inputs = append(devs.Inputs,
+				DomainInput{Type: "keyboard", Bus: p.InputBus})
+		}
+		for i := range b.graphics {
+			devs.Graphics = append(devs.Graphics, *b.graphics[i].Clone())
+		}
+		devs.Videos = []DomainVideo{
+			DomainVideo{
+				Model: DomainVideoModel{
+					Type:    p.VideoModel,
+					Heads:   1,
+					Primary: "yes",
+				},
+			},
+		}
+	}
+
+	return devs
+}
+
+func (b *DomainBuilder) features() *DomainFeatureList {
+	p := &b.profile
+	if !p.ACPI && !p.APIC && !p.GIC {
+		return nil
+	}
+	features := &DomainFeatureList{}
+	if p.ACPI {
+		features.ACPI = &DomainFeature{}
+	}
+	if p.APIC {
+		features.APIC = &DomainFeatureAPIC{}
+	}
+	if p.GIC {
+		features.GIC = &DomainFeatureGIC{}
+	}
+	return features
+}
+
+// Build returns a new Domain reflecting the builder's settings.
+// The builder may continue to be used afterwards
+func (b *DomainBuilder) Build() (*Domain, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	p := &b.profile
+	dom := &Domain{
+		Type: b.typ,
+		Name: b.name,
+		UUID: b.uuid,
+		Memory: &DomainMemory{
+			Value: b.memory,
+			Unit:  b.memoryUnit,
+		},
+		CurrentMemory: &DomainCurrentMemory{
+			Value: b.memory,
+			Unit:  b.memoryUnit,
+		},
+		VCPU: &DomainVCPU{
+			Placement: "static",
+			Value:     b.vcpus,
+		},
+		OS: &DomainOS{
+			Type: &DomainOSType{
+				Arch:    p.Arch,
+				Machine: p.Machine,
+				Type:    "hvm",
+			},
+			Firmware: p.Firmware,
+		},
+		Features: b.features(),
+		Clock: &DomainClock{
+			Offset: "utc",
+		},
+		OnPoweroff: "destroy",
+		OnReboot:   "restart",
+		OnCrash:    "destroy",
+		Devices:    b.devices(),
+	}
+	for i := range p.Timers {
+		dom.Clock.Timer = append(dom.Clock.Timer, *p.Timers[i].Clone())
+	}
+	if b.cpuMode != "" {
+		dom.CPU = &DomainCPU{Mode: b.cpuMode}
+	}
+
+	if err := dom.Validate(); err != nil {
+		return nil, err
+	}
+	return dom, nil
+}
@@ -0,0 +1,249 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDomainBuilderQ35(t *testing.T) {
+	dom, err := NewDomainBuilder("demo", DomainProfileX86_64Q35).
+		Memory(2, "GiB").
+		VCPUs(2).
+		AddDisk("/var/lib/libvirt/images/demo.qcow2").
+		AddCDROM("").
+		AddNetworkInterface("default").
+		AddGraphics("vnc").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := dom.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := strings.Join([]string{
+		`<domain type="kvm">`,
+		`  <name>demo</name>`,
+		`  <memory unit="GiB">2</memory>`,
+		`  <currentMemory unit="GiB">2</currentMemory>`,
+		`  <vcpu placement="static">2</vcpu>`,
+		`  <os>`,
+		`    <type arch="x86_64" machine="q35">hvm</type>`,
+		`  </os>`,
+		`  <features>`,
+		`    <acpi></acpi>`,
+		`    <apic></apic>`,
+		`  </features>`,
+		`  <cpu mode="host-model"></cpu>`,
+		`  <clock offset="utc">`,
+		`    <timer name="rtc" tickpolicy="catchup"></timer>`,
+		`    <timer name="pit" tickpolicy="delay"></timer>`,
+		`    <timer name="hpet" present="no"></timer>`,
+		`  </clock>`,
+		`  <on_poweroff>destroy</on_poweroff>`,
+		`  <on_reboot>restart</on_reboot>`,
+		`  <on_crash>destroy</on_crash>`,
+		`  <devices>`,
+		`    <disk type="file" device="disk">`,
+		`      <driver name="qemu" type="qcow2"></driver>`,
+		`      <source file="/var/lib/libvirt/images/demo.qcow2"></source>`,
+		`      <target dev="vda" bus="virtio"></target>`,
+		`    </disk>`,
+		`    <disk device="cdrom">`,
+		`      <driver name="qemu" type="raw"></driver>`,
+		`      <target dev="sda" bus="sata"></target>`,
+		`      <readonly></readonly>`,
+		`    </disk>`,
+		`    <controller type="pci" index="0" model="pcie-root"></controller>`,
+		`    <controller type="usb" index="0" model="qemu-xhci"></controller>`,
+		`    <controller type="sata" index="0"></controller>`,
+		`    <controller type="virtio-serial" index="0"></controller>`,
+		`    <interface type="network">`,
+		`      <source network="default"></source>`,
+		`      <model type="virtio"></model>`,
+		`    </interface>`,
+		`    <serial type="pty">`,
+		`      <target type="isa-serial" port="0">`,
+		`        <model name="isa-serial"></model>`,
+		`      </target>`,
+		`    </serial>`,
+		`    <console type="pty">`,
+		`      <target type="serial" port="0"></target>`,
+		`    </console>`,
+		`    <channel type="unix">`,
+		`      <source mode="bind"></source>`,
+		`      <target type="virtio" name="org.qemu.guest_agent.0"></target>`,
+		`    </channel>`,
+		`    <input type="tablet" bus="usb"></input>`,
+		`    <graphics type="vnc" autoport="yes"></graphics>`,
+		`    <video>`,
+		`      <model type="virtio" heads="1" primary="yes"></model>`,
+		`    </video>`,
+		`    <memballoon model="virtio"></memballoon>`,
+		`    <rng model="virtio">`,
+		`      <backend model="random">/dev/urandom</backend>`,
+		`    </rng>`,
+		`  </devices>`,
+		`</domain>`,
+	}, "\n")
+	if doc != expect {
+		t.Fatal("Bad xml:\n", doc, "\n does not match\n", expect, "\n")
+	}
+}
+
+func TestDomainBuilderProfiles(t *testing.T) {
+	var tests = []struct {
+		Profile  DomainProfile
+		Expected []string
+	}{
+		{
+			Profile: DomainProfileAArch64Virt,
+			Expected: []string{
+				`<os firmware="efi">`,
+				`<type arch="aarch64" machine="virt">hvm</type>`,
+				`<gic></gic>`,
+				`<cpu mode="host-passthrough"></cpu>`,
+				`<target dev="sda" bus="scsi"></target>`,
+				`<controller type="scsi" index="0" model="virtio-scsi"></controller>`,
+				`<model name="pl011"></model>`,
+				`<input type="keyboard" bus="usb"></input>`,
+			},
+		},
+		{
+			Profile: DomainProfilePPC64PSeries,
+			Expected: []string{
+				`<type arch="ppc64" machine="pseries">hvm</type>`,
+				`<controller type="pci" index="0" model="pci-root"></controller>`,
+				`<target type="spapr-vio-serial" port="0">`,
+				`<model type="vga" heads="1" primary="yes"></model>`,
+			},
+		},
+		{
+			Profile: DomainProfileS390XCCW,
+			Expected: []string{
+				`<type arch="s390x" machine="s390-ccw-virtio">hvm</type>`,
+				`<controller type="usb" index="0" model="none"></controller>`,
+				`<target type="sclp" port="0"></target>`,
+				`<input type="tablet" bus="virtio"></input>`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		dom, err := NewDomainBuilder("demo", test.Profile).
+			AddDisk("/dev/sdb").
+			AddCDROM("/tmp/install.iso").
+			AddBridgeInterface("br0").
+			AddGraphics("spice").
+			Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		doc, err := dom.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range test.Expected {
+			if !strings.Contains(doc, want) {
+				t.Errorf("%s: missing '%s' in xml:\n%s", test.Profile.Machine, want, doc)
+			}
+		}
+	}
+}
+
+func TestDomainBuilderReuse(t *testing.T) {
+	builder := NewDomainBuilder("demo", DomainProfileX86_64Q35).
+		AddDisk("/var/lib/libvirt/images/demo.qcow2").
+		AddNetworkInterface("default").
+		AddGraphics("vnc")
+
+	dom, err := builder.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect, err := dom.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dom.Devices.Disks[0].Source.File.File = "/tmp/other.qcow2"
+	dom.Devices.Interfaces[0].Source.Network.Network = "other"
+	dom.Devices.Graphics[0].VNC.Port = 5901
+	dom.Clock.Timer[0].TickPolicy = "merge"
+
+	dom, err = builder.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := dom.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc != expect {
+		t.Fatal("Bad rebuilt xml:\n", doc, "\n does not match\n", expect, "\n")
+	}
+}
+
+func TestDomainBuilderError(t *testing.T) {
+	_, err := NewDomainBuilder("demo", DomainProfileX86_64Q35).
+		AddGraphics("rdp").
+		Build()
+	if err == nil {
+		t.Fatal("Expected error for unsupported graphics type")
+	}
+
+	_, err = NewDomainBuilder("", DomainProfileX86_64Q35).Build()
+	if err == nil {
+		t.Fatal("Expected error for missing name")
+	}
+}
+
+func TestDiskTargetName(t *testing.T) {
+	var tests = []struct {
+		Index    int
+		Expected string
+	}{
+		{0, "vda"},
+		{25, "vdz"},
+		{26, "vdaa"},
+		{51, "vdaz"},
+		{52, "vdba"},
+		{701, "vdzz"},
+		{702, "vdaaa"},
+	}
+
+	for _, test := range tests {
+		name := diskTargetName("vd", test.Index)
+		if name != test.Expected {
+			t.Errorf("Index %d gave '%s' not '%s'", test.Index, name, test.Expected)
+		}
+	}
+}
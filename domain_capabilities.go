@@ -147,6 +147,10 @@ func (c *DomainCaps) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), c)
 }
 
+func (c *DomainCaps) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(c, doc)
+}
+
 func (c *DomainCaps) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(c, "", "  ")
 	if err != nil {
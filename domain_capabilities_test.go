@@ -0,0 +1,196 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"testing"
+)
+
+var domainCapsValidateTestCaps = &DomainCaps{
+	Domain: "kvm",
+	Arch:   "x86_64",
+	VCPU: &DomainCapsVCPU{
+		Max: 255,
+	},
+	OS: &DomainCapsOS{
+		Supported: "yes",
+		Enums: []DomainCapsEnum{
+			DomainCapsEnum{Name: "firmware", Values: []string{"efi"}},
+		},
+		Loader: &DomainCapsOSLoader{
+			Supported: "yes",
+			Enums: []DomainCapsEnum{
+				DomainCapsEnum{Name: "type", Values: []string{"rom", "pflash"}},
+				DomainCapsEnum{Name: "secure", Values: []string{"no"}},
+			},
+		},
+	},
+	CPU: &DomainCapsCPU{
+		Modes: []DomainCapsCPUMode{
+			DomainCapsCPUMode{Name: "host-passthrough", Supported: "yes"},
+			DomainCapsCPUMode{Name: "host-model", Supported: "no"},
+			DomainCapsCPUMode{
+				Name:      "custom",
+				Supported: "yes",
+				Models: []DomainCapsCPUModel{
+					DomainCapsCPUModel{Name: "Skylake-Client", Usable: "yes"},
+					DomainCapsCPUModel{Name: "EPYC", Usable: "no"},
+				},
+			},
+		},
+	},
+	Devices: &DomainCapsDevices{
+		Disk: &DomainCapsDevice{
+			Supported: "yes",
+			Enums: []DomainCapsEnum{
+				DomainCapsEnum{Name: "diskDevice", Values: []string{"disk", "cdrom"}},
+				DomainCapsEnum{Name: "bus", Values: []string{"ide", "scsi", "virtio", "sata"}},
+			},
+		},
+		Graphics: &DomainCapsDevice{
+			Supported: "yes",
+			Enums: []DomainCapsEnum{
+				DomainCapsEnum{Name: "type", Values: []string{"sdl", "vnc"}},
+			},
+		},
+		Video: &DomainCapsDevice{
+			Supported: "yes",
+			Enums: []DomainCapsEnum{
+				DomainCapsEnum{Name: "modelType", Values: []string{"vga", "virtio"}},
+			},
+		},
+		RNG: &DomainCapsDevice{
+			Supported: "no",
+		},
+	},
+	Features: &DomainCapsFeatures{
+		GenID: &DomainCapsFeatureGenID{
+			Supported: "no",
+		},
+	},
+}
+
+func TestDomainCapsValidate(t *testing.T) {
+	dom := &Domain{
+		Type: "kvm",
+		VCPU: &DomainVCPU{
+			Value: 4,
+		},
+		OS: &DomainOS{
+			Type: &DomainOSType{
+				Arch: "x86_64",
+				Type: "hvm",
+			},
+			Loader: &DomainLoader{
+				Type: "pflash",
+			},
+		},
+		CPU: &DomainCPU{
+			Mode: "host-passthrough",
+		},
+		Devices: &DomainDeviceList{
+			Disks: []DomainDisk{
+				DomainDisk{
+					Device: "disk",
+					Target: &DomainDiskTarget{
+						Dev: "vda",
+						Bus: "virtio",
+					},
+				},
+			},
+			Graphics: []DomainGraphic{
+				DomainGraphic{
+					VNC: &DomainGraphicVNC{},
+				},
+			},
+			Videos: []DomainVideo{
+				DomainVideo{
+					Model: DomainVideoModel{
+						Type: "virtio",
+					},
+				},
+			},
+		},
+	}
+
+	issues := domainCapsValidateTestCaps.Validate(dom)
+	if len(issues) != 0 {
+		t.Fatalf("Unexpected validation issues %v", issues)
+	}
+
+	dom.VCPU.Value = 512
+	dom.OS.Firmware = "bios"
+	dom.OS.Loader.Secure = "yes"
+	dom.CPU = &DomainCPU{
+		Model: &DomainCPUModel{
+			Value: "EPYC",
+		},
+	}
+	dom.Devices.Disks[0].Target.Bus = "usb"
+	dom.Devices.Graphics[0] = DomainGraphic{
+		Spice: &DomainGraphicSpice{},
+	}
+	dom.Devices.Videos[0].Model.Type = "qxl"
+	dom.Devices.RNGs = []DomainRNG{
+		DomainRNG{
+			Model: "virtio",
+		},
+	}
+	dom.GenID = &DomainGenID{}
+
+	issues = domainCapsValidateTestCaps.Validate(dom)
+	expect := []string{
+		"/domain/vcpu",
+		"/domain/os/@firmware",
+		"/domain/os/loader/@secure",
+		"/domain/cpu/model",
+		"/domain/devices/disk[0]/target/@bus",
+		"/domain/devices/graphics[0]/@type",
+		"/domain/devices/video[0]/model/@type",
+		"/domain/devices/rng[0]",
+		"/domain/genid",
+	}
+	if len(issues) != len(expect) {
+		t.Fatalf("Expected %d issues, got %v", len(expect), issues)
+	}
+	for i, issue := range issues {
+		if issue.Path != expect[i] {
+			t.Fatalf("Expected issue at '%s', got '%s'", expect[i], issue)
+		}
+	}
+
+	dom.CPU.Mode = "host-model"
+	issues = domainCapsValidateTestCaps.Validate(dom)
+	found := false
+	for _, issue := range issues {
+		if issue.Path == "/domain/cpu/@mode" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("Expected unsupported CPU mode to be reported")
+	}
+}
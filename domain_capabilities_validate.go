@@ -0,0 +1,353 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidationIssue describes a single value in a Domain which is
+// not permitted by the DomainCaps it was checked against. Path
+// is an XPath-like location of the offending element or attribute
+type ValidationIssue struct {
+	Path    string
+	Value   string
+	Allowed []string
+	Message string
+}
+
+func (i ValidationIssue) String() string {
+	if len(i.Allowed) > 0 {
+		return fmt.Sprintf("%s: %s (allowed: %s)", i.Path, i.Message, strings.Join(i.Allowed, ", "))
+	}
+	return fmt.Sprintf("%s: %s", i.Path, i.Message)
+}
+
+type domainCapsValidator struct {
+	issues []ValidationIssue
+}
+
+func (v *domainCapsValidator) report(path, value, message string, allowed []string) {
+	v.issues = append(v.issues, ValidationIssue{
+		Path:    path,
+		Value:   value,
+		Allowed: allowed,
+		Message: message,
+	})
+}
+
+func (v *domainCapsValidator) checkEnum(path string, enums []DomainCapsEnum, name, value string) {
+	if value == "" {
+		return
+	}
+	for _, enum := range enums {
+		if enum.Name != name {
+			continue
+		}
+		for _, allowed := range enum.Values {
+			if allowed == value {
+				return
+			}
+		}
+		v.report(path, value,
+			fmt.Sprintf("value '%s' is not supported", value), enum.Values)
+		return
+	}
+}
+
+func (v *domainCapsValidator) checkSupported(path, supported string) bool {
+	if supported == "no" {
+		v.report(path, "", "not supported", nil)
+		return false
+	}
+	return true
+}
+
+func domainGraphicType(g *DomainGraphic) string {
+	if g.SDL != nil {
+		return "sdl"
+	} else if g.VNC != nil {
+		return "vnc"
+	} else if g.RDP != nil {
+		return "rdp"
+	} else if g.Desktop != nil {
+		return "desktop"
+	} else if g.Spice != nil {
+		return "spice"
+	} else if g.EGLHeadless != nil {
+		return "egl-headless"
+	}
+	return ""
+}
+
+func domainHostdevModeType(h *DomainHostdev) (string, string) {
+	if h.SubsysUSB != nil {
+		return "subsystem", "usb"
+	} else if h.SubsysSCSI != nil {
+		return "subsystem", "scsi"
+	} else if h.SubsysSCSIHost != nil {
+		return "subsystem", "scsi_host"
+	} else if h.SubsysPCI != nil {
+		return "subsystem", "pci"
+	} else if h.SubsysMDev != nil {
+		return "subsystem", "mdev"
+	} else if h.CapsStorage != nil {
+		return "capabilities", "storage"
+	} else if h.CapsMisc != nil {
+		return "capabilities", "misc"
+	} else if h.CapsNet != nil {
+		return "capabilities", "net"
+	}
+	return "", ""
+}
+
+func domainRNGBackendModel(b *DomainRNGBackend) string {
+	if b.Random != nil {
+		return "random"
+	} else if b.EGD != nil {
+		return "egd"
+	} else if b.BuiltIn != nil {
+		return "builtin"
+	}
+	return ""
+}
+
+func (v *domainCapsValidator) checkOS(c *DomainCaps, os *DomainOS) {
+	if c.OS == nil {
+		return
+	}
+	if !v.checkSupported("/domain/os", c.OS.Supported) {
+		return
+	}
+	v.checkEnum("/domain/os/@firmware", c.OS.Enums, "firmware", os.Firmware)
+	if os.Loader == nil || c.OS.Loader == nil {
+		return
+	}
+	if !v.checkSupported("/domain/os/loader", c.OS.Loader.Supported) {
+		return
+	}
+	v.checkEnum("/domain/os/loader/@type", c.OS.Loader.Enums, "type", os.Loader.Type)
+	v.checkEnum("/domain/os/loader/@readonly", c.OS.Loader.Enums, "readonly", os.Loader.Readonly)
+	v.checkEnum("/domain/os/loader/@secure", c.OS.Loader.Enums, "secure", os.Loader.Secure)
+}
+
+func (v *domainCapsValidator) checkCPU(c *DomainCaps, cpu *DomainCPU) {
+	if c.CPU == nil {
+		return
+	}
+	mode := cpu.Mode
+	if mode == "" {
+		if cpu.Model == nil {
+			return
+		}
+		mode = "custom"
+	}
+
+	var capsMode *DomainCapsCPUMode
+	var modes []string
+	for i := range c.CPU.Modes {
+		if c.CPU.Modes[i].Supported == "yes" {
+			modes = append(modes, c.CPU.Modes[i].Name)
+		}
+		if c.CPU.Modes[i].Name == mode {
+			capsMode = &c.CPU.Modes[i]
+		}
+	}
+	if capsMode == nil || capsMode.Supported != "yes" {
+		v.report("/domain/cpu/@mode", mode,
+			fmt.Sprintf("CPU mode '%s' is not supported", mode), modes)
+		return
+	}
+
+	if mode != "custom" || cpu.Model == nil || cpu.Model.Value == "" || len(capsMode.Models) == 0 {
+		return
+	}
+	var models []string
+	for _, model := range capsMode.Models {
+		models = append(models, model.Name)
+		if model.Name != cpu.Model.Value {
+			continue
+		}
+		if model.Usable == "no" {
+			v.report("/domain/cpu/model", cpu.Model.Value,
+				fmt.Sprintf("CPU model '%s' is not usable on this host", cpu.Model.Value), nil)
+		}
+		return
+	}
+	v.report("/domain/cpu/model", cpu.Model.Value,
+		fmt.Sprintf("CPU model '%s' is not supported", cpu.Model.Value), models)
+}
+
+func (v *domainCapsValidator) checkDevices(c *DomainCaps, devices *DomainDeviceList) {
+	if c.Devices == nil {
+		return
+	}
+
+	if caps := c.Devices.Disk; caps != nil {
+		for i, disk := range devices.Disks {
+			path := fmt.Sprintf("/domain/devices/disk[%d]", i)
+			if !v.checkSupported(path, caps.Supported) {
+				continue
+			}
+			v.checkEnum(path+"/@device", caps.Enums, "diskDevice", disk.Device)
+			v.checkEnum(path+"/@model", caps.Enums, "model", disk.Model)
+			if disk.Target != nil {
+				v.checkEnum(path+"/target/@bus", caps.Enums, "bus", disk.Target.Bus)
+			}
+		}
+	}
+
+	if caps := c.Devices.Graphics; caps != nil {
+		for i := range devices.Graphics {
+			path := fmt.Sprintf("/domain/devices/graphics[%d]", i)
+			if !v.checkSupported(path, caps.Supported) {
+				continue
+			}
+			v.checkEnum(path+"/@type", caps.Enums, "type", domainGraphicType(&devices.Graphics[i]))
+		}
+	}
+
+	if caps := c.Devices.Video; caps != nil {
+		for i, video := range devices.Videos {
+			path := fmt.Sprintf("/domain/devices/video[%d]", i)
+			if !v.checkSupported(path, caps.Supported) {
+				continue
+			}
+			v.checkEnum(path+"/model/@type", caps.Enums, "modelType", video.Model.Type)
+		}
+	}
+
+	if caps := c.Devices.HostDev; caps != nil {
+		for i := range devices.Hostdevs {
+			hostdev := &devices.Hostdevs[i]
+			path := fmt.Sprintf("/domain/devices/hostdev[%d]", i)
+			if !v.checkSupported(path, caps.Supported) {
+				continue
+			}
+			mode, typ := domainHostdevModeType(hostdev)
+			v.checkEnum(path+"/@mode", caps.Enums, "mode", mode)
+			if mode == "subsystem" {
+				v.checkEnum(path+"/@type", caps.Enums, "subsysType", typ)
+			} else {
+				v.checkEnum(path+"/@type", caps.Enums, "capsType", typ)
+			}
+			if hostdev.SubsysPCI != nil && hostdev.SubsysPCI.Driver != nil {
+				v.checkEnum(path+"/driver/@name", caps.Enums, "pciBackend", hostdev.SubsysPCI.Driver.Name)
+			}
+		}
+	}
+
+	if caps := c.Devices.RNG; caps != nil {
+		for i, rng := range devices.RNGs {
+			path := fmt.Sprintf("/domain/devices/rng[%d]", i)
+			if !v.checkSupported(path, caps.Supported) {
+				continue
+			}
+			v.checkEnum(path+"/@model", caps.Enums, "model", rng.Model)
+			if rng.Backend != nil {
+				v.checkEnum(path+"/backend/@model", caps.Enums, "backendModel", domainRNGBackendModel(rng.Backend))
+			}
+		}
+	}
+
+	if caps := c.Devices.FileSystem; caps != nil {
+		for i, fs := range devices.Filesystems {
+			path := fmt.Sprintf("/domain/devices/filesystem[%d]", i)
+			if !v.checkSupported(path, caps.Supported) {
+				continue
+			}
+			if fs.Driver != nil {
+				v.checkEnum(path+"/driver/@type", caps.Enums, "driverType", fs.Driver.Type)
+			}
+		}
+	}
+}
+
+func (v *domainCapsValidator) checkFeatures(c *DomainCaps, dom *Domain) {
+	if c.Features == nil {
+		return
+	}
+	features := dom.Features
+	if features != nil {
+		if features.GIC != nil && c.Features.GIC != nil {
+			if v.checkSupported("/domain/features/gic", c.Features.GIC.Supported) {
+				v.checkEnum("/domain/features/gic/@version", c.Features.GIC.Enums, "version", features.GIC.Version)
+			}
+		}
+		if features.VMCoreInfo != nil && c.Features.VMCoreInfo != nil {
+			v.checkSupported("/domain/features/vmcoreinfo", c.Features.VMCoreInfo.Supported)
+		}
+	}
+	if dom.GenID != nil && c.Features.GenID != nil {
+		v.checkSupported("/domain/genid", c.Features.GenID.Supported)
+	}
+	if dom.LaunchSecurity != nil && dom.LaunchSecurity.SEV != nil {
+		if c.Features.SEV == nil {
+			v.report("/domain/launchSecurity", "sev", "not supported", nil)
+		} else {
+			v.checkSupported("/domain/launchSecurity", c.Features.SEV.Supported)
+		}
+	}
+}
+
+// Validate checks the domain configuration against the values
+// advertised in the domain capabilities, returning an issue for
+// every value which libvirt would be expected to reject. Values
+// for which the capabilities do not report an enum are not checked
+func (c *DomainCaps) Validate(dom *Domain) []ValidationIssue {
+	v := &domainCapsValidator{}
+
+	if c.Domain != "" && dom.Type != "" && dom.Type != c.Domain {
+		v.report("/domain/@type", dom.Type,
+			fmt.Sprintf("domain type '%s' does not match capabilities type '%s'", dom.Type, c.Domain),
+			[]string{c.Domain})
+	}
+	if c.VCPU != nil && c.VCPU.Max != 0 && dom.VCPU != nil && dom.VCPU.Value > c.VCPU.Max {
+		v.report("/domain/vcpu", fmt.Sprintf("%d", dom.VCPU.Value),
+			fmt.Sprintf("vCPU count %d exceeds maximum %d", dom.VCPU.Value, c.VCPU.Max), nil)
+	}
+	if c.IOThreads != nil && dom.IOThreads != 0 {
+		v.checkSupported("/domain/iothreads", c.IOThreads.Supported)
+	}
+	if dom.OS != nil {
+		if dom.OS.Type != nil && c.Arch != "" && dom.OS.Type.Arch != "" && dom.OS.Type.Arch != c.Arch {
+			v.report("/domain/os/type/@arch", dom.OS.Type.Arch,
+				fmt.Sprintf("architecture '%s' does not match capabilities architecture '%s'", dom.OS.Type.Arch, c.Arch),
+				[]string{c.Arch})
+		}
+		v.checkOS(c, dom.OS)
+	}
+	if dom.CPU != nil {
+		v.checkCPU(c, dom.CPU)
+	}
+	if dom.Devices != nil {
+		v.checkDevices(c, dom.Devices)
+	}
+	v.checkFeatures(c, dom)
+
+	return v.issues
+}
@@ -0,0 +1,437 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"strings"
+)
+
+func (d *Domain) archMachine() (string, string) {
+	if d.OS == nil || d.OS.Type == nil {
+		return "", ""
+	}
+	return d.OS.Type.Arch, d.OS.Type.Machine
+}
+
+func isX86Arch(arch string) bool {
+	return arch == "" || arch == "x86_64" || arch == "i686"
+}
+
+func isQ35Machine(machine string) bool {
+	return machine == "q35" || strings.HasPrefix(machine, "pc-q35")
+}
+
+// defaultPCIRootModel returns the model of the PCI root controller
+// libvirt adds for the machine, or the empty string if none is added
+func defaultPCIRootModel(arch, machine string) string {
+	if strings.HasPrefix(arch, "s390") {
+		return ""
+	}
+	if isQ35Machine(machine) || strings.HasPrefix(machine, "virt") {
+		return "pcie-root"
+	}
+	return "pci-root"
+}
+
+func defaultUSBModel(arch, machine string) string {
+	switch {
+	case strings.HasPrefix(arch, "s390"):
+		return "none"
+	case strings.HasPrefix(arch, "ppc64"):
+		return "pci-ohci"
+	case isX86Arch(arch) && !isQ35Machine(machine):
+		return "piix3-uhci"
+	}
+	return "qemu-xhci"
+}
+
+func defaultSCSIModel(arch string) string {
+	switch {
+	case strings.HasPrefix(arch, "ppc64"):
+		return "ibmvscsi"
+	case isX86Arch(arch):
+		return "lsilogic"
+	}
+	return "virtio-scsi"
+}
+
+func defaultSerialTarget(arch string) (string, string) {
+	switch {
+	case strings.HasPrefix(arch, "s390"):
+		return "sclp-serial", "sclpconsole"
+	case strings.HasPrefix(arch, "ppc64"):
+		return "spapr-vio-serial", "spapr-vty"
+	case arch == "aarch64" || strings.HasPrefix(arch, "arm"):
+		return "system-serial", "pl011"
+	}
+	return "isa-serial", "isa-serial"
+}
+
+func defaultVideoModel(arch string) string {
+	if isX86Arch(arch) || strings.HasPrefix(arch, "ppc64") {
+		return "vga"
+	}
+	return "virtio"
+}
+
+// insertController adds a controller in the position libvirt would,
+// which is after all other controllers unless there is a controller
+// of the same type with a larger index
+func (d *DomainDeviceList) insertController(c DomainController) {
+	insertAt := -1
+	for i := len(d.Controllers) - 1; i >= 0; i-- {
+		current := &d.Controllers[i]
+		if current.Type != c.Type || current.Index == nil {
+			continue
+		}
+		if *current.Index > *c.Index {
+			insertAt = i
+		} else {
+			break
+		}
+	}
+	if insertAt < 0 {
+		d.Controllers = append(d.Controllers, c)
+		return
+	}
+	d.Controllers = append(d.Controllers, DomainController{})
+	copy(d.Controllers[insertAt+1:], d.Controllers[insertAt:])
+	d.Controllers[insertAt] = c
+}
+
+func (d *DomainDeviceList) hasController(typ string, index uint) bool {
+	for _, c := range d.Controllers {
+		if c.Type != typ {
+			continue
+		}
+		cindex := uint(0)
+		if c.Index != nil {
+			cindex = *c.Index
+		}
+		if cindex == index {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *DomainDeviceList) ensureController(typ string, index uint, model string) {
+	if d.hasController(typ, index) {
+		return
+	}
+	d.insertController(DomainController{
+		Type:  typ,
+		Index: &index,
+		Model: model,
+	})
+}
+
+func diskBusFromTarget(dev string) string {
+	switch {
+	case strings.HasPrefix(dev, "vd"):
+		return "virtio"
+	case strings.HasPrefix(dev, "sd"):
+		return "scsi"
+	case strings.HasPrefix(dev, "hd"):
+		return "ide"
+	case strings.HasPrefix(dev, "fd"):
+		return "fdc"
+	case strings.HasPrefix(dev, "xvd"):
+		return "xen"
+	}
+	return ""
+}
+
+func (d *Domain) applyMemoryDefaults() error {
+	if d.Memory != nil {
+		if err := d.Memory.ConvertTo("KiB"); err != nil {
+			return err
+		}
+	}
+	if d.MaximumMemory != nil {
+		if err := d.MaximumMemory.ConvertTo("KiB"); err != nil {
+			return err
+		}
+	}
+	if d.CurrentMemory != nil {
+		if err := d.CurrentMemory.ConvertTo("KiB"); err != nil {
+			return err
+		}
+	}
+	if d.Memory != nil {
+		if d.CurrentMemory == nil {
+			d.CurrentMemory = &DomainCurrentMemory{}
+		}
+		if d.CurrentMemory.Value == 0 || d.CurrentMemory.Value > d.Memory.Value {
+			d.CurrentMemory.Value = d.Memory.Value
+		}
+		d.CurrentMemory.Unit = "KiB"
+	}
+	return nil
+}
+
+func (d *Domain) applyControllerDefaults(arch, machine string) {
+	devs := d.Devices
+	x86 := isX86Arch(arch)
+	q35 := x86 && isQ35Machine(machine)
+
+	hasUSB := false
+	for _, c := range devs.Controllers {
+		if c.Type == "usb" {
+			hasUSB = true
+		}
+	}
+	if !hasUSB {
+		devs.ensureController("usb", 0, defaultUSBModel(arch, machine))
+	}
+	if q35 {
+		devs.ensureController("sata", 0, "")
+	}
+	if model := defaultPCIRootModel(arch, machine); model != "" {
+		devs.ensureController("pci", 0, model)
+	}
+	if x86 && !q35 {
+		devs.ensureController("ide", 0, "")
+	}
+
+	for _, disk := range devs.Disks {
+		if disk.Target == nil {
+			continue
+		}
+		index := uint(0)
+		if disk.Address != nil && disk.Address.Drive != nil &&
+			disk.Address.Drive.Controller != nil {
+			index = *disk.Address.Drive.Controller
+		}
+		switch disk.Target.Bus {
+		case "scsi":
+			devs.ensureController("scsi", index, defaultSCSIModel(arch))
+		case "sata", "ide", "fdc":
+			devs.ensureController(disk.Target.Bus, index, "")
+		}
+	}
+
+	for _, console := range devs.Consoles {
+		if console.Target != nil && console.Target.Type == "virtio" {
+			devs.ensureController("virtio-serial", 0, "")
+		}
+	}
+	for _, channel := range devs.Channels {
+		if channel.Target == nil || channel.Target.VirtIO == nil {
+			continue
+		}
+		index := uint(0)
+		if channel.Address != nil && channel.Address.VirtioSerial != nil &&
+			channel.Address.VirtioSerial.Controller != nil {
+			index = *channel.Address.VirtioSerial.Controller
+		}
+		devs.ensureController("virtio-serial", index, "")
+	}
+}
+
+func (d *Domain) applyCharDefaults(arch string) {
+	devs := d.Devices
+	targetType, targetModel := defaultSerialTarget(arch)
+	for i := range devs.Serials {
+		serial := &devs.Serials[i]
+		if serial.Target == nil {
+			serial.Target = &DomainSerialTarget{}
+		}
+		if serial.Target.Type == "" {
+			serial.Target.Type = targetType
+		}
+		if serial.Target.Model == nil && serial.Target.Type == targetType {
+			serial.Target.Model = &DomainSerialTargetModel{Name: targetModel}
+		}
+		if serial.Target.Port == nil {
+			port := uint(i)
+			serial.Target.Port = &port
+		}
+	}
+
+	if len(devs.Consoles) == 0 && len(devs.Serials) > 0 {
+		// The console is a second view of the first serial port,
+		// so shares its configuration
+		console := DomainConsole{}
+		serial := devs.Serials[0]
+		if serial.Source != nil {
+			source := *serial.Source
+			console.Source = &source
+		}
+		if serial.Protocol != nil {
+			protocol := *serial.Protocol
+			console.Protocol = &protocol
+		}
+		devs.Consoles = []DomainConsole{console}
+	}
+	for i := range devs.Consoles {
+		console := &devs.Consoles[i]
+		if console.Target == nil {
+			console.Target = &DomainConsoleTarget{}
+		}
+		if console.Target.Type == "" {
+			if i == 0 && len(devs.Serials) > 0 {
+				console.Target.Type = "serial"
+			} else if strings.HasPrefix(arch, "s390") {
+				console.Target.Type = "sclp"
+			} else {
+				console.Target.Type = "virtio"
+			}
+		}
+		if console.Target.Port == nil {
+			port := uint(0)
+			if console.Target.Type != "serial" {
+				port = uint(i)
+			}
+			console.Target.Port = &port
+		}
+	}
+}
+
+func (d *Domain) applyVideoDefaults(arch string) {
+	devs := d.Devices
+	if len(devs.Videos) == 0 && len(devs.Graphics) > 0 {
+		devs.Videos = []DomainVideo{
+			DomainVideo{Model: DomainVideoModel{Type: defaultVideoModel(arch)}},
+		}
+	}
+	for i := range devs.Videos {
+		model := &devs.Videos[i].Model
+		if model.Type == "" {
+			model.Type = defaultVideoModel(arch)
+		}
+		if model.Type == "none" {
+			continue
+		}
+		if model.Heads == 0 {
+			model.Heads = 1
+		}
+		switch model.Type {
+		case "vga", "cirrus", "bochs":
+			if model.VRam == 0 {
+				model.VRam = 16384
+			}
+		case "vmvga":
+			if model.VRam == 0 {
+				model.VRam = 4096
+			}
+		case "qxl":
+			if model.Ram == 0 {
+				model.Ram = 65536
+			}
+			if model.VRam == 0 {
+				model.VRam = 65536
+			}
+			if model.VGAMem == 0 {
+				model.VGAMem = 16384
+			}
+		}
+		if i == 0 && len(devs.Videos) > 1 && model.Primary == "" {
+			model.Primary = "yes"
+		}
+	}
+}
+
+// ApplyDefaults fills in the settings and devices libvirt adds to a
+// domain when it is defined, so that a configuration document can be
+// compared with the XML libvirt later reports. This covers implicit
+// controllers, default memballoon, consoles and video devices, memory
+// sizes normalized to KiB, and various per device defaults. The
+// defaults are those of the QEMU driver for the domain's architecture
+// and machine type
+func (d *Domain) ApplyDefaults() error {
+	arch, machine := d.archMachine()
+	if isX86Arch(arch) && machine == "" && d.OS != nil && d.OS.Type != nil {
+		machine = "pc"
+	}
+
+	if err := d.applyMemoryDefaults(); err != nil {
+		return err
+	}
+	if d.VCPU == nil {
+		d.VCPU = &DomainVCPU{Value: 1}
+	}
+	if d.VCPU.Placement == "" && d.VCPU.CPUSet == "" {
+		d.VCPU.Placement = "static"
+	}
+	if d.VCPU.Current == d.VCPU.Value {
+		d.VCPU.Current = 0
+	}
+	if d.Clock == nil {
+		d.Clock = &DomainClock{}
+	}
+	if d.Clock.Offset == "" {
+		d.Clock.Offset = "utc"
+	}
+	if d.OnPoweroff == "" {
+		d.OnPoweroff = "destroy"
+	}
+	if d.OnReboot == "" {
+		d.OnReboot = "restart"
+	}
+	if d.OnCrash == "" {
+		d.OnCrash = "destroy"
+	}
+
+	if d.Devices == nil {
+		d.Devices = &DomainDeviceList{}
+	}
+	devs := d.Devices
+
+	for i := range devs.Disks {
+		target := devs.Disks[i].Target
+		if target != nil && target.Bus == "" {
+			target.Bus = diskBusFromTarget(target.Dev)
+		}
+	}
+
+	d.applyControllerDefaults(arch, machine)
+	d.applyCharDefaults(arch)
+
+	if isX86Arch(arch) && len(devs.Graphics) > 0 {
+		hasMouse, hasKeyboard := false, false
+		for _, input := range devs.Inputs {
+			if input.Bus == "ps2" && input.Type == "mouse" {
+				hasMouse = true
+			} else if input.Bus == "ps2" && input.Type == "keyboard" {
+				hasKeyboard = true
+			}
+		}
+		if !hasMouse {
+			devs.Inputs = append(devs.Inputs, DomainInput{Type: "mouse", Bus: "ps2"})
+		}
+		if !hasKeyboard {
+			devs.Inputs = append(devs.Inputs, DomainInput{Type: "keyboard", Bus: "ps2"})
+		}
+	}
+
+	d.applyVideoDefaults(arch)
+
+	if devs.MemBalloon == nil {
+		devs.MemBalloon = &DomainMemBalloon{Model: "virtio"}
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDomainApplyDefaults(t *testing.T) {
+	dom := &Domain{}
+	err := dom.Unmarshal(strings.Join([]string{
+		`<domain type="kvm">`,
+		`  <name>demo</name>`,
+		`  <memory unit="GiB">1</memory>`,
+		`  <os>`,
+		`    <type arch="x86_64" machine="pc-i440fx-5.0">hvm</type>`,
+		`  </os>`,
+		`  <devices>`,
+		`    <disk type="file" device="disk">`,
+		`      <source file="/a.img"/>`,
+		`      <target dev="sda"/>`,
+		`    </disk>`,
+		`    <serial type="pty"/>`,
+		`    <channel type="unix">`,
+		`      <target type="virtio" name="org.qemu.guest_agent.0"/>`,
+		`    </channel>`,
+		`    <graphics type="vnc"/>`,
+		`  </devices>`,
+		`</domain>`,
+	}, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = dom.ApplyDefaults()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := dom.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := strings.Join([]string{
+		`<domain type="kvm">`,
+		`  <name>demo</name>`,
+		`  <memory unit="KiB">1048576</memory>`,
+		`  <currentMemory unit="KiB">1048576</currentMemory>`,
+		`  <vcpu placement="static">1</vcpu>`,
+		`  <os>`,
+		`    <type arch="x86_64" machine="pc-i440fx-5.0">hvm</type>`,
+		`  </os>`,
+		`  <clock offset="utc"></clock>`,
+		`  <on_poweroff>destroy</on_poweroff>`,
+		`  <on_reboot>restart</on_reboot>`,
+		`  <on_crash>destroy</on_crash>`,
+		`  <devices>`,
+		`    <disk type="file" device="disk">`,
+		`      <source file="/a.img"></source>`,
+		`      <target dev="sda" bus="scsi"></target>`,
+		`    </disk>`,
+		`    <controller type="usb" index="0" model="piix3-uhci"></controller>`,
+		`    <controller type="pci" index="0" model="pci-root"></controller>`,
+		`    <controller type="ide" index="0"></controller>`,
+		`    <controller type="scsi" index="0" model="lsilogic"></controller>`,
+		`    <controller type="virtio-serial" index="0"></controller>`,
+		`    <serial type="pty">`,
+		`      <target type="isa-serial" port="0">`,
+		`        <model name="isa-serial"></model>`,
+		`      </target>`,
+		`    </serial>`,
+		`    <console type="pty">`,
+		`      <target type="serial" port="0"></target>`,
+		`    </console>`,
+		`    <channel type="unix">`,
+		`      <target type="virtio" name="org.qemu.guest_agent.0"></target>`,
+		`    </channel>`,
+		`    <input type="mouse" bus="ps2"></input>`,
+		`    <input type="keyboard" bus="ps2"></input>`,
+		`    <graphics type="vnc"></graphics>`,
+		`    <video>`,
+		`      <model type="vga" heads="1" vram="16384"></model>`,
+		`    </video>`,
+		`    <memballoon model="virtio"></memballoon>`,
+		`  </devices>`,
+		`</domain>`,
+	}, "\n")
+	if doc != expect {
+		t.Fatal("Bad xml:\n", doc, "\n does not match\n", expect, "\n")
+	}
+
+	// A second pass must not change anything
+	err = dom.ApplyDefaults()
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err = dom.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc != expect {
+		t.Fatal("Bad xml after second pass:\n", doc, "\n does not match\n", expect, "\n")
+	}
+}
+
+func TestDomainApplyDefaultsQ35(t *testing.T) {
+	dom := &Domain{
+		Type: "kvm",
+		Name: "demo",
+		Memory: &DomainMemory{
+			Value: 2048,
+			Unit:  "MiB",
+		},
+		CurrentMemory: &DomainCurrentMemory{
+			Value: 4,
+			Unit:  "GB",
+		},
+		OS: &DomainOS{
+			Type: &DomainOSType{Arch: "x86_64", Machine: "q35", Type: "hvm"},
+		},
+	}
+
+	err := dom.ApplyDefaults()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if dom.Memory.Value != 2097152 || dom.CurrentMemory.Value != 2097152 {
+		t.Errorf("Unexpected memory %d current %d", dom.Memory.Value, dom.CurrentMemory.Value)
+	}
+	var controllers []string
+	for _, c := range dom.Devices.Controllers {
+		controllers = append(controllers, c.Type+"/"+c.Model)
+	}
+	expect := "usb/qemu-xhci sata/ pci/pcie-root"
+	if strings.Join(controllers, " ") != expect {
+		t.Errorf("Expected controllers '%s' got '%s'", expect, strings.Join(controllers, " "))
+	}
+}
+
+func TestMemoryToKiB(t *testing.T) {
+	var tests = []struct {
+		Value    uint
+		Unit     string
+		Expected uint
+	}{
+		{1024, "", 1024},
+		{1024, "k", 1024},
+		{1000, "KB", 977},
+		{1, "MiB", 1024},
+		{1, "MB", 977},
+		{1, "G", 1048576},
+		{1, "GB", 976563},
+		{1, "TiB", 1073741824},
+		{1025, "bytes", 2},
+	}
+
+	for _, test := range tests {
+		mem := &DomainMemory{Value: test.Value, Unit: test.Unit}
+		err := mem.ConvertTo("KiB")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mem.Value != test.Expected || mem.Unit != "KiB" {
+			t.Errorf("%d%s: expected %d KiB got %d%s", test.Value, test.Unit, test.Expected, mem.Value, mem.Unit)
+		}
+	}
+
+	mem := &DomainMemory{Value: 1, Unit: "furlongs"}
+	if err := mem.ConvertTo("KiB"); err == nil {
+		t.Error("Expected error for unknown unit")
+	}
+}
@@ -0,0 +1,674 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+// DomainType is the hypervisor driver in the domain type attribute
+type DomainType string
+
+const (
+	DomainTypeQEMU      DomainType = "qemu"
+	DomainTypeKQEMU     DomainType = "kqemu"
+	DomainTypeKVM       DomainType = "kvm"
+	DomainTypeXen       DomainType = "xen"
+	DomainTypeLXC       DomainType = "lxc"
+	DomainTypeUML       DomainType = "uml"
+	DomainTypeOpenVZ    DomainType = "openvz"
+	DomainTypeTest      DomainType = "test"
+	DomainTypeVMWare    DomainType = "vmware"
+	DomainTypeHyperV    DomainType = "hyperv"
+	DomainTypeVBox      DomainType = "vbox"
+	DomainTypePHyp      DomainType = "phyp"
+	DomainTypeParallels DomainType = "parallels"
+	DomainTypeBHyve     DomainType = "bhyve"
+	DomainTypeVZ        DomainType = "vz"
+)
+
+// IsValid reports whether v is one of the DomainType constants
+func (v DomainType) IsValid() bool {
+	switch v {
+	case DomainTypeQEMU, DomainTypeKQEMU, DomainTypeKVM, DomainTypeXen,
+		DomainTypeLXC, DomainTypeUML, DomainTypeOpenVZ, DomainTypeTest,
+		DomainTypeVMWare, DomainTypeHyperV, DomainTypeVBox,
+		DomainTypePHyp, DomainTypeParallels, DomainTypeBHyve,
+		DomainTypeVZ:
+		return true
+	}
+	return false
+}
+
+// DomainOSTypeName is the kind of guest OS in the os type element
+type DomainOSTypeName string
+
+const (
+	DomainOSTypeNameHVM    DomainOSTypeName = "hvm"
+	DomainOSTypeNameXen    DomainOSTypeName = "xen"
+	DomainOSTypeNameXenPVH DomainOSTypeName = "xenpvh"
+	DomainOSTypeNameLinux  DomainOSTypeName = "linux"
+	DomainOSTypeNameExe    DomainOSTypeName = "exe"
+	DomainOSTypeNameUML    DomainOSTypeName = "uml"
+)
+
+// IsValid reports whether v is one of the DomainOSTypeName constants
+func (v DomainOSTypeName) IsValid() bool {
+	switch v {
+	case DomainOSTypeNameHVM, DomainOSTypeNameXen,
+		DomainOSTypeNameXenPVH, DomainOSTypeNameLinux,
+		DomainOSTypeNameExe, DomainOSTypeNameUML:
+		return true
+	}
+	return false
+}
+
+// DomainLifecycleAction is an on_poweroff or on_reboot action
+type DomainLifecycleAction string
+
+const (
+	DomainLifecycleActionDestroy       DomainLifecycleAction = "destroy"
+	DomainLifecycleActionRestart       DomainLifecycleAction = "restart"
+	DomainLifecycleActionPreserve      DomainLifecycleAction = "preserve"
+	DomainLifecycleActionRenameRestart DomainLifecycleAction = "rename-restart"
+)
+
+// IsValid reports whether v is one of the DomainLifecycleAction constants
+func (v DomainLifecycleAction) IsValid() bool {
+	switch v {
+	case DomainLifecycleActionDestroy, DomainLifecycleActionRestart,
+		DomainLifecycleActionPreserve,
+		DomainLifecycleActionRenameRestart:
+		return true
+	}
+	return false
+}
+
+// DomainCrashAction is an on_crash action
+type DomainCrashAction string
+
+const (
+	DomainCrashActionDestroy         DomainCrashAction = "destroy"
+	DomainCrashActionRestart         DomainCrashAction = "restart"
+	DomainCrashActionPreserve        DomainCrashAction = "preserve"
+	DomainCrashActionRenameRestart   DomainCrashAction = "rename-restart"
+	DomainCrashActionCoredumpDestroy DomainCrashAction = "coredump-destroy"
+	DomainCrashActionCoredumpRestart DomainCrashAction = "coredump-restart"
+)
+
+// IsValid reports whether v is one of the DomainCrashAction constants
+func (v DomainCrashAction) IsValid() bool {
+	switch v {
+	case DomainCrashActionDestroy, DomainCrashActionRestart,
+		DomainCrashActionPreserve, DomainCrashActionRenameRestart,
+		DomainCrashActionCoredumpDestroy,
+		DomainCrashActionCoredumpRestart:
+		return true
+	}
+	return false
+}
+
+// DomainBootDev is a device in the os boot element
+type DomainBootDev string
+
+const (
+	DomainBootDevFD      DomainBootDev = "fd"
+	DomainBootDevHD      DomainBootDev = "hd"
+	DomainBootDevCDROM   DomainBootDev = "cdrom"
+	DomainBootDevNetwork DomainBootDev = "network"
+)
+
+// IsValid reports whether v is one of the DomainBootDev constants
+func (v DomainBootDev) IsValid() bool {
+	switch v {
+	case DomainBootDevFD, DomainBootDevHD, DomainBootDevCDROM,
+		DomainBootDevNetwork:
+		return true
+	}
+	return false
+}
+
+// DomainClockOffset is the clock offset attribute
+type DomainClockOffset string
+
+const (
+	DomainClockOffsetUTC       DomainClockOffset = "utc"
+	DomainClockOffsetLocalTime DomainClockOffset = "localtime"
+	DomainClockOffsetTimezone  DomainClockOffset = "timezone"
+	DomainClockOffsetVariable  DomainClockOffset = "variable"
+	DomainClockOffsetAbsolute  DomainClockOffset = "absolute"
+)
+
+// IsValid reports whether v is one of the DomainClockOffset constants
+func (v DomainClockOffset) IsValid() bool {
+	switch v {
+	case DomainClockOffsetUTC, DomainClockOffsetLocalTime,
+		DomainClockOffsetTimezone, DomainClockOffsetVariable,
+		DomainClockOffsetAbsolute:
+		return true
+	}
+	return false
+}
+
+// DomainCPUMode is the cpu mode attribute
+type DomainCPUMode string
+
+const (
+	DomainCPUModeCustom          DomainCPUMode = "custom"
+	DomainCPUModeHostModel       DomainCPUMode = "host-model"
+	DomainCPUModeHostPassthrough DomainCPUMode = "host-passthrough"
+)
+
+// IsValid reports whether v is one of the DomainCPUMode constants
+func (v DomainCPUMode) IsValid() bool {
+	switch v {
+	case DomainCPUModeCustom, DomainCPUModeHostModel,
+		DomainCPUModeHostPassthrough:
+		return true
+	}
+	return false
+}
+
+// DomainCPUMatch is the cpu match attribute
+type DomainCPUMatch string
+
+const (
+	DomainCPUMatchMinimum DomainCPUMatch = "minimum"
+	DomainCPUMatchExact   DomainCPUMatch = "exact"
+	DomainCPUMatchStrict  DomainCPUMatch = "strict"
+)
+
+// IsValid reports whether v is one of the DomainCPUMatch constants
+func (v DomainCPUMatch) IsValid() bool {
+	switch v {
+	case DomainCPUMatchMinimum, DomainCPUMatchExact,
+		DomainCPUMatchStrict:
+		return true
+	}
+	return false
+}
+
+// DomainCPUCheck is the cpu check attribute
+type DomainCPUCheck string
+
+const (
+	DomainCPUCheckNone    DomainCPUCheck = "none"
+	DomainCPUCheckPartial DomainCPUCheck = "partial"
+	DomainCPUCheckFull    DomainCPUCheck = "full"
+)
+
+// IsValid reports whether v is one of the DomainCPUCheck constants
+func (v DomainCPUCheck) IsValid() bool {
+	switch v {
+	case DomainCPUCheckNone, DomainCPUCheckPartial, DomainCPUCheckFull:
+		return true
+	}
+	return false
+}
+
+// DomainCPUFeaturePolicy is the policy attribute of a cpu feature
+type DomainCPUFeaturePolicy string
+
+const (
+	DomainCPUFeaturePolicyForce    DomainCPUFeaturePolicy = "force"
+	DomainCPUFeaturePolicyRequire  DomainCPUFeaturePolicy = "require"
+	DomainCPUFeaturePolicyOptional DomainCPUFeaturePolicy = "optional"
+	DomainCPUFeaturePolicyDisable  DomainCPUFeaturePolicy = "disable"
+	DomainCPUFeaturePolicyForbid   DomainCPUFeaturePolicy = "forbid"
+)
+
+// IsValid reports whether v is one of the DomainCPUFeaturePolicy constants
+func (v DomainCPUFeaturePolicy) IsValid() bool {
+	switch v {
+	case DomainCPUFeaturePolicyForce, DomainCPUFeaturePolicyRequire,
+		DomainCPUFeaturePolicyOptional, DomainCPUFeaturePolicyDisable,
+		DomainCPUFeaturePolicyForbid:
+		return true
+	}
+	return false
+}
+
+// DomainDiskDevice is the disk device attribute
+type DomainDiskDevice string
+
+const (
+	DomainDiskDeviceFloppy DomainDiskDevice = "floppy"
+	DomainDiskDeviceDisk   DomainDiskDevice = "disk"
+	DomainDiskDeviceCDROM  DomainDiskDevice = "cdrom"
+	DomainDiskDeviceLUN    DomainDiskDevice = "lun"
+)
+
+// IsValid reports whether v is one of the DomainDiskDevice constants
+func (v DomainDiskDevice) IsValid() bool {
+	switch v {
+	case DomainDiskDeviceFloppy, DomainDiskDeviceDisk,
+		DomainDiskDeviceCDROM, DomainDiskDeviceLUN:
+		return true
+	}
+	return false
+}
+
+// DomainDiskBus is the disk target bus attribute
+type DomainDiskBus string
+
+const (
+	DomainDiskBusIDE    DomainDiskBus = "ide"
+	DomainDiskBusFDC    DomainDiskBus = "fdc"
+	DomainDiskBusSCSI   DomainDiskBus = "scsi"
+	DomainDiskBusVirtIO DomainDiskBus = "virtio"
+	DomainDiskBusXen    DomainDiskBus = "xen"
+	DomainDiskBusUSB    DomainDiskBus = "usb"
+	DomainDiskBusUML    DomainDiskBus = "uml"
+	DomainDiskBusSATA   DomainDiskBus = "sata"
+	DomainDiskBusSD     DomainDiskBus = "sd"
+)
+
+// IsValid reports whether v is one of the DomainDiskBus constants
+func (v DomainDiskBus) IsValid() bool {
+	switch v {
+	case DomainDiskBusIDE, DomainDiskBusFDC, DomainDiskBusSCSI,
+		DomainDiskBusVirtIO, DomainDiskBusXen, DomainDiskBusUSB,
+		DomainDiskBusUML, DomainDiskBusSATA, DomainDiskBusSD:
+		return true
+	}
+	return false
+}
+
+// DomainDiskCache is the disk driver cache attribute
+type DomainDiskCache string
+
+const (
+	DomainDiskCacheDefault      DomainDiskCache = "default"
+	DomainDiskCacheNone         DomainDiskCache = "none"
+	DomainDiskCacheWriteThrough DomainDiskCache = "writethrough"
+	DomainDiskCacheWriteBack    DomainDiskCache = "writeback"
+	DomainDiskCacheDirectSync   DomainDiskCache = "directsync"
+	DomainDiskCacheUnsafe       DomainDiskCache = "unsafe"
+)
+
+// IsValid reports whether v is one of the DomainDiskCache constants
+func (v DomainDiskCache) IsValid() bool {
+	switch v {
+	case DomainDiskCacheDefault, DomainDiskCacheNone,
+		DomainDiskCacheWriteThrough, DomainDiskCacheWriteBack,
+		DomainDiskCacheDirectSync, DomainDiskCacheUnsafe:
+		return true
+	}
+	return false
+}
+
+// DomainDiskIO is the disk driver io attribute
+type DomainDiskIO string
+
+const (
+	DomainDiskIOThreads DomainDiskIO = "threads"
+	DomainDiskIONative  DomainDiskIO = "native"
+	DomainDiskIOIOURing DomainDiskIO = "io_uring"
+)
+
+// IsValid reports whether v is one of the DomainDiskIO constants
+func (v DomainDiskIO) IsValid() bool {
+	switch v {
+	case DomainDiskIOThreads, DomainDiskIONative, DomainDiskIOIOURing:
+		return true
+	}
+	return false
+}
+
+// DomainDiskErrorPolicy is the disk driver error_policy attribute. The rerror_policy attribute allows the same values except enospace
+type DomainDiskErrorPolicy string
+
+const (
+	DomainDiskErrorPolicyStop     DomainDiskErrorPolicy = "stop"
+	DomainDiskErrorPolicyReport   DomainDiskErrorPolicy = "report"
+	DomainDiskErrorPolicyIgnore   DomainDiskErrorPolicy = "ignore"
+	DomainDiskErrorPolicyENOSpace DomainDiskErrorPolicy = "enospace"
+)
+
+// IsValid reports whether v is one of the DomainDiskErrorPolicy constants
+func (v DomainDiskErrorPolicy) IsValid() bool {
+	switch v {
+	case DomainDiskErrorPolicyStop, DomainDiskErrorPolicyReport,
+		DomainDiskErrorPolicyIgnore, DomainDiskErrorPolicyENOSpace:
+		return true
+	}
+	return false
+}
+
+// DomainDiskDiscard is the disk driver discard attribute
+type DomainDiskDiscard string
+
+const (
+	DomainDiskDiscardUnmap  DomainDiskDiscard = "unmap"
+	DomainDiskDiscardIgnore DomainDiskDiscard = "ignore"
+)
+
+// IsValid reports whether v is one of the DomainDiskDiscard constants
+func (v DomainDiskDiscard) IsValid() bool {
+	switch v {
+	case DomainDiskDiscardUnmap, DomainDiskDiscardIgnore:
+		return true
+	}
+	return false
+}
+
+// DomainDiskDetectZeroes is the disk driver detect_zeroes attribute
+type DomainDiskDetectZeroes string
+
+const (
+	DomainDiskDetectZeroesOff   DomainDiskDetectZeroes = "off"
+	DomainDiskDetectZeroesOn    DomainDiskDetectZeroes = "on"
+	DomainDiskDetectZeroesUnmap DomainDiskDetectZeroes = "unmap"
+)
+
+// IsValid reports whether v is one of the DomainDiskDetectZeroes constants
+func (v DomainDiskDetectZeroes) IsValid() bool {
+	switch v {
+	case DomainDiskDetectZeroesOff, DomainDiskDetectZeroesOn,
+		DomainDiskDetectZeroesUnmap:
+		return true
+	}
+	return false
+}
+
+// DomainControllerType is the controller type attribute
+type DomainControllerType string
+
+const (
+	DomainControllerTypeIDE          DomainControllerType = "ide"
+	DomainControllerTypeFDC          DomainControllerType = "fdc"
+	DomainControllerTypeSCSI         DomainControllerType = "scsi"
+	DomainControllerTypeSATA         DomainControllerType = "sata"
+	DomainControllerTypeUSB          DomainControllerType = "usb"
+	DomainControllerTypePCI          DomainControllerType = "pci"
+	DomainControllerTypeVirtIOSerial DomainControllerType = "virtio-serial"
+	DomainControllerTypeCCID         DomainControllerType = "ccid"
+	DomainControllerTypeXenBus       DomainControllerType = "xenbus"
+	DomainControllerTypeISA          DomainControllerType = "isa"
+)
+
+// IsValid reports whether v is one of the DomainControllerType constants
+func (v DomainControllerType) IsValid() bool {
+	switch v {
+	case DomainControllerTypeIDE, DomainControllerTypeFDC,
+		DomainControllerTypeSCSI, DomainControllerTypeSATA,
+		DomainControllerTypeUSB, DomainControllerTypePCI,
+		DomainControllerTypeVirtIOSerial, DomainControllerTypeCCID,
+		DomainControllerTypeXenBus, DomainControllerTypeISA:
+		return true
+	}
+	return false
+}
+
+// DomainInterfaceModelType is an interface model known to libvirt's hypervisor drivers
+type DomainInterfaceModelType string
+
+const (
+	DomainInterfaceModelTypeVirtIO                DomainInterfaceModelType = "virtio"
+	DomainInterfaceModelTypeVirtIOTransitional    DomainInterfaceModelType = "virtio-transitional"
+	DomainInterfaceModelTypeVirtIONonTransitional DomainInterfaceModelType = "virtio-non-transitional"
+	DomainInterfaceModelTypeE1000                 DomainInterfaceModelType = "e1000"
+	DomainInterfaceModelTypeE1000E                DomainInterfaceModelType = "e1000e"
+	DomainInterfaceModelTypeRTL8139               DomainInterfaceModelType = "rtl8139"
+	DomainInterfaceModelTypeNE2KPCI               DomainInterfaceModelType = "ne2k_pci"
+	DomainInterfaceModelTypeNE2KISA               DomainInterfaceModelType = "ne2k_isa"
+	DomainInterfaceModelTypePCNet                 DomainInterfaceModelType = "pcnet"
+	DomainInterfaceModelTypeI82551                DomainInterfaceModelType = "i82551"
+	DomainInterfaceModelTypeI82557B               DomainInterfaceModelType = "i82557b"
+	DomainInterfaceModelTypeI82559ER              DomainInterfaceModelType = "i82559er"
+	DomainInterfaceModelTypeVMXNet3               DomainInterfaceModelType = "vmxnet3"
+	DomainInterfaceModelTypeSPAPRVLan             DomainInterfaceModelType = "spapr-vlan"
+	DomainInterfaceModelTypeUSBNet                DomainInterfaceModelType = "usb-net"
+	DomainInterfaceModelTypeNetfront              DomainInterfaceModelType = "netfront"
+	DomainInterfaceModelTypeLAN9118               DomainInterfaceModelType = "lan9118"
+	DomainInterfaceModelTypeSMC91C111             DomainInterfaceModelType = "smc91c111"
+)
+
+// IsValid reports whether v is one of the DomainInterfaceModelType constants
+func (v DomainInterfaceModelType) IsValid() bool {
+	switch v {
+	case DomainInterfaceModelTypeVirtIO,
+		DomainInterfaceModelTypeVirtIOTransitional,
+		DomainInterfaceModelTypeVirtIONonTransitional,
+		DomainInterfaceModelTypeE1000, DomainInterfaceModelTypeE1000E,
+		DomainInterfaceModelTypeRTL8139,
+		DomainInterfaceModelTypeNE2KPCI,
+		DomainInterfaceModelTypeNE2KISA, DomainInterfaceModelTypePCNet,
+		DomainInterfaceModelTypeI82551, DomainInterfaceModelTypeI82557B,
+		DomainInterfaceModelTypeI82559ER,
+		DomainInterfaceModelTypeVMXNet3,
+		DomainInterfaceModelTypeSPAPRVLan,
+		DomainInterfaceModelTypeUSBNet,
+		DomainInterfaceModelTypeNetfront,
+		DomainInterfaceModelTypeLAN9118,
+		DomainInterfaceModelTypeSMC91C111:
+		return true
+	}
+	return false
+}
+
+// DomainGraphicListenType is the graphics listen type attribute
+type DomainGraphicListenType string
+
+const (
+	DomainGraphicListenTypeAddress DomainGraphicListenType = "address"
+	DomainGraphicListenTypeNetwork DomainGraphicListenType = "network"
+	DomainGraphicListenTypeSocket  DomainGraphicListenType = "socket"
+	DomainGraphicListenTypeNone    DomainGraphicListenType = "none"
+)
+
+// IsValid reports whether v is one of the DomainGraphicListenType constants
+func (v DomainGraphicListenType) IsValid() bool {
+	switch v {
+	case DomainGraphicListenTypeAddress, DomainGraphicListenTypeNetwork,
+		DomainGraphicListenTypeSocket, DomainGraphicListenTypeNone:
+		return true
+	}
+	return false
+}
+
+// DomainVideoModelType is the video model type attribute
+type DomainVideoModelType string
+
+const (
+	DomainVideoModelTypeVGA    DomainVideoModelType = "vga"
+	DomainVideoModelTypeCirrus DomainVideoModelType = "cirrus"
+	DomainVideoModelTypeVMVGA  DomainVideoModelType = "vmvga"
+	DomainVideoModelTypeXen    DomainVideoModelType = "xen"
+	DomainVideoModelTypeVBox   DomainVideoModelType = "vbox"
+	DomainVideoModelTypeQXL    DomainVideoModelType = "qxl"
+	DomainVideoModelTypeVirtIO DomainVideoModelType = "virtio"
+	DomainVideoModelTypeGOP    DomainVideoModelType = "gop"
+	DomainVideoModelTypeBochs  DomainVideoModelType = "bochs"
+	DomainVideoModelTypeRAMFB  DomainVideoModelType = "ramfb"
+	DomainVideoModelTypeNone   DomainVideoModelType = "none"
+)
+
+// IsValid reports whether v is one of the DomainVideoModelType constants
+func (v DomainVideoModelType) IsValid() bool {
+	switch v {
+	case DomainVideoModelTypeVGA, DomainVideoModelTypeCirrus,
+		DomainVideoModelTypeVMVGA, DomainVideoModelTypeXen,
+		DomainVideoModelTypeVBox, DomainVideoModelTypeQXL,
+		DomainVideoModelTypeVirtIO, DomainVideoModelTypeGOP,
+		DomainVideoModelTypeBochs, DomainVideoModelTypeRAMFB,
+		DomainVideoModelTypeNone:
+		return true
+	}
+	return false
+}
+
+// DomainInputType is the input type attribute
+type DomainInputType string
+
+const (
+	DomainInputTypeTablet      DomainInputType = "tablet"
+	DomainInputTypeMouse       DomainInputType = "mouse"
+	DomainInputTypeKeyboard    DomainInputType = "keyboard"
+	DomainInputTypePassthrough DomainInputType = "passthrough"
+	DomainInputTypeEVDev       DomainInputType = "evdev"
+)
+
+// IsValid reports whether v is one of the DomainInputType constants
+func (v DomainInputType) IsValid() bool {
+	switch v {
+	case DomainInputTypeTablet, DomainInputTypeMouse,
+		DomainInputTypeKeyboard, DomainInputTypePassthrough,
+		DomainInputTypeEVDev:
+		return true
+	}
+	return false
+}
+
+// DomainInputBus is the input bus attribute
+type DomainInputBus string
+
+const (
+	DomainInputBusPS2       DomainInputBus = "ps2"
+	DomainInputBusUSB       DomainInputBus = "usb"
+	DomainInputBusXen       DomainInputBus = "xen"
+	DomainInputBusVirtIO    DomainInputBus = "virtio"
+	DomainInputBusParallels DomainInputBus = "parallels"
+	DomainInputBusNone      DomainInputBus = "none"
+)
+
+// IsValid reports whether v is one of the DomainInputBus constants
+func (v DomainInputBus) IsValid() bool {
+	switch v {
+	case DomainInputBusPS2, DomainInputBusUSB, DomainInputBusXen,
+		DomainInputBusVirtIO, DomainInputBusParallels, DomainInputBusNone:
+		return true
+	}
+	return false
+}
+
+// DomainSoundModel is the sound model attribute
+type DomainSoundModel string
+
+const (
+	DomainSoundModelSB16   DomainSoundModel = "sb16"
+	DomainSoundModelES1370 DomainSoundModel = "es1370"
+	DomainSoundModelPCSpk  DomainSoundModel = "pcspk"
+	DomainSoundModelAC97   DomainSoundModel = "ac97"
+	DomainSoundModelICH6   DomainSoundModel = "ich6"
+	DomainSoundModelICH7   DomainSoundModel = "ich7"
+	DomainSoundModelICH9   DomainSoundModel = "ich9"
+	DomainSoundModelUSB    DomainSoundModel = "usb"
+)
+
+// IsValid reports whether v is one of the DomainSoundModel constants
+func (v DomainSoundModel) IsValid() bool {
+	switch v {
+	case DomainSoundModelSB16, DomainSoundModelES1370,
+		DomainSoundModelPCSpk, DomainSoundModelAC97,
+		DomainSoundModelICH6, DomainSoundModelICH7,
+		DomainSoundModelICH9, DomainSoundModelUSB:
+		return true
+	}
+	return false
+}
+
+// DomainWatchdogModel is the watchdog model attribute
+type DomainWatchdogModel string
+
+const (
+	DomainWatchdogModelI6300ESB DomainWatchdogModel = "i6300esb"
+	DomainWatchdogModelIB700    DomainWatchdogModel = "ib700"
+	DomainWatchdogModelDiag288  DomainWatchdogModel = "diag288"
+)
+
+// IsValid reports whether v is one of the DomainWatchdogModel constants
+func (v DomainWatchdogModel) IsValid() bool {
+	switch v {
+	case DomainWatchdogModelI6300ESB, DomainWatchdogModelIB700,
+		DomainWatchdogModelDiag288:
+		return true
+	}
+	return false
+}
+
+// DomainWatchdogAction is the watchdog action attribute
+type DomainWatchdogAction string
+
+const (
+	DomainWatchdogActionReset     DomainWatchdogAction = "reset"
+	DomainWatchdogActionShutdown  DomainWatchdogAction = "shutdown"
+	DomainWatchdogActionPoweroff  DomainWatchdogAction = "poweroff"
+	DomainWatchdogActionPause     DomainWatchdogAction = "pause"
+	DomainWatchdogActionNone      DomainWatchdogAction = "none"
+	DomainWatchdogActionDump      DomainWatchdogAction = "dump"
+	DomainWatchdogActionInjectNMI DomainWatchdogAction = "inject-nmi"
+)
+
+// IsValid reports whether v is one of the DomainWatchdogAction constants
+func (v DomainWatchdogAction) IsValid() bool {
+	switch v {
+	case DomainWatchdogActionReset, DomainWatchdogActionShutdown,
+		DomainWatchdogActionPoweroff, DomainWatchdogActionPause,
+		DomainWatchdogActionNone, DomainWatchdogActionDump,
+		DomainWatchdogActionInjectNMI:
+		return true
+	}
+	return false
+}
+
+// DomainMemBalloonModel is the memballoon model attribute
+type DomainMemBalloonModel string
+
+const (
+	DomainMemBalloonModelVirtIO                DomainMemBalloonModel = "virtio"
+	DomainMemBalloonModelVirtIOTransitional    DomainMemBalloonModel = "virtio-transitional"
+	DomainMemBalloonModelVirtIONonTransitional DomainMemBalloonModel = "virtio-non-transitional"
+	DomainMemBalloonModelXen                   DomainMemBalloonModel = "xen"
+	DomainMemBalloonModelNone                  DomainMemBalloonModel = "none"
+)
+
+// IsValid reports whether v is one of the DomainMemBalloonModel constants
+func (v DomainMemBalloonModel) IsValid() bool {
+	switch v {
+	case DomainMemBalloonModelVirtIO,
+		DomainMemBalloonModelVirtIOTransitional,
+		DomainMemBalloonModelVirtIONonTransitional,
+		DomainMemBalloonModelXen, DomainMemBalloonModelNone:
+		return true
+	}
+	return false
+}
+
+// DomainRNGModel is the rng model attribute
+type DomainRNGModel string
+
+const (
+	DomainRNGModelVirtIO                DomainRNGModel = "virtio"
+	DomainRNGModelVirtIOTransitional    DomainRNGModel = "virtio-transitional"
+	DomainRNGModelVirtIONonTransitional DomainRNGModel = "virtio-non-transitional"
+)
+
+// IsValid reports whether v is one of the DomainRNGModel constants
+func (v DomainRNGModel) IsValid() bool {
+	switch v {
+	case DomainRNGModelVirtIO, DomainRNGModelVirtIOTransitional,
+		DomainRNGModelVirtIONonTransitional:
+		return true
+	}
+	return false
+}
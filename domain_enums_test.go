@@ -0,0 +1,85 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"testing"
+)
+
+func TestEnumIsValid(t *testing.T) {
+	type isValider interface {
+		IsValid() bool
+	}
+	var tests = []struct {
+		Value isValider
+		Valid bool
+	}{
+		{DomainTypeKVM, true},
+		{DomainType("kvm2"), false},
+		{DomainType(""), false},
+		{DomainDiskBus("virtio"), true},
+		{DomainDiskBus("VIRTIO"), false},
+		{DomainDiskCacheDirectSync, true},
+		{DomainDiskIO("io_uring"), true},
+		{DomainControllerType("ide"), true},
+		{DomainControllerType("fdc"), true},
+		{DomainControllerType("scsi"), true},
+		{DomainControllerType("sata"), true},
+		{DomainControllerType("usb"), true},
+		{DomainControllerType("pci"), true},
+		{DomainControllerType("virtio-serial"), true},
+		{DomainControllerType("ccid"), true},
+		{DomainControllerType("xenbus"), true},
+		{DomainControllerType("isa"), true},
+		{DomainControllerType("virtio"), false},
+		{DomainInputBus("ps2"), true},
+		{DomainInputBus("usb"), true},
+		{DomainInputBus("xen"), true},
+		{DomainInputBus("virtio"), true},
+		{DomainInputBus("parallels"), true},
+		{DomainInputBus("none"), true},
+		{DomainInputBus("pci"), false},
+		{DomainInterfaceModelType("ne2k_pci"), true},
+		{DomainInterfaceModelType("virtio-net"), false},
+		{DomainGraphicListenType("socket"), true},
+		{DomainLifecycleAction("rename-restart"), true},
+		{DomainLifecycleAction("coredump-restart"), false},
+		{DomainCrashAction("coredump-restart"), true},
+		{NetworkForwardMode("open"), true},
+		{NetworkForwardMode("masquerade"), false},
+		{NetworkVirtualPortType("802.1Qbh"), true},
+		{StoragePoolType("iscsi-direct"), true},
+		{StoragePoolType("lvm"), false},
+		{StorageVolumeFormat("qcow2"), true},
+		{StorageVolumeFormat("qcow3"), false},
+	}
+
+	for _, test := range tests {
+		if test.Value.IsValid() != test.Valid {
+			t.Errorf("Expected IsValid %v for %#v", test.Valid, test.Value)
+		}
+	}
+}
@@ -121,6 +121,10 @@ func (s *DomainSnapshot) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), s)
 }
 
+func (s *DomainSnapshot) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(s, doc)
+}
+
 func (s *DomainSnapshot) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(s, "", "  ")
 	if err != nil {
@@ -141,6 +141,10 @@ func (s *Interface) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), s)
 }
 
+func (s *Interface) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(s, doc)
+}
+
 func (s *Interface) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(s, "", "  ")
 	if err != nil {
@@ -470,6 +470,10 @@ func (s *NetworkDHCPHost) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), s)
 }
 
+func (s *NetworkDHCPHost) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(s, doc)
+}
+
 func (s *NetworkDHCPHost) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(s, "", "  ")
 	if err != nil {
@@ -482,6 +486,10 @@ func (s *NetworkDNSHost) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), s)
 }
 
+func (s *NetworkDNSHost) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(s, doc)
+}
+
 func (s *NetworkDNSHost) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(s, "", "  ")
 	if err != nil {
@@ -494,6 +502,10 @@ func (s *NetworkPortGroup) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), s)
 }
 
+func (s *NetworkPortGroup) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(s, doc)
+}
+
 func (s *NetworkPortGroup) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(s, "", "  ")
 	if err != nil {
@@ -506,6 +518,10 @@ func (s *NetworkDNSTXT) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), s)
 }
 
+func (s *NetworkDNSTXT) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(s, doc)
+}
+
 func (s *NetworkDNSTXT) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(s, "", "  ")
 	if err != nil {
@@ -518,6 +534,10 @@ func (s *NetworkDNSSRV) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), s)
 }
 
+func (s *NetworkDNSSRV) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(s, doc)
+}
+
 func (s *NetworkDNSSRV) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(s, "", "  ")
 	if err != nil {
@@ -530,6 +550,10 @@ func (s *NetworkDHCPRange) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), s)
 }
 
+func (s *NetworkDHCPRange) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(s, doc)
+}
+
 func (s *NetworkDHCPRange) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(s, "", "  ")
 	if err != nil {
@@ -542,6 +566,10 @@ func (s *NetworkForwardInterface) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), s)
 }
 
+func (s *NetworkForwardInterface) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(s, doc)
+}
+
 func (s *NetworkForwardInterface) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(s, "", "  ")
 	if err != nil {
@@ -554,6 +582,10 @@ func (s *Network) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), s)
 }
 
+func (s *Network) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(s, doc)
+}
+
 func (s *Network) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(s, "", "  ")
 	if err != nil {
@@ -0,0 +1,627 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// DnsmasqConfigOptions describes the dnsmasq binary which will use
+// the configuration, since libvirt only emits some settings when
+// dnsmasq supports them, and where its files will be placed
+type DnsmasqConfigOptions struct {
+	// Version of dnsmasq, such as "2.80", which determines the
+	// settings it supports
+	Version string
+	// Directory for the hosts files, defaulting to
+	// /var/lib/libvirt/dnsmasq
+	StateDir string
+	// Optional pid-file setting
+	PIDFile string
+}
+
+// DnsmasqConfig holds the files libvirt would generate to run
+// dnsmasq for a network
+type DnsmasqConfig struct {
+	// Contents of the dnsmasq.conf file
+	Conf string
+	// Contents of the dhcp-hostsfile, with static DHCP hosts
+	HostsFile string
+	// Contents of the addn-hosts file, with DNS host entries
+	AddnHosts string
+}
+
+const dnsmasqDefaultStateDir = "/var/lib/libvirt/dnsmasq"
+
+var defaultDnsmasqConfigOptions = DnsmasqConfigOptions{
+	Version: "2.80",
+}
+
+// dnsmasqVersion returns the version in the same form as libvirt,
+// major * 1000000 + minor * 1000
+func dnsmasqVersion(version string) (uint, error) {
+	parts := strings.SplitN(version, ".", 3)
+	var ret uint
+	scale := uint(1000000)
+	for i, part := range parts {
+		if i == 2 {
+			break
+		}
+		val, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return 0, fmt.Errorf("Cannot parse dnsmasq version '%s'", version)
+		}
+		ret += uint(val) * scale
+		scale /= 1000
+	}
+	return ret, nil
+}
+
+// networkIPIsV6 reports whether the network IP is IPv6, falling
+// back to the address format when no family is given
+func networkIPIsV6(ip *NetworkIP) bool {
+	if ip.Family != "" {
+		return ip.Family == "ipv6"
+	}
+	return strings.Contains(ip.Address, ":")
+}
+
+// networkIPPrefix returns the prefix of a network IP, from the
+// prefix or netmask attribute, or else by address class as libvirt
+// does
+func networkIPPrefix(ip *NetworkIP) (int, error) {
+	addr := net.ParseIP(ip.Address)
+	if addr == nil {
+		return 0, fmt.Errorf("Invalid network address '%s'", ip.Address)
+	}
+	addr4 := addr.To4()
+	if (addr4 == nil) != networkIPIsV6(ip) {
+		return 0, fmt.Errorf("Network address '%s' does not match family '%s'", ip.Address, ip.Family)
+	}
+	if ip.Prefix != 0 {
+		return int(ip.Prefix), nil
+	}
+	if ip.Netmask != "" {
+		mask := net.ParseIP(ip.Netmask).To4()
+		if mask == nil {
+			return 0, fmt.Errorf("Invalid netmask '%s'", ip.Netmask)
+		}
+		ones, bits := net.IPMask(mask).Size()
+		if bits == 0 {
+			return 0, fmt.Errorf("Invalid netmask '%s'", ip.Netmask)
+		}
+		return ones, nil
+	}
+	if addr4 == nil {
+		return 64, nil
+	}
+	switch {
+	case addr4[0]&0x80 == 0:
+		return 8, nil
+	case addr4[0]&0xc0 == 0x80:
+		return 16, nil
+	case addr4[0]&0xe0 == 0xc0:
+		return 24, nil
+	}
+	return 0, fmt.Errorf("Cannot determine prefix for '%s'", ip.Address)
+}
+
+func formatNetworkAddr(addr string) (string, error) {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return "", fmt.Errorf("Invalid address '%s'", addr)
+	}
+	return ip.String(), nil
+}
+
+// dnsmasqPTRDomain returns the reverse DNS zone for a network,
+// which requires the prefix to fall on an octet or nibble boundary
+func dnsmasqPTRDomain(ip *NetworkIP, prefix int) (string, error) {
+	addr := net.ParseIP(ip.Address)
+	if addr == nil {
+		return "", fmt.Errorf("Invalid network address '%s'", ip.Address)
+	}
+	var labels []string
+	if addr4 := addr.To4(); addr4 != nil && !networkIPIsV6(ip) {
+		if prefix == 0 || prefix >= 32 || prefix%8 != 0 {
+			return "", fmt.Errorf("PTR domain for IPv4 network with prefix %d cannot be automatically created", prefix)
+		}
+		for i := prefix/8 - 1; i >= 0; i-- {
+			labels = append(labels, strconv.Itoa(int(addr4[i])))
+		}
+		labels = append(labels, "in-addr", "arpa")
+	} else {
+		if prefix == 0 || prefix >= 128 || prefix%4 != 0 {
+			return "", fmt.Errorf("PTR domain for IPv6 network with prefix %d cannot be automatically created", prefix)
+		}
+		addr16 := addr.To16()
+		for i := prefix/4 - 1; i >= 0; i-- {
+			nibble := addr16[i/2]
+			if i%2 == 0 {
+				nibble >>= 4
+			}
+			labels = append(labels, strconv.FormatUint(uint64(nibble&0xf), 16))
+		}
+		labels = append(labels, "ip6", "arpa")
+	}
+	return strings.Join(labels, "."), nil
+}
+
+// dnsmasqRangeSize returns the number of addresses in a DHCP
+// range, checking that it lies within the network
+func dnsmasqRangeSize(ip *NetworkIP, prefix int, rng *NetworkDHCPRange) (int, error) {
+	start := net.ParseIP(rng.Start)
+	end := net.ParseIP(rng.End)
+	network := net.ParseIP(ip.Address)
+	if start == nil || end == nil || network == nil {
+		return 0, fmt.Errorf("Invalid DHCP range %s - %s", rng.Start, rng.End)
+	}
+	bits := 128
+	if !networkIPIsV6(ip) {
+		start, end, network = start.To4(), end.To4(), network.To4()
+		bits = 32
+		if start == nil || end == nil || network == nil {
+			return 0, fmt.Errorf("Invalid DHCP range %s - %s", rng.Start, rng.End)
+		}
+	}
+	mask := net.CIDRMask(prefix, bits)
+	if !start.Mask(mask).Equal(network.Mask(mask)) || !end.Mask(mask).Equal(network.Mask(mask)) {
+		return 0, fmt.Errorf("Range %s - %s is not entirely within network %s/%d",
+			rng.Start, rng.End, ip.Address, prefix)
+	}
+	size := new(big.Int).Sub(new(big.Int).SetBytes(end), new(big.Int).SetBytes(start))
+	if size.Sign() < 0 {
+		return 0, fmt.Errorf("Range %s - %s is reversed", rng.Start, rng.End)
+	}
+	if !size.IsInt64() || size.Int64() >= 65535 {
+		return 0, fmt.Errorf("Range %s - %s exceeds maximum size 65535", rng.Start, rng.End)
+	}
+	return int(size.Int64()) + 1, nil
+}
+
+// dnsmasqLeaseTime formats a lease in dnsmasq syntax, which takes
+// seconds by default where libvirt defaults to minutes
+func dnsmasqLeaseTime(lease *NetworkDHCPLease) string {
+	if lease == nil {
+		return ""
+	}
+	if lease.Expiry == 0 {
+		return "infinite"
+	}
+	switch lease.Unit {
+	case "seconds":
+		return fmt.Sprintf("%ds", lease.Expiry)
+	case "hours":
+		return fmt.Sprintf("%dh", lease.Expiry)
+	}
+	return fmt.Sprintf("%dm", lease.Expiry)
+}
+
+func dnsmasqDHCPHost(host *NetworkDHCPHost, ipv6 bool) (string, error) {
+	ip, err := formatNetworkAddr(host.IP)
+	if err != nil {
+		return "", err
+	}
+	var line string
+	if ipv6 {
+		if host.Name != "" && host.ID != "" {
+			line = fmt.Sprintf("id:%s,%s", host.ID, host.Name)
+		} else if host.Name != "" {
+			line = host.Name
+		} else if host.ID != "" {
+			line = fmt.Sprintf("id:%s", host.ID)
+		}
+		line += fmt.Sprintf(",[%s]", ip)
+	} else if host.Name != "" && host.MAC != "" {
+		line = fmt.Sprintf("%s,%s,%s", host.MAC, ip, host.Name)
+	} else if host.Name != "" {
+		line = fmt.Sprintf("%s,%s", host.Name, ip)
+	} else {
+		line = fmt.Sprintf("%s,%s", host.MAC, ip)
+	}
+	if lease := dnsmasqLeaseTime(host.Lease); lease != "" {
+		line += "," + lease
+	}
+	return line, nil
+}
+
+func dnsmasqSRVRecord(srv *NetworkDNSSRV) (string, error) {
+	if srv.Service == "" {
+		return "", fmt.Errorf("Missing required 'service' attribute in SRV record of network")
+	}
+	if srv.Protocol == "" {
+		return "", fmt.Errorf("Missing required 'protocol' attribute in SRV record of network")
+	}
+	// RFC2782 requires that service and protocol be preceded by
+	// an underscore
+	line := fmt.Sprintf("srv-host=_%s._%s", srv.Service, srv.Protocol)
+	if srv.Domain != "" {
+		line += "." + srv.Domain
+	}
+	// Port, priority and weight are optional, but are identified by
+	// their position in the line, so an unset one must be given its
+	// default if a later one is set. dnsmasq defaults the port to 1.
+	// A target of "." means the service is not available, which
+	// dnsmasq expresses by leaving all of them out
+	if srv.Target != "" && srv.Target != "." {
+		line += "," + srv.Target
+		if srv.Port != 0 || srv.Priority != 0 || srv.Weight != 0 {
+			port := srv.Port
+			if port == 0 {
+				port = 1
+			}
+			line += fmt.Sprintf(",%d", port)
+		}
+		if srv.Priority != 0 || srv.Weight != 0 {
+			line += fmt.Sprintf(",%d", srv.Priority)
+		}
+		if srv.Weight != 0 {
+			line += fmt.Sprintf(",%d", srv.Weight)
+		}
+	}
+	return line, nil
+}
+
+// DnsmasqConfig renders the dnsmasq configuration libvirt uses to
+// provide DHCP and DNS for the network, along with the contents of
+// the static DHCP hosts and DNS hosts files it references. If opts
+// is nil, a recent dnsmasq is assumed
+func (s *Network) DnsmasqConfig(opts *DnsmasqConfigOptions) (*DnsmasqConfig, error) {
+	if opts == nil {
+		opts = &defaultDnsmasqConfigOptions
+	}
+	version, err := dnsmasqVersion(opts.Version)
+	if err != nil {
+		return nil, err
+	}
+	statedir := opts.StateDir
+	if statedir == "" {
+		statedir = dnsmasqDefaultStateDir
+	}
+	// Router advertisements and DHCPv6 need dnsmasq 2.64,
+	// --bind-dynamic was added in 2.63 and --ra-param in 2.67
+	haveIPv6 := version >= 2064000
+	bindDynamic := version >= 2063000
+	raParam := version >= 2067000
+
+	dns := s.DNS
+	if dns == nil {
+		dns = &NetworkDNS{}
+	}
+	wantDNS := dns.Enable != "no"
+
+	var conf bytes.Buffer
+	fmt.Fprintf(&conf, "##WARNING:  THIS IS AN AUTO-GENERATED FILE. "+
+		"CHANGES TO IT ARE LIKELY TO BE\n"+
+		"##OVERWRITTEN AND LOST.  Changes to this "+
+		"configuration should be made using:\n"+
+		"##    virsh net-edit %s\n"+
+		"## or other application using the libvirt API.\n"+
+		"##\n## dnsmasq conf file created by libvirt\n"+
+		"strict-order\n", s.Name)
+
+	// A listening port of 0 disables DNS
+	if !wantDNS {
+		conf.WriteString("port=0\n")
+	}
+
+	if wantDNS && len(dns.Forwarders) != 0 {
+		// If any forwarder handles all domains, the host's
+		// resolv.conf is not needed
+		noResolv := false
+		for _, fwd := range dns.Forwarders {
+			conf.WriteString("server=")
+			if fwd.Domain != "" {
+				fmt.Fprintf(&conf, "/%s/", fwd.Domain)
+			}
+			if fwd.Addr != "" {
+				addr, err := formatNetworkAddr(fwd.Addr)
+				if err != nil {
+					return nil, err
+				}
+				conf.WriteString(addr + "\n")
+				if fwd.Domain == "" {
+					noResolv = true
+				}
+			} else {
+				// Don't forward requests for this domain
+				conf.WriteString("#\n")
+			}
+		}
+		if noResolv {
+			conf.WriteString("no-resolv\n")
+		}
+	}
+
+	if s.Domain != nil && s.Domain.Name != "" {
+		if s.Domain.LocalOnly == "yes" {
+			fmt.Fprintf(&conf, "local=/%s/\n", s.Domain.Name)
+		}
+		fmt.Fprintf(&conf, "domain=%s\nexpand-hosts\n", s.Domain.Name)
+	}
+
+	if wantDNS {
+		for i := range s.IPs {
+			ip := &s.IPs[i]
+			if ip.LocalPtr != "yes" {
+				continue
+			}
+			prefix, err := networkIPPrefix(ip)
+			if err != nil {
+				return nil, err
+			}
+			ptr, err := dnsmasqPTRDomain(ip, prefix)
+			if err != nil {
+				return nil, err
+			}
+			fmt.Fprintf(&conf, "local=/%s/\n", ptr)
+		}
+	}
+
+	// Unless plain names are to be forwarded, they must be
+	// answered locally whether or not a domain is set
+	if wantDNS && dns.ForwardPlainNames == "no" {
+		conf.WriteString("domain-needed\nlocal=//\n")
+	}
+
+	if opts.PIDFile != "" {
+		fmt.Fprintf(&conf, "pid-file=%s\n", opts.PIDFile)
+	}
+
+	// dnsmasq always listens on localhost unless told otherwise
+	conf.WriteString("except-interface=lo\n")
+
+	if bindDynamic {
+		bridge := ""
+		if s.Bridge != nil {
+			bridge = s.Bridge.Name
+		}
+		fmt.Fprintf(&conf, "bind-dynamic\ninterface=%s\n", bridge)
+	} else {
+		conf.WriteString("bind-interfaces\n")
+		for _, ip := range s.IPs {
+			addr, err := formatNetworkAddr(ip.Address)
+			if err != nil {
+				return nil, err
+			}
+			fmt.Fprintf(&conf, "listen-address=%s\n", addr)
+		}
+	}
+
+	// An isolated network must not offer a default route, nor
+	// forward DNS requests to the host's servers
+	if s.Forward == nil {
+		conf.WriteString("dhcp-option=3\nno-resolv\n")
+		if raParam {
+			conf.WriteString("ra-param=*,0,0\n")
+		}
+	}
+
+	if wantDNS {
+		for _, txt := range dns.TXTs {
+			fmt.Fprintf(&conf, "txt-record=%s,%s\n", txt.Name, txt.Value)
+		}
+		for i := range dns.SRVs {
+			line, err := dnsmasqSRVRecord(&dns.SRVs[i])
+			if err != nil {
+				return nil, err
+			}
+			conf.WriteString(line + "\n")
+		}
+	}
+
+	// Only the first IPv4 and IPv6 addresses with DHCP are used
+	var ipv4def, ipv6def *NetworkIP
+	for i := range s.IPs {
+		ip := &s.IPs[i]
+		hasDHCP := ip.DHCP != nil && (len(ip.DHCP.Ranges) != 0 || len(ip.DHCP.Hosts) != 0)
+		if !networkIPIsV6(ip) {
+			if hasDHCP {
+				if ipv4def != nil {
+					return nil, fmt.Errorf("For IPv4, multiple DHCP definitions cannot be specified.")
+				}
+				ipv4def = ip
+			}
+			continue
+		}
+		if !hasDHCP {
+			continue
+		}
+		if !haveIPv6 {
+			return nil, fmt.Errorf("The version of dnsmasq on this host does not support DHCPv6 (have %s, need 2.64)", opts.Version)
+		}
+		if ipv6def != nil {
+			return nil, fmt.Errorf("For IPv6, multiple DHCP definitions cannot be specified.")
+		}
+		ipv6def = ip
+	}
+
+	var hosts bytes.Buffer
+	leases := 0
+	for _, ip := range []*NetworkIP{ipv4def, ipv6def} {
+		if ip == nil {
+			continue
+		}
+		ipv6 := networkIPIsV6(ip)
+		prefix, err := networkIPPrefix(ip)
+		if err != nil {
+			return nil, err
+		}
+		for i := range ip.DHCP.Ranges {
+			rng := &ip.DHCP.Ranges[i]
+			start, err := formatNetworkAddr(rng.Start)
+			if err != nil {
+				return nil, err
+			}
+			end, err := formatNetworkAddr(rng.End)
+			if err != nil {
+				return nil, err
+			}
+			if ipv6 {
+				fmt.Fprintf(&conf, "dhcp-range=%s,%s,%d", start, end, prefix)
+			} else {
+				netmask := net.IP(net.CIDRMask(prefix, 32)).String()
+				fmt.Fprintf(&conf, "dhcp-range=%s,%s,%s", start, end, netmask)
+			}
+			if lease := dnsmasqLeaseTime(rng.Lease); lease != "" {
+				conf.WriteString("," + lease)
+			}
+			conf.WriteString("\n")
+
+			size, err := dnsmasqRangeSize(ip, prefix, rng)
+			if err != nil {
+				return nil, err
+			}
+			leases += size
+		}
+
+		// Static only DHCP needs a special range to enable the
+		// DHCP service
+		if len(ip.DHCP.Ranges) == 0 && len(ip.DHCP.Hosts) != 0 {
+			addr, err := formatNetworkAddr(ip.Address)
+			if err != nil {
+				return nil, err
+			}
+			fmt.Fprintf(&conf, "dhcp-range=%s,static", addr)
+			if ipv6 {
+				fmt.Fprintf(&conf, ",%d", prefix)
+			}
+			conf.WriteString("\n")
+		}
+
+		for i := range ip.DHCP.Hosts {
+			host := &ip.DHCP.Hosts[i]
+			if host.IP == "" {
+				continue
+			}
+			line, err := dnsmasqDHCPHost(host, ipv6)
+			if err != nil {
+				return nil, err
+			}
+			hosts.WriteString(line + "\n")
+		}
+
+		if ipv6 {
+			continue
+		}
+		conf.WriteString("dhcp-no-override\ndhcp-authoritative\n")
+		if ip.TFTP != nil && ip.TFTP.Root != "" {
+			fmt.Fprintf(&conf, "enable-tftp\ntftp-root=%s\n", ip.TFTP.Root)
+		}
+		if len(ip.DHCP.Bootp) != 0 && ip.DHCP.Bootp[0].File != "" {
+			bootp := ip.DHCP.Bootp[0]
+			if bootp.Server != "" {
+				server, err := formatNetworkAddr(bootp.Server)
+				if err != nil {
+					return nil, err
+				}
+				fmt.Fprintf(&conf, "dhcp-boot=%s,,%s\n", bootp.File, server)
+			} else {
+				fmt.Fprintf(&conf, "dhcp-boot=%s\n", bootp.File)
+			}
+		}
+	}
+
+	if leases > 0 {
+		fmt.Fprintf(&conf, "dhcp-lease-max=%d\n", leases)
+	}
+
+	// Hostnames sharing an IP address are listed on one line
+	var addnhosts bytes.Buffer
+	var addrs []string
+	names := make(map[string][]string)
+	for _, host := range dns.Host {
+		if host.IP == "" {
+			continue
+		}
+		addr, err := formatNetworkAddr(host.IP)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := names[addr]; !ok {
+			addrs = append(addrs, addr)
+		}
+		for _, hostname := range host.Hostnames {
+			names[addr] = append(names[addr], hostname.Hostname)
+		}
+	}
+	for _, addr := range addrs {
+		addnhosts.WriteString(addr + "\t")
+		for _, name := range names[addr] {
+			addnhosts.WriteString(name + "\t")
+		}
+		addnhosts.WriteString("\n")
+	}
+
+	// The files are always referenced, even if empty, so that
+	// hosts can be added at runtime
+	if ipv4def != nil || ipv6def != nil {
+		fmt.Fprintf(&conf, "dhcp-hostsfile=%s/%s.hostsfile\n", statedir, s.Name)
+	}
+	if wantDNS {
+		fmt.Fprintf(&conf, "addn-hosts=%s/%s.addnhosts\n", statedir, s.Name)
+	}
+
+	if s.MTU != nil && s.MTU.Size > 0 {
+		fmt.Fprintf(&conf, "dhcp-option=option:mtu,%d\n", s.MTU.Size)
+	}
+
+	if haveIPv6 {
+		if ipv6def != nil {
+			conf.WriteString("enable-ra\n")
+		} else {
+			for i := range s.IPs {
+				ip := &s.IPs[i]
+				if !networkIPIsV6(ip) ||
+					(ip.DHCP != nil && (len(ip.DHCP.Ranges) != 0 || len(ip.DHCP.Hosts) != 0)) {
+					continue
+				}
+				addr, err := formatNetworkAddr(ip.Address)
+				if err != nil {
+					return nil, err
+				}
+				fmt.Fprintf(&conf, "dhcp-range=%s,ra-only\n", addr)
+			}
+		}
+	}
+
+	if s.DnsmasqOptions != nil {
+		for _, opt := range s.DnsmasqOptions.Option {
+			conf.WriteString(opt.Value + "\n")
+		}
+	}
+
+	return &DnsmasqConfig{
+		Conf:      conf.String(),
+		HostsFile: hosts.String(),
+		AddnHosts: addnhosts.String(),
+	}, nil
+}
@@ -0,0 +1,402 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+const dnsmasqConfHeader = "##WARNING:  THIS IS AN AUTO-GENERATED FILE. CHANGES TO IT ARE LIKELY TO BE\n" +
+	"##OVERWRITTEN AND LOST.  Changes to this configuration should be made using:\n" +
+	"##    virsh net-edit %s\n" +
+	"## or other application using the libvirt API.\n" +
+	"##\n" +
+	"## dnsmasq conf file created by libvirt\n"
+
+var dnsmasqTestData = []struct {
+	Network   string
+	Options   DnsmasqConfigOptions
+	Conf      []string
+	HostsFile []string
+	AddnHosts []string
+}{
+	{
+		Network: strings.Join([]string{
+			`<network>`,
+			`  <name>default</name>`,
+			`  <forward dev='eth1' mode='nat'>`,
+			`    <interface dev='eth1'/>`,
+			`  </forward>`,
+			`  <bridge name='virbr0' stp='on' delay='0'/>`,
+			`  <ip address='192.168.122.1' netmask='255.255.255.0'>`,
+			`    <dhcp>`,
+			`      <range start='192.168.122.2' end='192.168.122.254'/>`,
+			`      <host mac='00:16:3e:77:e2:ed' name='a.example.com' ip='192.168.122.10'/>`,
+			`      <host mac='00:16:3e:3e:a9:1a' name='b.example.com' ip='192.168.122.11'/>`,
+			`    </dhcp>`,
+			`  </ip>`,
+			`  <ip family='ipv4' address='192.168.123.1' netmask='255.255.255.0'>`,
+			`  </ip>`,
+			`  <ip family='ipv6' address='2001:db8:ac10:fe01::1' prefix='64'>`,
+			`    <dhcp>`,
+			`      <range start='2001:db8:ac10:fe01::1:10' end='2001:db8:ac10:fe01::1:ff'/>`,
+			`    </dhcp>`,
+			`  </ip>`,
+			`  <ip family='ipv6' address='2001:db8:ac10:fd01::1' prefix='64'>`,
+			`  </ip>`,
+			`  <ip family='ipv4' address='10.24.10.1'>`,
+			`  </ip>`,
+			`</network>`,
+		}, "\n"),
+		Options: DnsmasqConfigOptions{
+			Version: "2.64",
+		},
+		Conf: []string{
+			`strict-order`,
+			`except-interface=lo`,
+			`bind-dynamic`,
+			`interface=virbr0`,
+			`dhcp-range=192.168.122.2,192.168.122.254,255.255.255.0`,
+			`dhcp-no-override`,
+			`dhcp-authoritative`,
+			`dhcp-range=2001:db8:ac10:fe01::1:10,2001:db8:ac10:fe01::1:ff,64`,
+			`dhcp-lease-max=493`,
+			`dhcp-hostsfile=/var/lib/libvirt/dnsmasq/default.hostsfile`,
+			`addn-hosts=/var/lib/libvirt/dnsmasq/default.addnhosts`,
+			`enable-ra`,
+		},
+		HostsFile: []string{
+			`00:16:3e:77:e2:ed,192.168.122.10,a.example.com`,
+			`00:16:3e:3e:a9:1a,192.168.122.11,b.example.com`,
+		},
+	},
+	{
+		Network: strings.Join([]string{
+			`<network>`,
+			`  <name>private</name>`,
+			`  <bridge name='virbr2'/>`,
+			`  <ip address='192.168.152.1' netmask='255.255.255.0'>`,
+			`    <dhcp>`,
+			`      <range start='192.168.152.2' end='192.168.152.254'/>`,
+			`    </dhcp>`,
+			`  </ip>`,
+			`</network>`,
+		}, "\n"),
+		Options: DnsmasqConfigOptions{
+			Version: "2.48",
+		},
+		Conf: []string{
+			`strict-order`,
+			`except-interface=lo`,
+			`bind-interfaces`,
+			`listen-address=192.168.152.1`,
+			`dhcp-option=3`,
+			`no-resolv`,
+			`dhcp-range=192.168.152.2,192.168.152.254,255.255.255.0`,
+			`dhcp-no-override`,
+			`dhcp-authoritative`,
+			`dhcp-lease-max=253`,
+			`dhcp-hostsfile=/var/lib/libvirt/dnsmasq/private.hostsfile`,
+			`addn-hosts=/var/lib/libvirt/dnsmasq/private.addnhosts`,
+		},
+	},
+	{
+		Network: strings.Join([]string{
+			`<network xmlns:dnsmasq='http://libvirt.org/schemas/network/dnsmasq/1.0'>`,
+			`  <name>lab</name>`,
+			`  <forward mode='route'/>`,
+			`  <bridge name='virbr5'/>`,
+			`  <mtu size='9000'/>`,
+			`  <domain name='lab.example.com' localOnly='yes'/>`,
+			`  <dns forwardPlainNames='no'>`,
+			`    <forwarder domain='corp.example.com' addr='10.0.0.53'/>`,
+			`    <forwarder domain='blocked.example.com'/>`,
+			`    <forwarder addr='8.8.8.8'/>`,
+			`    <txt name='example' value='example value'/>`,
+			`    <srv service='name' protocol='tcp' domain='test-domain-name' target='.' port='1024' priority='10' weight='10'/>`,
+			`    <srv service='ldap' protocol='tcp' target='ldap.example.com' weight='5'/>`,
+			`    <host ip='192.168.125.2'>`,
+			`      <hostname>host</hostname>`,
+			`    </host>`,
+			`    <host ip='192.168.125.2'>`,
+			`      <hostname>alias</hostname>`,
+			`      <hostname>other</hostname>`,
+			`    </host>`,
+			`  </dns>`,
+			`  <ip address='192.168.125.1' prefix='24' localPtr='yes'>`,
+			`    <tftp root='/srv/tftp'/>`,
+			`    <dhcp>`,
+			`      <host mac='52:54:00:00:00:01' ip='192.168.125.10'>`,
+			`        <lease expiry='2' unit='hours'/>`,
+			`      </host>`,
+			`      <host name='nomac' ip='192.168.125.11'>`,
+			`        <lease expiry='0'/>`,
+			`      </host>`,
+			`      <host mac='52:54:00:00:00:02' ip='192.168.125.12'>`,
+			`        <lease expiry='3600' unit='seconds'/>`,
+			`      </host>`,
+			`      <bootp file='pxelinux.0' server='192.168.125.5'/>`,
+			`    </dhcp>`,
+			`  </ip>`,
+			`  <ip family='ipv6' address='2001:db8:ca2:2::1' prefix='64' localPtr='yes'>`,
+			`    <dhcp>`,
+			`      <host id='0:4:7e:7d:f0:7d:a8:bc:c5:d2:13:32:11:ed:16:ea:84:63' name='paul' ip='2001:db8:ca2:2::3'/>`,
+			`    </dhcp>`,
+			`  </ip>`,
+			`  <dnsmasq:options>`,
+			`    <dnsmasq:option value='cache-size=0'/>`,
+			`  </dnsmasq:options>`,
+			`</network>`,
+		}, "\n"),
+		Options: DnsmasqConfigOptions{
+			Version:  "2.80",
+			StateDir: "/run/dnsmasq",
+			PIDFile:  "/run/dnsmasq/lab.pid",
+		},
+		Conf: []string{
+			`strict-order`,
+			`server=/corp.example.com/10.0.0.53`,
+			`server=/blocked.example.com/#`,
+			`server=8.8.8.8`,
+			`no-resolv`,
+			`local=/lab.example.com/`,
+			`domain=lab.example.com`,
+			`expand-hosts`,
+			`local=/125.168.192.in-addr.arpa/`,
+			`local=/2.0.0.0.2.a.c.0.8.b.d.0.1.0.0.2.ip6.arpa/`,
+			`domain-needed`,
+			`local=//`,
+			`pid-file=/run/dnsmasq/lab.pid`,
+			`except-interface=lo`,
+			`bind-dynamic`,
+			`interface=virbr5`,
+			`txt-record=example,example value`,
+			`srv-host=_name._tcp.test-domain-name`,
+			`srv-host=_ldap._tcp,ldap.example.com,1,0,5`,
+			`dhcp-range=192.168.125.1,static`,
+			`dhcp-no-override`,
+			`dhcp-authoritative`,
+			`enable-tftp`,
+			`tftp-root=/srv/tftp`,
+			`dhcp-boot=pxelinux.0,,192.168.125.5`,
+			`dhcp-range=2001:db8:ca2:2::1,static,64`,
+			`dhcp-hostsfile=/run/dnsmasq/lab.hostsfile`,
+			`addn-hosts=/run/dnsmasq/lab.addnhosts`,
+			`dhcp-option=option:mtu,9000`,
+			`enable-ra`,
+			`cache-size=0`,
+		},
+		HostsFile: []string{
+			`52:54:00:00:00:01,192.168.125.10,2h`,
+			`nomac,192.168.125.11,infinite`,
+			`52:54:00:00:00:02,192.168.125.12,3600s`,
+			`id:0:4:7e:7d:f0:7d:a8:bc:c5:d2:13:32:11:ed:16:ea:84:63,paul,[2001:db8:ca2:2::3]`,
+		},
+		AddnHosts: []string{
+			"192.168.125.2\thost\talias\tother\t",
+		},
+	},
+	{
+		Network: strings.Join([]string{
+			`<network>`,
+			`  <name>nodns</name>`,
+			`  <forward mode='route'/>`,
+			`  <bridge name='virbr6'/>`,
+			`  <dns enable='no'/>`,
+			`  <ip family='ipv6' address='2001:db8:ac10:fd01::1' prefix='64'/>`,
+			`</network>`,
+		}, "\n"),
+		Options: DnsmasqConfigOptions{
+			Version: "2.80",
+		},
+		Conf: []string{
+			`strict-order`,
+			`port=0`,
+			`except-interface=lo`,
+			`bind-dynamic`,
+			`interface=virbr6`,
+			`dhcp-range=2001:db8:ac10:fd01::1,ra-only`,
+		},
+	},
+	{
+		Network: strings.Join([]string{
+			`<network>`,
+			`  <name>isolated</name>`,
+			`  <bridge name='virbr7'/>`,
+			`  <ip address='192.168.7.1' prefix='24'/>`,
+			`</network>`,
+		}, "\n"),
+		Options: DnsmasqConfigOptions{
+			Version: "2.66",
+		},
+		Conf: []string{
+			`strict-order`,
+			`except-interface=lo`,
+			`bind-dynamic`,
+			`interface=virbr7`,
+			`dhcp-option=3`,
+			`no-resolv`,
+			`addn-hosts=/var/lib/libvirt/dnsmasq/isolated.addnhosts`,
+		},
+	},
+	{
+		Network: strings.Join([]string{
+			`<network>`,
+			`  <name>isolated</name>`,
+			`  <bridge name='virbr7'/>`,
+			`  <ip address='192.168.7.1' prefix='24'/>`,
+			`</network>`,
+		}, "\n"),
+		Options: DnsmasqConfigOptions{
+			Version: "2.67",
+		},
+		Conf: []string{
+			`strict-order`,
+			`except-interface=lo`,
+			`bind-dynamic`,
+			`interface=virbr7`,
+			`dhcp-option=3`,
+			`no-resolv`,
+			`ra-param=*,0,0`,
+			`addn-hosts=/var/lib/libvirt/dnsmasq/isolated.addnhosts`,
+		},
+	},
+}
+
+func dnsmasqTestFile(lines []string) string {
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func TestNetworkDnsmasqConfig(t *testing.T) {
+	for _, test := range dnsmasqTestData {
+		net := &Network{}
+		if err := net.Unmarshal(test.Network); err != nil {
+			t.Fatal(err)
+		}
+
+		cfg, err := net.DnsmasqConfig(&test.Options)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expect := strings.Replace(dnsmasqConfHeader, "%s", net.Name, 1) +
+			dnsmasqTestFile(test.Conf)
+		if cfg.Conf != expect {
+			t.Fatal("Bad dnsmasq conf:\n", cfg.Conf, "\n does not match\n", expect)
+		}
+		expect = dnsmasqTestFile(test.HostsFile)
+		if cfg.HostsFile != expect {
+			t.Fatal("Bad hostsfile:\n", cfg.HostsFile, "\n does not match\n", expect)
+		}
+		expect = dnsmasqTestFile(test.AddnHosts)
+		if cfg.AddnHosts != expect {
+			t.Fatal("Bad addnhosts:\n", cfg.AddnHosts, "\n does not match\n", expect)
+		}
+	}
+}
+
+func TestDnsmasqSRVRecord(t *testing.T) {
+	var tests = []struct {
+		SRV    NetworkDNSSRV
+		Expect string
+	}{
+		{
+			SRV:    NetworkDNSSRV{Service: "ldap", Protocol: "tcp"},
+			Expect: "srv-host=_ldap._tcp",
+		},
+		{
+			SRV:    NetworkDNSSRV{Service: "ldap", Protocol: "tcp", Domain: "example.com", Target: "ldap.example.com", Port: 389},
+			Expect: "srv-host=_ldap._tcp.example.com,ldap.example.com,389",
+		},
+		{
+			SRV:    NetworkDNSSRV{Service: "ldap", Protocol: "tcp", Target: "ldap.example.com", Priority: 10},
+			Expect: "srv-host=_ldap._tcp,ldap.example.com,1,10",
+		},
+		{
+			SRV:    NetworkDNSSRV{Service: "ldap", Protocol: "tcp", Domain: "example.com", Target: ".", Port: 389, Priority: 10, Weight: 5},
+			Expect: "srv-host=_ldap._tcp.example.com",
+		},
+	}
+
+	for _, test := range tests {
+		line, err := dnsmasqSRVRecord(&test.SRV)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if line != test.Expect {
+			t.Errorf("Expected %q, got %q", test.Expect, line)
+		}
+	}
+}
+
+func TestNetworkDnsmasqConfigErrors(t *testing.T) {
+	var tests = []string{
+		`<network><name>a</name>` +
+			`<ip address='192.168.1.1' prefix='24'><dhcp><range start='192.168.1.2' end='192.168.1.9'/></dhcp></ip>` +
+			`<ip address='192.168.2.1' prefix='24'><dhcp><range start='192.168.2.2' end='192.168.2.9'/></dhcp></ip>` +
+			`</network>`,
+		`<network><name>b</name>` +
+			`<ip address='192.168.1.1' prefix='24'><dhcp><range start='192.168.2.2' end='192.168.2.9'/></dhcp></ip>` +
+			`</network>`,
+		`<network><name>c</name>` +
+			`<ip address='192.168.1.1' prefix='20' localPtr='yes'/>` +
+			`</network>`,
+		`<network><name>d</name>` +
+			`<dns><srv protocol='tcp'/></dns>` +
+			`</network>`,
+		`<network><name>e</name>` +
+			`<ip family='ipv4' address='fd00::1' localPtr='yes'/>` +
+			`</network>`,
+		`<network><name>f</name>` +
+			`<ip family='ipv6' address='192.168.1.1' prefix='64' localPtr='yes'/>` +
+			`</network>`,
+	}
+
+	for _, test := range tests {
+		net := &Network{}
+		if err := net.Unmarshal(test); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := net.DnsmasqConfig(nil); err == nil {
+			t.Errorf("Expected error for %s", test)
+		}
+	}
+
+	net := &Network{}
+	err := net.Unmarshal(`<network><name>e</name>` +
+		`<ip family='ipv6' address='2001:db8::1' prefix='64'><dhcp><range start='2001:db8::10' end='2001:db8::20'/></dhcp></ip>` +
+		`</network>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := net.DnsmasqConfig(&DnsmasqConfigOptions{Version: "2.48"}); err == nil {
+		t.Errorf("Expected DHCPv6 to need dnsmasq 2.64")
+	}
+}
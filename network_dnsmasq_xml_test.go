@@ -0,0 +1,109 @@
+//go:build xmlroundtrip
+// +build xmlroundtrip
+
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var (
+	dnsmasqRestricted = DnsmasqConfigOptions{Version: "2.48"}
+	dnsmasqFull       = DnsmasqConfigOptions{Version: "2.63"}
+	dnsmasqDHCPv6     = DnsmasqConfigOptions{Version: "2.64"}
+)
+
+// Mirrors the capabilities used by libvirt's networkxml2conftest,
+// with anything not listed there using dnsmasqDHCPv6
+var dnsmasqFixtureOptions = map[string]*DnsmasqConfigOptions{
+	"isolated-network":                    &dnsmasqRestricted,
+	"netboot-network":                     &dnsmasqRestricted,
+	"netboot-proxy-network":               &dnsmasqRestricted,
+	"nat-network-dns-srv-record-minimal":  &dnsmasqRestricted,
+	"nat-network-name-with-quotes":        &dnsmasqRestricted,
+	"routed-network":                      &dnsmasqFull,
+	"routed-network-no-dns":               &dnsmasqFull,
+	"open-network":                        &dnsmasqFull,
+	"nat-network-dns-txt-record":          &dnsmasqFull,
+	"nat-network-dns-srv-record":          &dnsmasqFull,
+	"nat-network-dns-hosts":               &dnsmasqFull,
+	"nat-network-dns-forward-plain":       &dnsmasqFull,
+	"nat-network-dns-forwarders":          &dnsmasqFull,
+	"nat-network-dns-forwarder-no-resolv": &dnsmasqFull,
+	"nat-network-dns-local-domain":        &dnsmasqFull,
+}
+
+func TestNetworkDnsmasqFixtures(t *testing.T) {
+	syncGit(t)
+
+	dir := "testdata/libvirt/tests/networkxml2confdata"
+	files, err := filepath.Glob(filepath.Join(dir, "*.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	compared := 0
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".xml")
+		want, err := ioutil.ReadFile(filepath.Join(dir, name+".conf"))
+		if err != nil {
+			continue
+		}
+
+		xml, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		net := &Network{}
+		if err := net.Unmarshal(string(xml)); err != nil {
+			t.Fatal(err)
+		}
+
+		opts, ok := dnsmasqFixtureOptions[name]
+		if !ok {
+			opts = &dnsmasqDHCPv6
+		}
+
+		compared++
+		cfg, err := net.DnsmasqConfig(opts)
+		if err != nil {
+			t.Errorf("%s: %s", file, err)
+			continue
+		}
+		if cfg.Conf != string(want) {
+			t.Errorf("%s: bad dnsmasq conf:\n%s\n does not match\n%s", file, cfg.Conf, want)
+		}
+	}
+	if compared == 0 {
+		t.Fatalf("No dnsmasq fixtures found in %s", dir)
+	}
+}
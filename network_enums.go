@@ -0,0 +1,90 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+// NetworkForwardMode is the network forward mode attribute
+type NetworkForwardMode string
+
+const (
+	NetworkForwardModeNAT         NetworkForwardMode = "nat"
+	NetworkForwardModeRoute       NetworkForwardMode = "route"
+	NetworkForwardModeOpen        NetworkForwardMode = "open"
+	NetworkForwardModeBridge      NetworkForwardMode = "bridge"
+	NetworkForwardModePrivate     NetworkForwardMode = "private"
+	NetworkForwardModeVEPA        NetworkForwardMode = "vepa"
+	NetworkForwardModePassthrough NetworkForwardMode = "passthrough"
+	NetworkForwardModeHostdev     NetworkForwardMode = "hostdev"
+)
+
+// IsValid reports whether v is one of the NetworkForwardMode constants
+func (v NetworkForwardMode) IsValid() bool {
+	switch v {
+	case NetworkForwardModeNAT, NetworkForwardModeRoute,
+		NetworkForwardModeOpen, NetworkForwardModeBridge,
+		NetworkForwardModePrivate, NetworkForwardModeVEPA,
+		NetworkForwardModePassthrough, NetworkForwardModeHostdev:
+		return true
+	}
+	return false
+}
+
+// NetworkIPFamily is the family attribute of a network ip element
+type NetworkIPFamily string
+
+const (
+	NetworkIPFamilyIPv4 NetworkIPFamily = "ipv4"
+	NetworkIPFamilyIPv6 NetworkIPFamily = "ipv6"
+)
+
+// IsValid reports whether v is one of the NetworkIPFamily constants
+func (v NetworkIPFamily) IsValid() bool {
+	switch v {
+	case NetworkIPFamilyIPv4, NetworkIPFamilyIPv6:
+		return true
+	}
+	return false
+}
+
+// NetworkVirtualPortType is the virtualport type attribute
+type NetworkVirtualPortType string
+
+const (
+	NetworkVirtualPortType8021Qbg     NetworkVirtualPortType = "802.1Qbg"
+	NetworkVirtualPortType8021Qbh     NetworkVirtualPortType = "802.1Qbh"
+	NetworkVirtualPortTypeOpenVSwitch NetworkVirtualPortType = "openvswitch"
+	NetworkVirtualPortTypeMidoNet     NetworkVirtualPortType = "midonet"
+)
+
+// IsValid reports whether v is one of the NetworkVirtualPortType constants
+func (v NetworkVirtualPortType) IsValid() bool {
+	switch v {
+	case NetworkVirtualPortType8021Qbg, NetworkVirtualPortType8021Qbh,
+		NetworkVirtualPortTypeOpenVSwitch,
+		NetworkVirtualPortTypeMidoNet:
+		return true
+	}
+	return false
+}
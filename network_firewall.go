@@ -0,0 +1,666 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"net"
+)
+
+// NetworkFirewallBackend selects the tools that a network's firewall
+// rules are generated for
+type NetworkFirewallBackend string
+
+const (
+	NetworkFirewallBackendIPTables NetworkFirewallBackend = "iptables"
+	NetworkFirewallBackendNftables NetworkFirewallBackend = "nftables"
+)
+
+// NetworkFirewallOptions controls how a network's firewall rules are
+// generated
+type NetworkFirewallOptions struct {
+	// Tools to generate commands for, defaulting to iptables and
+	// ip6tables
+	Backend NetworkFirewallBackend
+	// Whether to first create the private chains shared by all
+	// networks, as libvirt does when it starts the first network
+	Setup bool
+}
+
+// NetworkFirewall is the sequence of commands which would set up the
+// firewall for a network
+type NetworkFirewall struct {
+	Commands [][]string
+}
+
+// String formats the commands one per line, quoted for the shell
+func (f *NetworkFirewall) String() string {
+	return formatFirewallCommands(f.Commands)
+}
+
+// networkFirewallBuilder emits the rules making up a network's
+// firewall, following the helpers in libvirt's network driver.
+// Rules are inserted at the top of their chain, so a later rule
+// takes precedence over an earlier one
+type networkFirewallBuilder interface {
+	setup()
+	input(ipv6 bool, iface string, proto string, port uint)
+	output(ipv6 bool, iface string, proto string, port uint)
+	forwardRejectOut(ipv6 bool, iface string)
+	forwardRejectIn(ipv6 bool, iface string)
+	forwardAllowCross(ipv6 bool, iface string)
+	forwardAllowOut(ipv6 bool, network, iface, physdev string)
+	forwardAllowRelatedIn(ipv6 bool, network, iface, physdev string)
+	forwardAllowIn(ipv6 bool, network, iface, physdev string)
+	masquerade(ipv6 bool, network, physdev, proto string, nat *networkNAT)
+	dontMasquerade(ipv6 bool, network, physdev, dest string)
+	fixChecksum(iface string, port uint)
+	commands() ([][]string, error)
+}
+
+// networkNAT is the public address and port range used for NAT
+type networkNAT struct {
+	addrStart string
+	addrEnd   string
+	portStart uint
+	portEnd   uint
+}
+
+// portRange returns the source ports used for a protocol, which
+// libvirt restricts to unprivileged ports by default
+func (n *networkNAT) portRange() (string, error) {
+	start, end := n.portStart, n.portEnd
+	if start == 0 && end == 0 {
+		start, end = 1024, 65535
+	}
+	if start >= end || end > 65535 {
+		return "", fmt.Errorf("Invalid port range '%d-%d'", start, end)
+	}
+	return fmt.Sprintf("%d-%d", start, end), nil
+}
+
+// toSource returns the SNAT target, or "" to masquerade
+func (n *networkNAT) toSource(proto string) (string, error) {
+	if n.addrStart == "" {
+		return "", nil
+	}
+	ret := n.addrStart
+	if n.addrEnd != "" {
+		ret += "-" + n.addrEnd
+	}
+	if proto != "" {
+		ports, err := n.portRange()
+		if err != nil {
+			return "", err
+		}
+		ret += ":" + ports
+	}
+	return ret, nil
+}
+
+type networkIPTables struct {
+	cmds [][]string
+	err  error
+}
+
+var _ networkFirewallBuilder = &networkIPTables{}
+
+func (b *networkIPTables) add(ipv6 bool, args ...string) {
+	tool := "iptables"
+	if ipv6 {
+		tool = "ip6tables"
+	}
+	b.cmds = append(b.cmds, append([]string{tool}, args...))
+}
+
+// networkIPTablesChains are the private chains libvirt creates, and
+// the builtin chains which jump to them
+var networkIPTablesChains = []struct {
+	table  string
+	parent string
+	chain  string
+}{
+	{"filter", "INPUT", "LIBVIRT_INP"},
+	{"filter", "OUTPUT", "LIBVIRT_OUT"},
+	{"filter", "FORWARD", "LIBVIRT_FWO"},
+	{"filter", "FORWARD", "LIBVIRT_FWI"},
+	{"filter", "FORWARD", "LIBVIRT_FWX"},
+	{"nat", "POSTROUTING", "LIBVIRT_PRT"},
+	{"mangle", "POSTROUTING", "LIBVIRT_PRT"},
+}
+
+func (b *networkIPTables) setup() {
+	for _, ipv6 := range []bool{false, true} {
+		for _, chain := range networkIPTablesChains {
+			b.add(ipv6, "--table", chain.table, "--new-chain", chain.chain)
+			b.add(ipv6, "--table", chain.table, "--insert", chain.parent, "--jump", chain.chain)
+		}
+	}
+}
+
+func (b *networkIPTables) input(ipv6 bool, iface string, proto string, port uint) {
+	b.add(ipv6, "--table", "filter", "--insert", "LIBVIRT_INP",
+		"--in-interface", iface, "--protocol", proto,
+		"--destination-port", fmt.Sprint(port), "--jump", "ACCEPT")
+}
+
+func (b *networkIPTables) output(ipv6 bool, iface string, proto string, port uint) {
+	b.add(ipv6, "--table", "filter", "--insert", "LIBVIRT_OUT",
+		"--out-interface", iface, "--protocol", proto,
+		"--destination-port", fmt.Sprint(port), "--jump", "ACCEPT")
+}
+
+func (b *networkIPTables) forwardRejectOut(ipv6 bool, iface string) {
+	b.add(ipv6, "--table", "filter", "--insert", "LIBVIRT_FWO",
+		"--in-interface", iface, "--jump", "REJECT")
+}
+
+func (b *networkIPTables) forwardRejectIn(ipv6 bool, iface string) {
+	b.add(ipv6, "--table", "filter", "--insert", "LIBVIRT_FWI",
+		"--out-interface", iface, "--jump", "REJECT")
+}
+
+func (b *networkIPTables) forwardAllowCross(ipv6 bool, iface string) {
+	b.add(ipv6, "--table", "filter", "--insert", "LIBVIRT_FWX",
+		"--in-interface", iface, "--out-interface", iface, "--jump", "ACCEPT")
+}
+
+func (b *networkIPTables) forwardAllowOut(ipv6 bool, network, iface, physdev string) {
+	args := []string{"--table", "filter", "--insert", "LIBVIRT_FWO",
+		"--source", network, "--in-interface", iface}
+	if physdev != "" {
+		args = append(args, "--out-interface", physdev)
+	}
+	b.add(ipv6, append(args, "--jump", "ACCEPT")...)
+}
+
+func (b *networkIPTables) forwardAllowRelatedIn(ipv6 bool, network, iface, physdev string) {
+	args := []string{"--table", "filter", "--insert", "LIBVIRT_FWI",
+		"--destination", network}
+	if physdev != "" {
+		args = append(args, "--in-interface", physdev)
+	}
+	b.add(ipv6, append(args, "--out-interface", iface,
+		"--match", "conntrack", "--ctstate", "ESTABLISHED,RELATED",
+		"--jump", "ACCEPT")...)
+}
+
+func (b *networkIPTables) forwardAllowIn(ipv6 bool, network, iface, physdev string) {
+	args := []string{"--table", "filter", "--insert", "LIBVIRT_FWI",
+		"--destination", network}
+	if physdev != "" {
+		args = append(args, "--in-interface", physdev)
+	}
+	b.add(ipv6, append(args, "--out-interface", iface, "--jump", "ACCEPT")...)
+}
+
+func (b *networkIPTables) masquerade(ipv6 bool, network, physdev, proto string, nat *networkNAT) {
+	args := []string{"--table", "nat", "--insert", "LIBVIRT_PRT",
+		"--source", network}
+	if proto != "" {
+		args = append(args, "-p", proto)
+	}
+	args = append(args, "!", "--destination", network)
+	if physdev != "" {
+		args = append(args, "--out-interface", physdev)
+	}
+
+	src, err := nat.toSource(proto)
+	if err != nil {
+		b.err = err
+		return
+	}
+	if src != "" {
+		args = append(args, "--jump", "SNAT", "--to-source", src)
+	} else {
+		args = append(args, "--jump", "MASQUERADE")
+		if proto != "" {
+			ports, err := nat.portRange()
+			if err != nil {
+				b.err = err
+				return
+			}
+			args = append(args, "--to-ports", ports)
+		}
+	}
+	b.add(ipv6, args...)
+}
+
+func (b *networkIPTables) dontMasquerade(ipv6 bool, network, physdev, dest string) {
+	args := []string{"--table", "nat", "--insert", "LIBVIRT_PRT"}
+	if physdev != "" {
+		args = append(args, "--out-interface", physdev)
+	}
+	b.add(ipv6, append(args, "--source", network, "--destination", dest,
+		"--jump", "RETURN")...)
+}
+
+func (b *networkIPTables) fixChecksum(iface string, port uint) {
+	b.add(false, "--table", "mangle", "--insert", "LIBVIRT_PRT",
+		"--out-interface", iface, "--protocol", "udp",
+		"--destination-port", fmt.Sprint(port),
+		"--jump", "CHECKSUM", "--checksum-fill")
+}
+
+func (b *networkIPTables) commands() ([][]string, error) {
+	return b.cmds, b.err
+}
+
+// networkNftablesTable is the table holding the rules of all
+// networks, in both the ip and ip6 families
+const networkNftablesTable = "libvirt_network"
+
+// networkNftables generates the equivalent nftables rules. As an
+// accept verdict in one table cannot override a reject in another,
+// libvirt does not open the input and output paths for DHCP and DNS,
+// nor fix up checksums, so those rules are skipped
+type networkNftables struct {
+	cmds [][]string
+	err  error
+}
+
+var _ networkFirewallBuilder = &networkNftables{}
+
+func networkNftablesFamily(ipv6 bool) string {
+	if ipv6 {
+		return "ip6"
+	}
+	return "ip"
+}
+
+func (b *networkNftables) insert(ipv6 bool, chain string, expr ...string) {
+	cmd := []string{"nft", "insert", "rule", networkNftablesFamily(ipv6),
+		networkNftablesTable, chain}
+	b.cmds = append(b.cmds, append(cmd, expr...))
+}
+
+// networkNftablesChains are the base chains of the table, and the
+// chains they jump to
+var networkNftablesChains = []struct {
+	chain  string
+	spec   string
+	chains []string
+}{
+	{"forward", "{ type filter hook forward priority filter; policy accept; }",
+		[]string{"guest_output", "guest_input", "guest_cross"}},
+	{"postrouting", "{ type nat hook postrouting priority srcnat; policy accept; }",
+		[]string{"guest_nat"}},
+}
+
+func (b *networkNftables) setup() {
+	for _, ipv6 := range []bool{false, true} {
+		family := networkNftablesFamily(ipv6)
+		b.cmds = append(b.cmds, []string{"nft", "add", "table", family, networkNftablesTable})
+		for _, base := range networkNftablesChains {
+			b.cmds = append(b.cmds, []string{"nft", "add", "chain", family,
+				networkNftablesTable, base.chain, base.spec})
+			for _, chain := range base.chains {
+				b.cmds = append(b.cmds, []string{"nft", "add", "chain", family,
+					networkNftablesTable, chain})
+				b.insert(ipv6, base.chain, "counter", "jump", chain)
+			}
+		}
+	}
+}
+
+func (b *networkNftables) input(ipv6 bool, iface string, proto string, port uint) {
+}
+
+func (b *networkNftables) output(ipv6 bool, iface string, proto string, port uint) {
+}
+
+func (b *networkNftables) forwardRejectOut(ipv6 bool, iface string) {
+	b.insert(ipv6, "guest_output", "iifname", iface, "counter", "reject")
+}
+
+func (b *networkNftables) forwardRejectIn(ipv6 bool, iface string) {
+	b.insert(ipv6, "guest_input", "oifname", iface, "counter", "reject")
+}
+
+func (b *networkNftables) forwardAllowCross(ipv6 bool, iface string) {
+	b.insert(ipv6, "guest_cross", "iifname", iface, "oifname", iface, "counter", "accept")
+}
+
+func (b *networkNftables) forwardAllowOut(ipv6 bool, network, iface, physdev string) {
+	expr := []string{networkNftablesFamily(ipv6), "saddr", network, "iifname", iface}
+	if physdev != "" {
+		expr = append(expr, "oifname", physdev)
+	}
+	b.insert(ipv6, "guest_output", append(expr, "counter", "accept")...)
+}
+
+func (b *networkNftables) forwardAllowRelatedIn(ipv6 bool, network, iface, physdev string) {
+	expr := []string{networkNftablesFamily(ipv6), "daddr", network}
+	if physdev != "" {
+		expr = append(expr, "iifname", physdev)
+	}
+	b.insert(ipv6, "guest_input", append(expr, "oifname", iface,
+		"ct", "state", "related,established", "counter", "accept")...)
+}
+
+func (b *networkNftables) forwardAllowIn(ipv6 bool, network, iface, physdev string) {
+	expr := []string{networkNftablesFamily(ipv6), "daddr", network}
+	if physdev != "" {
+		expr = append(expr, "iifname", physdev)
+	}
+	b.insert(ipv6, "guest_input", append(expr, "oifname", iface, "counter", "accept")...)
+}
+
+func (b *networkNftables) masquerade(ipv6 bool, network, physdev, proto string, nat *networkNAT) {
+	family := networkNftablesFamily(ipv6)
+	expr := []string{family, "saddr", network}
+	if proto != "" {
+		expr = append(expr, "meta", "l4proto", proto)
+	}
+	expr = append(expr, family, "daddr", "!=", network)
+	if physdev != "" {
+		expr = append(expr, "oifname", physdev)
+	}
+	expr = append(expr, "counter")
+
+	src, err := nat.toSource(proto)
+	if err != nil {
+		b.err = err
+		return
+	}
+	if src != "" {
+		expr = append(expr, "snat", "to", src)
+	} else {
+		expr = append(expr, "masquerade")
+		if proto != "" {
+			ports, err := nat.portRange()
+			if err != nil {
+				b.err = err
+				return
+			}
+			expr = append(expr, "to", ":"+ports)
+		}
+	}
+	b.insert(ipv6, "guest_nat", expr...)
+}
+
+func (b *networkNftables) dontMasquerade(ipv6 bool, network, physdev, dest string) {
+	family := networkNftablesFamily(ipv6)
+	expr := []string{family, "saddr", network, family, "daddr", dest}
+	if physdev != "" {
+		expr = append(expr, "oifname", physdev)
+	}
+	b.insert(ipv6, "guest_nat", append(expr, "counter", "return")...)
+}
+
+func (b *networkNftables) fixChecksum(iface string, port uint) {
+}
+
+func (b *networkNftables) commands() ([][]string, error) {
+	return b.cmds, b.err
+}
+
+// networkFirewallNetwork returns the network containing ip, in
+// address/prefix form
+func networkFirewallNetwork(ip *NetworkIP) (string, error) {
+	prefix, err := networkIPPrefix(ip)
+	if err != nil {
+		return "", err
+	}
+	addr := net.ParseIP(ip.Address)
+	if addr == nil {
+		return "", fmt.Errorf("Invalid network address '%s'", ip.Address)
+	}
+	bits := 128
+	if !networkIPIsV6(ip) {
+		addr = addr.To4()
+		bits = 32
+	}
+	if addr == nil || prefix > bits {
+		return "", fmt.Errorf("Invalid prefix or netmask for '%s'", ip.Address)
+	}
+	network := addr.Mask(net.CIDRMask(prefix, bits))
+	return fmt.Sprintf("%s/%d", network, prefix), nil
+}
+
+// networkFirewallNAT returns the public address and port range of a
+// NAT network. libvirt only supports a single range of each
+func networkFirewallNAT(fwd *NetworkForward) (*networkNAT, error) {
+	ret := &networkNAT{}
+	if fwd.NAT == nil {
+		return ret, nil
+	}
+	if len(fwd.NAT.Addresses) > 1 {
+		return nil, fmt.Errorf("Only one NAT address range is supported")
+	}
+	if len(fwd.NAT.Ports) > 1 {
+		return nil, fmt.Errorf("Only one NAT port range is supported")
+	}
+	if len(fwd.NAT.Addresses) != 0 {
+		addr := fwd.NAT.Addresses[0]
+		// Only IPv4 addresses are used for SNAT
+		start := net.ParseIP(addr.Start)
+		if start == nil {
+			return nil, fmt.Errorf("Invalid NAT start address '%s'", addr.Start)
+		}
+		if start.To4() != nil {
+			ret.addrStart = start.String()
+			if end := net.ParseIP(addr.End); end != nil && end.To4() != nil {
+				ret.addrEnd = end.String()
+			} else if addr.End != "" {
+				return nil, fmt.Errorf("Invalid NAT end address '%s'", addr.End)
+			}
+		}
+	}
+	if len(fwd.NAT.Ports) != 0 {
+		ret.portStart = fwd.NAT.Ports[0].Start
+		ret.portEnd = fwd.NAT.Ports[0].End
+	}
+	return ret, nil
+}
+
+// networkFirewallForwardIf returns the device that a network forwards
+// traffic through, if it is restricted to one
+func networkFirewallForwardIf(fwd *NetworkForward) string {
+	if fwd.Dev != "" {
+		return fwd.Dev
+	}
+	if len(fwd.Interfaces) != 0 {
+		return fwd.Interfaces[0].Dev
+	}
+	return ""
+}
+
+// networkFirewallRules adds the rules for a network, in the same
+// order as libvirt's network driver
+func networkFirewallRules(s *Network, b networkFirewallBuilder) error {
+	bridge := ""
+	if s.Bridge != nil {
+		bridge = s.Bridge.Name
+	}
+	if bridge == "" {
+		return fmt.Errorf("Network '%s' has no bridge name", s.Name)
+	}
+
+	mode := ""
+	if s.Forward != nil {
+		mode = s.Forward.Mode
+		if mode == "" {
+			mode = "nat"
+		}
+	}
+
+	var haveIPv6 bool
+	// The first IPv4 address with DHCP or TFTP enabled, and the
+	// first with DHCP enabled
+	var tftp, dhcp *NetworkIP
+	for i := range s.IPs {
+		ip := &s.IPs[i]
+		if networkIPIsV6(ip) {
+			haveIPv6 = true
+			continue
+		}
+		hasDHCP := ip.DHCP != nil && (len(ip.DHCP.Ranges) != 0 || len(ip.DHCP.Hosts) != 0)
+		if tftp == nil && (hasDHCP || (ip.TFTP != nil && ip.TFTP.Root != "")) {
+			tftp = ip
+		}
+		if dhcp == nil && hasDHCP {
+			dhcp = ip
+		}
+	}
+
+	// Allow DHCP and DNS requests through to dnsmasq, and back out
+	b.input(false, bridge, "tcp", 67)
+	b.input(false, bridge, "udp", 67)
+	b.output(false, bridge, "tcp", 68)
+	b.output(false, bridge, "udp", 68)
+
+	b.input(false, bridge, "tcp", 53)
+	b.input(false, bridge, "udp", 53)
+	b.output(false, bridge, "tcp", 53)
+	b.output(false, bridge, "udp", 53)
+
+	if tftp != nil && tftp.TFTP != nil && tftp.TFTP.Root != "" {
+		b.input(false, bridge, "udp", 69)
+		b.output(false, bridge, "udp", 69)
+	}
+
+	// Block forwarding to and from the bridge, except between guests
+	b.forwardRejectOut(false, bridge)
+	b.forwardRejectIn(false, bridge)
+	b.forwardAllowCross(false, bridge)
+
+	if haveIPv6 || s.IPv6 == "yes" {
+		b.forwardRejectOut(true, bridge)
+		b.forwardRejectIn(true, bridge)
+		b.forwardAllowCross(true, bridge)
+
+		if haveIPv6 {
+			b.input(true, bridge, "tcp", 53)
+			b.input(true, bridge, "udp", 53)
+			b.output(true, bridge, "tcp", 53)
+			b.output(true, bridge, "udp", 53)
+
+			b.input(true, bridge, "udp", 547)
+			b.output(true, bridge, "udp", 546)
+		}
+	}
+
+	if mode == "nat" || mode == "route" {
+		if err := networkFirewallForwardRules(s, b, mode, bridge); err != nil {
+			return err
+		}
+	}
+
+	// Fix up the checksum of DHCP responses to guests, which some
+	// older DHCP clients reject otherwise
+	if dhcp != nil {
+		b.fixChecksum(bridge, 68)
+	}
+	return nil
+}
+
+// networkFirewallForwardRules adds the rules which allow each of a
+// network's subnets to be masqueraded or routed
+func networkFirewallForwardRules(s *Network, b networkFirewallBuilder, mode, bridge string) error {
+	physdev := networkFirewallForwardIf(s.Forward)
+	nat, err := networkFirewallNAT(s.Forward)
+	if err != nil {
+		return err
+	}
+	natIPv6 := s.Forward.NAT != nil && s.Forward.NAT.IPv6 == "yes"
+
+	for i := range s.IPs {
+		ip := &s.IPs[i]
+		ipv6 := networkIPIsV6(ip)
+		network, err := networkFirewallNetwork(ip)
+		if err != nil {
+			return err
+		}
+
+		// IPv6 is routed rather than masqueraded unless NAT
+		// is explicitly enabled for it
+		if mode == "route" || (ipv6 && !natIPv6) {
+			b.forwardAllowOut(ipv6, network, bridge, physdev)
+			b.forwardAllowIn(ipv6, network, bridge, physdev)
+			continue
+		}
+
+		b.forwardAllowOut(ipv6, network, bridge, physdev)
+		b.forwardAllowRelatedIn(ipv6, network, bridge, physdev)
+
+		// Masquerade all traffic, then restrict the source ports
+		// of UDP and TCP
+		b.masquerade(ipv6, network, physdev, "", nat)
+		b.masquerade(ipv6, network, physdev, "udp", nat)
+		b.masquerade(ipv6, network, physdev, "tcp", nat)
+
+		// Exempt the local broadcast and multicast addresses
+		if !ipv6 {
+			b.dontMasquerade(ipv6, network, physdev, "255.255.255.255/32")
+			b.dontMasquerade(ipv6, network, physdev, "224.0.0.0/24")
+		} else {
+			b.dontMasquerade(ipv6, network, physdev, "ff02::/16")
+		}
+	}
+	return nil
+}
+
+// Firewall generates the commands which would set up the firewall
+// for a virtual network, in the same way as libvirt's network driver.
+// Networks in open mode leave the firewall alone, so have no commands
+func (s *Network) Firewall(opts *NetworkFirewallOptions) (*NetworkFirewall, error) {
+	if opts == nil {
+		opts = &NetworkFirewallOptions{}
+	}
+
+	var b networkFirewallBuilder
+	switch opts.Backend {
+	case "", NetworkFirewallBackendIPTables:
+		b = &networkIPTables{}
+	case NetworkFirewallBackendNftables:
+		b = &networkNftables{}
+	default:
+		return nil, fmt.Errorf("Unknown firewall backend '%s'", opts.Backend)
+	}
+
+	if s.Forward != nil {
+		switch s.Forward.Mode {
+		case "", "nat", "route":
+		case "open":
+			return &NetworkFirewall{}, nil
+		default:
+			return nil, fmt.Errorf("Network forward mode '%s' has no firewall rules", s.Forward.Mode)
+		}
+	}
+
+	if opts.Setup {
+		b.setup()
+	}
+	if err := networkFirewallRules(s, b); err != nil {
+		return nil, err
+	}
+	cmds, err := b.commands()
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkFirewall{Commands: cmds}, nil
+}
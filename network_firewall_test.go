@@ -0,0 +1,357 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+var networkFirewallTestData = []struct {
+	Network  string
+	Backend  NetworkFirewallBackend
+	Expected []string
+}{
+	{
+		Network: strings.Join([]string{
+			`<network>`,
+			`  <name>default</name>`,
+			`  <forward mode='nat' dev='eth0'>`,
+			`    <nat>`,
+			`      <address start='10.0.0.1' end='10.0.0.5'/>`,
+			`      <port start='2000' end='3000'/>`,
+			`    </nat>`,
+			`  </forward>`,
+			`  <bridge name='virbr0'/>`,
+			`  <ip address='192.168.122.1' netmask='255.255.255.0'>`,
+			`    <dhcp>`,
+			`      <range start='192.168.122.2' end='192.168.122.254'/>`,
+			`    </dhcp>`,
+			`  </ip>`,
+			`</network>`,
+		}, "\n"),
+		Expected: []string{
+			`iptables --table filter --insert LIBVIRT_INP --in-interface virbr0 --protocol tcp --destination-port 67 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_INP --in-interface virbr0 --protocol udp --destination-port 67 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_OUT --out-interface virbr0 --protocol tcp --destination-port 68 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_OUT --out-interface virbr0 --protocol udp --destination-port 68 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_INP --in-interface virbr0 --protocol tcp --destination-port 53 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_INP --in-interface virbr0 --protocol udp --destination-port 53 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_OUT --out-interface virbr0 --protocol tcp --destination-port 53 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_OUT --out-interface virbr0 --protocol udp --destination-port 53 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_FWO --in-interface virbr0 --jump REJECT`,
+			`iptables --table filter --insert LIBVIRT_FWI --out-interface virbr0 --jump REJECT`,
+			`iptables --table filter --insert LIBVIRT_FWX --in-interface virbr0 --out-interface virbr0 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_FWO --source 192.168.122.0/24 --in-interface virbr0 --out-interface eth0 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_FWI --destination 192.168.122.0/24 --in-interface eth0 --out-interface virbr0 --match conntrack --ctstate ESTABLISHED,RELATED --jump ACCEPT`,
+			`iptables --table nat --insert LIBVIRT_PRT --source 192.168.122.0/24 '!' --destination 192.168.122.0/24 --out-interface eth0 --jump SNAT --to-source 10.0.0.1-10.0.0.5`,
+			`iptables --table nat --insert LIBVIRT_PRT --source 192.168.122.0/24 -p udp '!' --destination 192.168.122.0/24 --out-interface eth0 --jump SNAT --to-source 10.0.0.1-10.0.0.5:2000-3000`,
+			`iptables --table nat --insert LIBVIRT_PRT --source 192.168.122.0/24 -p tcp '!' --destination 192.168.122.0/24 --out-interface eth0 --jump SNAT --to-source 10.0.0.1-10.0.0.5:2000-3000`,
+			`iptables --table nat --insert LIBVIRT_PRT --out-interface eth0 --source 192.168.122.0/24 --destination 255.255.255.255/32 --jump RETURN`,
+			`iptables --table nat --insert LIBVIRT_PRT --out-interface eth0 --source 192.168.122.0/24 --destination 224.0.0.0/24 --jump RETURN`,
+			`iptables --table mangle --insert LIBVIRT_PRT --out-interface virbr0 --protocol udp --destination-port 68 --jump CHECKSUM --checksum-fill`,
+		},
+	},
+	{
+		Network: strings.Join([]string{
+			`<network>`,
+			`  <name>default</name>`,
+			`  <forward/>`,
+			`  <bridge name='virbr0'/>`,
+			`  <ip address='192.168.122.1' prefix='24'>`,
+			`    <tftp root='/var/lib/tftpboot'/>`,
+			`  </ip>`,
+			`  <ip family='ipv6' address='2001:db8:ca2:2::1' prefix='64'/>`,
+			`</network>`,
+		}, "\n"),
+		Expected: []string{
+			`iptables --table filter --insert LIBVIRT_INP --in-interface virbr0 --protocol tcp --destination-port 67 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_INP --in-interface virbr0 --protocol udp --destination-port 67 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_OUT --out-interface virbr0 --protocol tcp --destination-port 68 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_OUT --out-interface virbr0 --protocol udp --destination-port 68 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_INP --in-interface virbr0 --protocol tcp --destination-port 53 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_INP --in-interface virbr0 --protocol udp --destination-port 53 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_OUT --out-interface virbr0 --protocol tcp --destination-port 53 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_OUT --out-interface virbr0 --protocol udp --destination-port 53 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_INP --in-interface virbr0 --protocol udp --destination-port 69 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_OUT --out-interface virbr0 --protocol udp --destination-port 69 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_FWO --in-interface virbr0 --jump REJECT`,
+			`iptables --table filter --insert LIBVIRT_FWI --out-interface virbr0 --jump REJECT`,
+			`iptables --table filter --insert LIBVIRT_FWX --in-interface virbr0 --out-interface virbr0 --jump ACCEPT`,
+			`ip6tables --table filter --insert LIBVIRT_FWO --in-interface virbr0 --jump REJECT`,
+			`ip6tables --table filter --insert LIBVIRT_FWI --out-interface virbr0 --jump REJECT`,
+			`ip6tables --table filter --insert LIBVIRT_FWX --in-interface virbr0 --out-interface virbr0 --jump ACCEPT`,
+			`ip6tables --table filter --insert LIBVIRT_INP --in-interface virbr0 --protocol tcp --destination-port 53 --jump ACCEPT`,
+			`ip6tables --table filter --insert LIBVIRT_INP --in-interface virbr0 --protocol udp --destination-port 53 --jump ACCEPT`,
+			`ip6tables --table filter --insert LIBVIRT_OUT --out-interface virbr0 --protocol tcp --destination-port 53 --jump ACCEPT`,
+			`ip6tables --table filter --insert LIBVIRT_OUT --out-interface virbr0 --protocol udp --destination-port 53 --jump ACCEPT`,
+			`ip6tables --table filter --insert LIBVIRT_INP --in-interface virbr0 --protocol udp --destination-port 547 --jump ACCEPT`,
+			`ip6tables --table filter --insert LIBVIRT_OUT --out-interface virbr0 --protocol udp --destination-port 546 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_FWO --source 192.168.122.0/24 --in-interface virbr0 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_FWI --destination 192.168.122.0/24 --out-interface virbr0 --match conntrack --ctstate ESTABLISHED,RELATED --jump ACCEPT`,
+			`iptables --table nat --insert LIBVIRT_PRT --source 192.168.122.0/24 '!' --destination 192.168.122.0/24 --jump MASQUERADE`,
+			`iptables --table nat --insert LIBVIRT_PRT --source 192.168.122.0/24 -p udp '!' --destination 192.168.122.0/24 --jump MASQUERADE --to-ports 1024-65535`,
+			`iptables --table nat --insert LIBVIRT_PRT --source 192.168.122.0/24 -p tcp '!' --destination 192.168.122.0/24 --jump MASQUERADE --to-ports 1024-65535`,
+			`iptables --table nat --insert LIBVIRT_PRT --source 192.168.122.0/24 --destination 255.255.255.255/32 --jump RETURN`,
+			`iptables --table nat --insert LIBVIRT_PRT --source 192.168.122.0/24 --destination 224.0.0.0/24 --jump RETURN`,
+			`ip6tables --table filter --insert LIBVIRT_FWO --source 2001:db8:ca2:2::/64 --in-interface virbr0 --jump ACCEPT`,
+			`ip6tables --table filter --insert LIBVIRT_FWI --destination 2001:db8:ca2:2::/64 --out-interface virbr0 --jump ACCEPT`,
+		},
+	},
+	{
+		Network: strings.Join([]string{
+			`<network>`,
+			`  <name>masq6</name>`,
+			`  <forward mode='nat'>`,
+			`    <nat ipv6='yes'>`,
+			`      <port start='4000' end='5000'/>`,
+			`    </nat>`,
+			`  </forward>`,
+			`  <bridge name='virbr1'/>`,
+			`  <ip address='192.168.120.1' prefix='24'/>`,
+			`  <ip family='ipv6' address='2001:db8:ca2:3::1' prefix='64'/>`,
+			`</network>`,
+		}, "\n"),
+		Backend: NetworkFirewallBackendNftables,
+		Expected: []string{
+			`nft insert rule ip libvirt_network guest_output iifname virbr1 counter reject`,
+			`nft insert rule ip libvirt_network guest_input oifname virbr1 counter reject`,
+			`nft insert rule ip libvirt_network guest_cross iifname virbr1 oifname virbr1 counter accept`,
+			`nft insert rule ip6 libvirt_network guest_output iifname virbr1 counter reject`,
+			`nft insert rule ip6 libvirt_network guest_input oifname virbr1 counter reject`,
+			`nft insert rule ip6 libvirt_network guest_cross iifname virbr1 oifname virbr1 counter accept`,
+			`nft insert rule ip libvirt_network guest_output ip saddr 192.168.120.0/24 iifname virbr1 counter accept`,
+			`nft insert rule ip libvirt_network guest_input ip daddr 192.168.120.0/24 oifname virbr1 ct state related,established counter accept`,
+			`nft insert rule ip libvirt_network guest_nat ip saddr 192.168.120.0/24 ip daddr '!=' 192.168.120.0/24 counter masquerade`,
+			`nft insert rule ip libvirt_network guest_nat ip saddr 192.168.120.0/24 meta l4proto udp ip daddr '!=' 192.168.120.0/24 counter masquerade to :4000-5000`,
+			`nft insert rule ip libvirt_network guest_nat ip saddr 192.168.120.0/24 meta l4proto tcp ip daddr '!=' 192.168.120.0/24 counter masquerade to :4000-5000`,
+			`nft insert rule ip libvirt_network guest_nat ip saddr 192.168.120.0/24 ip daddr 255.255.255.255/32 counter return`,
+			`nft insert rule ip libvirt_network guest_nat ip saddr 192.168.120.0/24 ip daddr 224.0.0.0/24 counter return`,
+			`nft insert rule ip6 libvirt_network guest_output ip6 saddr 2001:db8:ca2:3::/64 iifname virbr1 counter accept`,
+			`nft insert rule ip6 libvirt_network guest_input ip6 daddr 2001:db8:ca2:3::/64 oifname virbr1 ct state related,established counter accept`,
+			`nft insert rule ip6 libvirt_network guest_nat ip6 saddr 2001:db8:ca2:3::/64 ip6 daddr '!=' 2001:db8:ca2:3::/64 counter masquerade`,
+			`nft insert rule ip6 libvirt_network guest_nat ip6 saddr 2001:db8:ca2:3::/64 meta l4proto udp ip6 daddr '!=' 2001:db8:ca2:3::/64 counter masquerade to :4000-5000`,
+			`nft insert rule ip6 libvirt_network guest_nat ip6 saddr 2001:db8:ca2:3::/64 meta l4proto tcp ip6 daddr '!=' 2001:db8:ca2:3::/64 counter masquerade to :4000-5000`,
+			`nft insert rule ip6 libvirt_network guest_nat ip6 saddr 2001:db8:ca2:3::/64 ip6 daddr ff02::/16 counter return`,
+		},
+	},
+	{
+		Network: strings.Join([]string{
+			`<network>`,
+			`  <name>routed</name>`,
+			`  <forward mode='route'>`,
+			`    <interface dev='eth1'/>`,
+			`  </forward>`,
+			`  <bridge name='virbr2'/>`,
+			`  <ip address='10.10.0.1' netmask='255.255.0.0'/>`,
+			`</network>`,
+		}, "\n"),
+		Backend: NetworkFirewallBackendNftables,
+		Expected: []string{
+			`nft insert rule ip libvirt_network guest_output iifname virbr2 counter reject`,
+			`nft insert rule ip libvirt_network guest_input oifname virbr2 counter reject`,
+			`nft insert rule ip libvirt_network guest_cross iifname virbr2 oifname virbr2 counter accept`,
+			`nft insert rule ip libvirt_network guest_output ip saddr 10.10.0.0/16 iifname virbr2 oifname eth1 counter accept`,
+			`nft insert rule ip libvirt_network guest_input ip daddr 10.10.0.0/16 iifname eth1 oifname virbr2 counter accept`,
+		},
+	},
+	{
+		Network: strings.Join([]string{
+			`<network>`,
+			`  <name>isolated</name>`,
+			`  <bridge name='virbr4'/>`,
+			`  <ip address='10.30.0.1' prefix='24'>`,
+			`    <tftp/>`,
+			`  </ip>`,
+			`</network>`,
+		}, "\n"),
+		Expected: []string{
+			`iptables --table filter --insert LIBVIRT_INP --in-interface virbr4 --protocol tcp --destination-port 67 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_INP --in-interface virbr4 --protocol udp --destination-port 67 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_OUT --out-interface virbr4 --protocol tcp --destination-port 68 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_OUT --out-interface virbr4 --protocol udp --destination-port 68 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_INP --in-interface virbr4 --protocol tcp --destination-port 53 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_INP --in-interface virbr4 --protocol udp --destination-port 53 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_OUT --out-interface virbr4 --protocol tcp --destination-port 53 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_OUT --out-interface virbr4 --protocol udp --destination-port 53 --jump ACCEPT`,
+			`iptables --table filter --insert LIBVIRT_FWO --in-interface virbr4 --jump REJECT`,
+			`iptables --table filter --insert LIBVIRT_FWI --out-interface virbr4 --jump REJECT`,
+			`iptables --table filter --insert LIBVIRT_FWX --in-interface virbr4 --out-interface virbr4 --jump ACCEPT`,
+		},
+	},
+	{
+		Network: strings.Join([]string{
+			`<network>`,
+			`  <name>open</name>`,
+			`  <forward mode='open'/>`,
+			`  <bridge name='virbr3'/>`,
+			`  <ip address='10.20.0.1' prefix='24'/>`,
+			`</network>`,
+		}, "\n"),
+	},
+}
+
+func TestNetworkFirewall(t *testing.T) {
+	for _, test := range networkFirewallTestData {
+		net := &Network{}
+		if err := net.Unmarshal(test.Network); err != nil {
+			t.Fatal(err)
+		}
+
+		fw, err := net.Firewall(&NetworkFirewallOptions{Backend: test.Backend})
+		if err != nil {
+			t.Errorf("%s: %s", net.Name, err)
+			continue
+		}
+
+		expect := ""
+		if len(test.Expected) != 0 {
+			expect = strings.Join(test.Expected, "\n") + "\n"
+		}
+		if fw.String() != expect {
+			t.Errorf("Bad firewall for %s:\n%s\n does not match\n%s", net.Name, fw.String(), expect)
+		}
+	}
+}
+
+func TestNetworkFirewallSetup(t *testing.T) {
+	net := &Network{
+		Name:   "isolated",
+		Bridge: &NetworkBridge{Name: "virbr9"},
+	}
+
+	var tests = []struct {
+		Backend  NetworkFirewallBackend
+		Expected []string
+	}{
+		{
+			Backend: NetworkFirewallBackendIPTables,
+			Expected: []string{
+				`iptables --table filter --new-chain LIBVIRT_INP`,
+				`iptables --table filter --insert INPUT --jump LIBVIRT_INP`,
+				`iptables --table filter --new-chain LIBVIRT_OUT`,
+				`iptables --table filter --insert OUTPUT --jump LIBVIRT_OUT`,
+				`iptables --table filter --new-chain LIBVIRT_FWO`,
+				`iptables --table filter --insert FORWARD --jump LIBVIRT_FWO`,
+				`iptables --table filter --new-chain LIBVIRT_FWI`,
+				`iptables --table filter --insert FORWARD --jump LIBVIRT_FWI`,
+				`iptables --table filter --new-chain LIBVIRT_FWX`,
+				`iptables --table filter --insert FORWARD --jump LIBVIRT_FWX`,
+				`iptables --table nat --new-chain LIBVIRT_PRT`,
+				`iptables --table nat --insert POSTROUTING --jump LIBVIRT_PRT`,
+				`iptables --table mangle --new-chain LIBVIRT_PRT`,
+				`iptables --table mangle --insert POSTROUTING --jump LIBVIRT_PRT`,
+				`ip6tables --table filter --new-chain LIBVIRT_INP`,
+				`ip6tables --table filter --insert INPUT --jump LIBVIRT_INP`,
+				`ip6tables --table filter --new-chain LIBVIRT_OUT`,
+				`ip6tables --table filter --insert OUTPUT --jump LIBVIRT_OUT`,
+				`ip6tables --table filter --new-chain LIBVIRT_FWO`,
+				`ip6tables --table filter --insert FORWARD --jump LIBVIRT_FWO`,
+				`ip6tables --table filter --new-chain LIBVIRT_FWI`,
+				`ip6tables --table filter --insert FORWARD --jump LIBVIRT_FWI`,
+				`ip6tables --table filter --new-chain LIBVIRT_FWX`,
+				`ip6tables --table filter --insert FORWARD --jump LIBVIRT_FWX`,
+				`ip6tables --table nat --new-chain LIBVIRT_PRT`,
+				`ip6tables --table nat --insert POSTROUTING --jump LIBVIRT_PRT`,
+				`ip6tables --table mangle --new-chain LIBVIRT_PRT`,
+				`ip6tables --table mangle --insert POSTROUTING --jump LIBVIRT_PRT`,
+				`iptables --table filter --insert LIBVIRT_INP --in-interface virbr9 --protocol tcp --destination-port 67 --jump ACCEPT`,
+			},
+		},
+		{
+			Backend: NetworkFirewallBackendNftables,
+			Expected: []string{
+				`nft add table ip libvirt_network`,
+				`nft add chain ip libvirt_network forward '{ type filter hook forward priority filter; policy accept; }'`,
+				`nft add chain ip libvirt_network guest_output`,
+				`nft insert rule ip libvirt_network forward counter jump guest_output`,
+				`nft add chain ip libvirt_network guest_input`,
+				`nft insert rule ip libvirt_network forward counter jump guest_input`,
+				`nft add chain ip libvirt_network guest_cross`,
+				`nft insert rule ip libvirt_network forward counter jump guest_cross`,
+				`nft add chain ip libvirt_network postrouting '{ type nat hook postrouting priority srcnat; policy accept; }'`,
+				`nft add chain ip libvirt_network guest_nat`,
+				`nft insert rule ip libvirt_network postrouting counter jump guest_nat`,
+				`nft add table ip6 libvirt_network`,
+				`nft add chain ip6 libvirt_network forward '{ type filter hook forward priority filter; policy accept; }'`,
+				`nft add chain ip6 libvirt_network guest_output`,
+				`nft insert rule ip6 libvirt_network forward counter jump guest_output`,
+				`nft add chain ip6 libvirt_network guest_input`,
+				`nft insert rule ip6 libvirt_network forward counter jump guest_input`,
+				`nft add chain ip6 libvirt_network guest_cross`,
+				`nft insert rule ip6 libvirt_network forward counter jump guest_cross`,
+				`nft add chain ip6 libvirt_network postrouting '{ type nat hook postrouting priority srcnat; policy accept; }'`,
+				`nft add chain ip6 libvirt_network guest_nat`,
+				`nft insert rule ip6 libvirt_network postrouting counter jump guest_nat`,
+				`nft insert rule ip libvirt_network guest_output iifname virbr9 counter reject`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		fw, err := net.Firewall(&NetworkFirewallOptions{Backend: test.Backend, Setup: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines := strings.Split(fw.String(), "\n")
+		if len(lines) < len(test.Expected) {
+			t.Fatalf("Too few commands for %s:\n%s", test.Backend, fw.String())
+		}
+		got := strings.Join(lines[:len(test.Expected)], "\n")
+		expect := strings.Join(test.Expected, "\n")
+		if got != expect {
+			t.Errorf("Bad %s setup:\n%s\n does not match\n%s", test.Backend, got, expect)
+		}
+	}
+}
+
+func TestNetworkFirewallErrors(t *testing.T) {
+	var tests = []string{
+		`<network><name>nobridge</name><forward mode='nat'/></network>`,
+		`<network><forward mode='bridge'/><bridge name='br0'/></network>`,
+		`<network><forward mode='nat'><nat><port start='3000' end='2000'/></nat></forward>` +
+			`<bridge name='virbr0'/><ip address='192.168.122.1' prefix='24'/></network>`,
+		`<network><forward mode='nat'><nat><address start='10.0.0.1' end='10.0.0'/></nat></forward>` +
+			`<bridge name='virbr0'/><ip address='192.168.122.1' prefix='24'/></network>`,
+		`<network><forward mode='route'/><bridge name='virbr0'/><ip address='192.168.122.1' prefix='33'/></network>`,
+		`<network><forward mode='route'/><bridge name='virbr0'/><ip family='ipv4' address='fd00::1'/></network>`,
+	}
+
+	for _, doc := range tests {
+		net := &Network{}
+		if err := net.Unmarshal(doc); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := net.Firewall(nil); err == nil {
+			t.Errorf("Expected error for %s", doc)
+		}
+	}
+
+	net := &Network{Bridge: &NetworkBridge{Name: "virbr0"}}
+	if _, err := net.Firewall(&NetworkFirewallOptions{Backend: "pf"}); err == nil {
+		t.Errorf("Expected error for unknown backend")
+	}
+}
@@ -207,6 +207,10 @@ func (s *NetworkPort) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), s)
 }
 
+func (s *NetworkPort) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(s, doc)
+}
+
 func (s *NetworkPort) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(s, "", "  ")
 	if err != nil {
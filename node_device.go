@@ -994,6 +994,10 @@ func (c *NodeDevice) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), c)
 }
 
+func (c *NodeDevice) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(c, doc)
+}
+
 func (c *NodeDevice) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(c, "", "  ")
 	if err != nil {
@@ -505,6 +505,10 @@ func (s *NWFilter) Unmarshal(doc string) error {
 	return xml.Unmarshal([]byte(doc), s)
 }
 
+func (s *NWFilter) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(s, doc)
+}
+
 func (s *NWFilter) Marshal() (string, error) {
 	doc, err := xml.MarshalIndent(s, "", "  ")
 	if err != nil {
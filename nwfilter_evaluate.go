@@ -0,0 +1,697 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// NWFilterPacket describes a packet to evaluate against a filter.
+// Fields which are not set are zero, so rules matching them only
+// match zero values
+type NWFilterPacket struct {
+	// Direction relative to the guest, "in" for traffic to the guest
+	// and "out" for traffic from it
+	Direction string
+	// Whether traffic from the guest is addressed to the host itself
+	// rather than forwarded
+	ToHost bool
+	// Connection tracking state, one of NEW, ESTABLISHED, RELATED or
+	// INVALID, defaulting to NEW
+	State string
+	// Number of connections already open from the source address,
+	// compared against connlimit-above
+	Connections uint
+
+	SrcMAC string
+	DstMAC string
+	// Ethernet type of the frame, which defaults according to the
+	// headers given
+	EtherType uint
+
+	VLAN *NWFilterPacketVLAN
+	STP  *NWFilterPacketSTP
+	ARP  *NWFilterPacketARP
+	IP   *NWFilterPacketIP
+	L4   *NWFilterPacketL4
+}
+
+type NWFilterPacketVLAN struct {
+	ID            uint
+	EncapProtocol uint
+}
+
+type NWFilterPacketSTP struct {
+	Type           uint
+	Flags          uint
+	RootPriority   uint
+	RootAddress    string
+	RootCost       uint
+	SenderPriority uint
+	SenderAddress  string
+	Port           uint
+	Age            uint
+	MaxAge         uint
+	HelloTime      uint
+	ForwardDelay   uint
+}
+
+// NWFilterPacketARP is the header of an ARP or RARP packet, told
+// apart by the ethernet type
+type NWFilterPacketARP struct {
+	HWType       uint
+	ProtocolType uint
+	OpCode       uint
+	SrcMAC       string
+	DstMAC       string
+	SrcIP        string
+	DstIP        string
+}
+
+// NWFilterPacketIP is the header of an IPv4 or IPv6 packet, told
+// apart by the addresses
+type NWFilterPacketIP struct {
+	SrcIP    string
+	DstIP    string
+	Protocol uint
+	DSCP     uint
+}
+
+// NWFilterPacketL4 holds the fields of the transport header, which
+// are used according to the IP protocol
+type NWFilterPacketL4 struct {
+	SrcPort uint
+	DstPort uint
+	// TCP flags as a comma separated list such as "SYN,ACK"
+	TCPFlags   string
+	TCPOptions []uint
+	ICMPType   uint
+	ICMPCode   uint
+}
+
+// NWFilterVerdict is the outcome of evaluating a packet
+type NWFilterVerdict struct {
+	// One of accept, drop or reject
+	Action string
+	// The rule which decided the action, or nil if the packet
+	// passed without an accept rule matching
+	Rule *NWFilterResolvedRule
+}
+
+// nwfilterPacket is a packet with its addresses parsed
+type nwfilterPacket struct {
+	*NWFilterPacket
+	srcMAC    net.HardwareAddr
+	dstMAC    net.HardwareAddr
+	arpSrcMAC net.HardwareAddr
+	arpDstMAC net.HardwareAddr
+	arpSrcIP  net.IP
+	arpDstIP  net.IP
+	srcIP     net.IP
+	dstIP     net.IP
+	tcpFlags  uint
+}
+
+func nwfilterPacketMAC(val string) (net.HardwareAddr, error) {
+	if val == "" {
+		return make(net.HardwareAddr, 6), nil
+	}
+	return nwfilterParseMAC(val)
+}
+
+func nwfilterPacketIP(val string) (net.IP, error) {
+	if val == "" {
+		return nil, nil
+	}
+	ip := net.ParseIP(val)
+	if ip == nil {
+		return nil, fmt.Errorf("Invalid IP address '%s'", val)
+	}
+	return ip, nil
+}
+
+func newNWFilterPacket(pkt *NWFilterPacket) (*nwfilterPacket, error) {
+	if pkt.Direction != "in" && pkt.Direction != "out" {
+		return nil, fmt.Errorf("Invalid packet direction '%s'", pkt.Direction)
+	}
+	if pkt.State != "" {
+		valid := false
+		for _, state := range nwfilterStates {
+			if strings.EqualFold(state, pkt.State) {
+				valid = true
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("Invalid connection state '%s'", pkt.State)
+		}
+	}
+
+	p := &nwfilterPacket{NWFilterPacket: pkt}
+	var err error
+	if p.srcMAC, err = nwfilterPacketMAC(pkt.SrcMAC); err != nil {
+		return nil, err
+	}
+	if p.dstMAC, err = nwfilterPacketMAC(pkt.DstMAC); err != nil {
+		return nil, err
+	}
+	if pkt.ARP != nil {
+		if p.arpSrcMAC, err = nwfilterPacketMAC(pkt.ARP.SrcMAC); err != nil {
+			return nil, err
+		}
+		if p.arpDstMAC, err = nwfilterPacketMAC(pkt.ARP.DstMAC); err != nil {
+			return nil, err
+		}
+		if p.arpSrcIP, err = nwfilterPacketIP(pkt.ARP.SrcIP); err != nil {
+			return nil, err
+		}
+		if p.arpDstIP, err = nwfilterPacketIP(pkt.ARP.DstIP); err != nil {
+			return nil, err
+		}
+	}
+	if pkt.IP != nil {
+		if p.srcIP, err = nwfilterPacketIP(pkt.IP.SrcIP); err != nil {
+			return nil, err
+		}
+		if p.dstIP, err = nwfilterPacketIP(pkt.IP.DstIP); err != nil {
+			return nil, err
+		}
+	}
+	if pkt.L4 != nil && pkt.L4.TCPFlags != "" {
+		if p.tcpFlags, err = nwfilterParseTCPFlagSet(pkt.L4.TCPFlags); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// etherType returns the ethernet type of the packet, defaulting
+// according to the headers given
+func (p *nwfilterPacket) etherType() uint {
+	switch {
+	case p.EtherType != 0:
+		return p.EtherType
+	case p.VLAN != nil:
+		return 0x8100
+	case p.ARP != nil:
+		return 0x0806
+	case p.IP != nil:
+		if p.srcIP.To4() == nil && p.dstIP.To4() == nil {
+			return 0x86dd
+		}
+		return 0x0800
+	}
+	return 0
+}
+
+func (p *nwfilterPacket) state() string {
+	if p.State == "" {
+		return "NEW"
+	}
+	return strings.ToUpper(p.State)
+}
+
+// nwfilterMatchMAC matches an address given as "mac" or "mac/mask"
+func nwfilterMatchMAC(val string, mac net.HardwareAddr) (bool, error) {
+	parts := strings.SplitN(val, "/", 2)
+	addr, err := nwfilterParseMAC(parts[0])
+	if err != nil {
+		return false, err
+	}
+	mask := net.HardwareAddr{0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	if len(parts) == 2 {
+		if mask, err = nwfilterParseMAC(parts[1]); err != nil {
+			return false, err
+		}
+	}
+	if len(mac) != len(addr) {
+		return false, nil
+	}
+	for i := range addr {
+		if addr[i]&mask[i] != mac[i]&mask[i] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// nwfilterMatchIP matches an address given as "ip" or "ip/prefix"
+func nwfilterMatchIP(val string, ip net.IP) (bool, error) {
+	if ip == nil {
+		return false, nil
+	}
+	if !strings.Contains(val, "/") {
+		addr := net.ParseIP(val)
+		if addr == nil {
+			return false, fmt.Errorf("Invalid IP address '%s'", val)
+		}
+		return addr.Equal(ip), nil
+	}
+	_, ipnet, err := net.ParseCIDR(val)
+	if err != nil {
+		return false, fmt.Errorf("Invalid IP address '%s'", val)
+	}
+	return ipnet.Contains(ip), nil
+}
+
+// nwfilterMatchIPRange matches an address range given as "from-to"
+func nwfilterMatchIPRange(val string, ip net.IP) (bool, error) {
+	if ip == nil {
+		return false, nil
+	}
+	parts := strings.SplitN(val, "-", 2)
+	from := net.ParseIP(parts[0])
+	to := from
+	if len(parts) == 2 {
+		to = net.ParseIP(parts[1])
+	}
+	if from == nil || to == nil {
+		return false, fmt.Errorf("Invalid IP address range '%s'", val)
+	}
+	if (from.To4() == nil) != (ip.To4() == nil) {
+		return false, nil
+	}
+	ip = ip.To16()
+	return bytes.Compare(ip, from.To16()) >= 0 && bytes.Compare(ip, to.To16()) <= 0, nil
+}
+
+// nwfilterMatchRange matches a number against a value or range given
+// as "lo:hi"
+func nwfilterMatchRange(val string, n uint) (bool, error) {
+	parts := strings.SplitN(val, ":", 2)
+	lo, err := nwfilterParseUint(parts[0], nwfilterTypeUint32)
+	if err != nil {
+		return false, err
+	}
+	hi := lo
+	if len(parts) == 2 {
+		if hi, err = nwfilterParseUint(parts[1], nwfilterTypeUint32); err != nil {
+			return false, err
+		}
+	}
+	return uint64(n) >= lo && uint64(n) <= hi, nil
+}
+
+// nwfilterMatchNumber matches a number given in the form printed by
+// the compiler, which may be an ethernet type or protocol name
+func nwfilterMatchNumber(val string, typ nwfilterDataType, n uint) (bool, error) {
+	want, err := nwfilterParseUint(val, typ)
+	if err != nil {
+		return false, err
+	}
+	return want == uint64(n), nil
+}
+
+// matchEbtables evaluates one ebtables option against the packet
+func (p *nwfilterPacket) matchEbtables(m *nwfilterMatch) (bool, error) {
+	val := ""
+	if len(m.args) > 0 {
+		val = m.args[0]
+	}
+	arp := p.ARP
+	if arp == nil {
+		arp = &NWFilterPacketARP{}
+	}
+	vlan := p.VLAN
+	if vlan == nil {
+		vlan = &NWFilterPacketVLAN{}
+	}
+	stp := p.STP
+	if stp == nil {
+		stp = &NWFilterPacketSTP{}
+	}
+	ip := p.IP
+	if ip == nil {
+		ip = &NWFilterPacketIP{}
+	}
+	l4 := p.L4
+	if l4 == nil {
+		l4 = &NWFilterPacketL4{}
+	}
+
+	switch m.opt {
+	case "-s":
+		return nwfilterMatchMAC(val, p.srcMAC)
+	case "-d":
+		return nwfilterMatchMAC(val, p.dstMAC)
+	case "-p":
+		return nwfilterMatchNumber(val, nwfilterTypeEtherType, p.etherType())
+	case "--vlan-id":
+		return nwfilterMatchNumber(val, nwfilterTypeUint16, vlan.ID)
+	case "--vlan-encap":
+		return nwfilterMatchNumber(val, nwfilterTypeEtherType, vlan.EncapProtocol)
+	case "--stp-type":
+		return nwfilterMatchNumber(val, nwfilterTypeUint8, stp.Type)
+	case "--stp-flags":
+		return nwfilterMatchNumber(val, nwfilterTypeUint8, stp.Flags)
+	case "--stp-root-pri":
+		return nwfilterMatchRange(val, stp.RootPriority)
+	case "--stp-root-addr":
+		addr, err := nwfilterPacketMAC(stp.RootAddress)
+		if err != nil {
+			return false, err
+		}
+		return nwfilterMatchMAC(val, addr)
+	case "--stp-root-cost":
+		return nwfilterMatchRange(val, stp.RootCost)
+	case "--stp-sender-prio":
+		return nwfilterMatchRange(val, stp.SenderPriority)
+	case "--stp-sender-addr":
+		addr, err := nwfilterPacketMAC(stp.SenderAddress)
+		if err != nil {
+			return false, err
+		}
+		return nwfilterMatchMAC(val, addr)
+	case "--stp-port":
+		return nwfilterMatchRange(val, stp.Port)
+	case "--stp-msg-age":
+		return nwfilterMatchRange(val, stp.Age)
+	case "--stp-max-age":
+		return nwfilterMatchRange(val, stp.MaxAge)
+	case "--stp-hello-time":
+		return nwfilterMatchRange(val, stp.HelloTime)
+	case "--stp-forward-delay":
+		return nwfilterMatchRange(val, stp.ForwardDelay)
+	case "--arp-htype":
+		return nwfilterMatchNumber(val, nwfilterTypeUint16, arp.HWType)
+	case "--arp-opcode":
+		return nwfilterMatchNumber(val, nwfilterTypeARPOpcode, arp.OpCode)
+	case "--arp-ptype":
+		return nwfilterMatchNumber(val, nwfilterTypeEtherType, arp.ProtocolType)
+	case "--arp-ip-src":
+		return nwfilterMatchIP(val, p.arpSrcIP)
+	case "--arp-ip-dst":
+		return nwfilterMatchIP(val, p.arpDstIP)
+	case "--arp-mac-src":
+		return nwfilterMatchMAC(val, p.arpSrcMAC)
+	case "--arp-mac-dst":
+		return nwfilterMatchMAC(val, p.arpDstMAC)
+	case "--arp-gratuitous":
+		return p.arpSrcIP != nil && p.arpSrcIP.Equal(p.arpDstIP), nil
+	case "--ip-source", "--ip6-source":
+		return nwfilterMatchIP(val, p.srcIP)
+	case "--ip-destination", "--ip6-destination":
+		return nwfilterMatchIP(val, p.dstIP)
+	case "--ip-protocol", "--ip6-protocol":
+		return nwfilterMatchNumber(val, nwfilterTypeIPProtocol, ip.Protocol)
+	case "--ip-source-port", "--ip6-source-port":
+		return nwfilterMatchRange(val, l4.SrcPort)
+	case "--ip-destination-port", "--ip6-destination-port":
+		return nwfilterMatchRange(val, l4.DstPort)
+	case "--ip-tos":
+		return nwfilterMatchNumber(val, nwfilterTypeUint8, ip.DSCP)
+	case "--ip6-icmp-type":
+		parts := strings.SplitN(val, "/", 2)
+		match, err := nwfilterMatchRange(parts[0], l4.ICMPType)
+		if err != nil || !match || len(parts) == 1 {
+			return match, err
+		}
+		return nwfilterMatchRange(parts[1], l4.ICMPCode)
+	}
+	return false, fmt.Errorf("Cannot evaluate ebtables option '%s'", m.opt)
+}
+
+// matchIPTables evaluates one iptables option against the packet
+func (p *nwfilterPacket) matchIPTables(m *nwfilterMatch) (bool, error) {
+	val := ""
+	if len(m.args) > 0 {
+		val = m.args[0]
+	}
+	ip := p.IP
+	if ip == nil {
+		ip = &NWFilterPacketIP{}
+	}
+	l4 := p.L4
+	if l4 == nil {
+		l4 = &NWFilterPacketL4{}
+	}
+
+	switch m.opt {
+	case "-p":
+		if val == "all" {
+			return true, nil
+		}
+		return nwfilterMatchNumber(val, nwfilterTypeIPProtocol, ip.Protocol)
+	case "--mac-source":
+		return nwfilterMatchMAC(val, p.srcMAC)
+	case "--source":
+		return nwfilterMatchIP(val, p.srcIP)
+	case "--destination":
+		return nwfilterMatchIP(val, p.dstIP)
+	case "--src-range":
+		return nwfilterMatchIPRange(val, p.srcIP)
+	case "--dst-range":
+		return nwfilterMatchIPRange(val, p.dstIP)
+	case "--dscp":
+		return nwfilterMatchNumber(val, nwfilterTypeUint8, ip.DSCP)
+	case "--tcp-flags":
+		mask, err := nwfilterParseTCPFlagSet(m.args[0])
+		if err != nil {
+			return false, err
+		}
+		flags, err := nwfilterParseTCPFlagSet(m.args[1])
+		if err != nil {
+			return false, err
+		}
+		return p.tcpFlags&mask == flags, nil
+	case "--sport":
+		return nwfilterMatchRange(val, l4.SrcPort)
+	case "--dport":
+		return nwfilterMatchRange(val, l4.DstPort)
+	case "--tcp-option":
+		for _, option := range l4.TCPOptions {
+			if match, err := nwfilterMatchNumber(val, nwfilterTypeUint8, option); err != nil || match {
+				return match, err
+			}
+		}
+		return false, nil
+	case "--icmp-type", "--icmpv6-type":
+		parts := strings.SplitN(val, "/", 2)
+		match, err := nwfilterMatchNumber(parts[0], nwfilterTypeUint8, l4.ICMPType)
+		if err != nil || !match || len(parts) == 1 {
+			return match, err
+		}
+		return nwfilterMatchNumber(parts[1], nwfilterTypeUint8, l4.ICMPCode)
+	case "--state":
+		for _, state := range strings.Split(val, ",") {
+			if state == p.state() {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "--connlimit-above":
+		limit, err := strconv.ParseUint(val, 10, 32)
+		if err != nil {
+			return false, err
+		}
+		return uint64(p.Connections) > limit, nil
+	case "--match-set":
+		return false, fmt.Errorf("Cannot evaluate rules matching ipset '%s'", val)
+	}
+	return false, fmt.Errorf("Cannot evaluate iptables option '%s'", m.opt)
+}
+
+// match reports whether every option of a rule matches the packet
+func (p *nwfilterPacket) match(rule *nwfilterFWRule) (bool, error) {
+	for i := range rule.matches {
+		m := &rule.matches[i]
+		var match bool
+		var err error
+		if rule.layer == nwfilterLayerEthernet {
+			match, err = p.matchEbtables(m)
+		} else {
+			match, err = p.matchIPTables(m)
+		}
+		if err != nil {
+			return false, err
+		}
+		if match == m.neg {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// nwfilterEvalEntry is a rule or a jump to a sub chain
+type nwfilterEvalEntry struct {
+	rule  *nwfilterFWRule
+	chain *nwfilterSubChain
+}
+
+// nwfilterEvaluator walks the compiled rules the way the kernel
+// would, recording the last rule to decide the fate of the packet
+type nwfilterEvaluator struct {
+	pkt    *nwfilterPacket
+	rules  []NWFilterResolvedRule
+	chains map[string][]nwfilterEvalEntry
+	rule   *NWFilterResolvedRule
+}
+
+// evalChain returns the target which ended traversal of an ebtables
+// chain, which is empty when the end of the chain is reached
+func (e *nwfilterEvaluator) evalChain(name string) (string, error) {
+	for _, entry := range e.chains[name] {
+		if entry.chain != nil {
+			proto := nwfilterL2Protocol(entry.chain.name)
+			switch proto.name {
+			case "mac":
+			case "stp":
+				if match, _ := nwfilterMatchMAC(nwfilterMACBGA, e.pkt.dstMAC); !match {
+					continue
+				}
+			default:
+				if e.pkt.etherType() != proto.ethertype {
+					continue
+				}
+			}
+			target, err := e.evalChain(entry.chain.name)
+			if err != nil {
+				return "", err
+			}
+			if target == "ACCEPT" || target == "DROP" {
+				return target, nil
+			}
+			continue
+		}
+
+		match, err := e.pkt.match(entry.rule)
+		if err != nil {
+			return "", err
+		}
+		if !match {
+			continue
+		}
+		switch entry.rule.target {
+		case "CONTINUE":
+			continue
+		case "ACCEPT", "DROP":
+			e.rule = &e.rules[entry.rule.inst.index]
+		}
+		return entry.rule.target, nil
+	}
+	return "", nil
+}
+
+// evalIPTables returns the target which ended traversal of one of
+// the per-interface iptables chains, which is empty when the end of
+// the chain is reached
+func (e *nwfilterEvaluator) evalIPTables(rules []*nwfilterFWRule, prefix string) (string, error) {
+	for _, rule := range rules {
+		if rule.prefix != prefix {
+			continue
+		}
+		match, err := e.pkt.match(rule)
+		if err != nil {
+			return "", err
+		}
+		if !match || rule.target == "CONTINUE" {
+			continue
+		}
+		if rule.target != "RETURN" || rule.inst.rule.Action == "accept" {
+			e.rule = &e.rules[rule.inst.index]
+		}
+		return rule.target, nil
+	}
+	return "", nil
+}
+
+// EvaluateNWFilter decides the fate of a packet sent to or from a
+// guest whose interface has the resolved rules applied. The rules are
+// compiled as for the iptables backend, then the ebtables rules are
+// walked followed by the iptables or ip6tables rules for IP packets.
+// Rules matching ipsets cannot be evaluated
+func EvaluateNWFilter(rules []NWFilterResolvedRule, pkt *NWFilterPacket) (*NWFilterVerdict, error) {
+	p, err := newNWFilterPacket(pkt)
+	if err != nil {
+		return nil, err
+	}
+
+	insts := make([]*nwfilterRuleInst, len(rules))
+	for i, rule := range rules {
+		insts[i] = &nwfilterRuleInst{i, rule.Rule, rule.Chain, rule.ChainPriority, rule.Priority}
+	}
+	plan, err := nwfilterBuildPlan(insts)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &nwfilterEvaluator{
+		pkt:    p,
+		rules:  rules,
+		chains: make(map[string][]nwfilterEvalEntry),
+	}
+	prefix := "P"
+	if pkt.Direction == "out" {
+		prefix = "J"
+	}
+	for _, step := range plan.ebtables {
+		if step.chain != nil && step.chain.incoming == (prefix == "J") {
+			e.chains[nwfilterRootChain] = append(e.chains[nwfilterRootChain], nwfilterEvalEntry{chain: step.chain})
+		} else if step.rule != nil && step.rule.prefix == prefix {
+			e.chains[step.rule.chain] = append(e.chains[step.rule.chain], nwfilterEvalEntry{rule: step.rule})
+		}
+	}
+
+	target, err := e.evalChain(nwfilterRootChain)
+	if err != nil {
+		return nil, err
+	}
+	if target == "DROP" {
+		return &NWFilterVerdict{Action: "drop", Rule: e.rule}, nil
+	}
+
+	var l3 []*nwfilterFWRule
+	switch p.etherType() {
+	case 0x0800:
+		l3 = plan.ipv4
+	case 0x86dd:
+		l3 = plan.ipv6
+	}
+	if pkt.IP != nil && len(l3) > 0 {
+		chain := "FP"
+		if pkt.Direction == "out" {
+			chain = "FJ"
+			if pkt.ToHost {
+				chain = "HJ"
+			}
+		}
+		target, err := e.evalIPTables(l3, chain)
+		if err != nil {
+			return nil, err
+		}
+		switch target {
+		case "DROP":
+			return &NWFilterVerdict{Action: "drop", Rule: e.rule}, nil
+		case "REJECT":
+			return &NWFilterVerdict{Action: "reject", Rule: e.rule}, nil
+		}
+	}
+
+	return &NWFilterVerdict{Action: "accept", Rule: e.rule}, nil
+}
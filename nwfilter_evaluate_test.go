@@ -0,0 +1,326 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+var nwfilterEvaluateFilters = []string{
+	strings.Join([]string{
+		`<filter name='guest' chain='root'>`,
+		`  <filterref filter='no-mac-spoofing'/>`,
+		`  <filterref filter='no-ip-spoofing'/>`,
+		`  <rule action='accept' direction='inout' priority='-500'>`,
+		`    <arp opcode='Request'/>`,
+		`  </rule>`,
+		`  <rule action='drop' direction='inout' priority='-400'>`,
+		`    <arp/>`,
+		`  </rule>`,
+		`  <rule action='accept' direction='in'>`,
+		`    <tcp dstportstart='22'/>`,
+		`  </rule>`,
+		`  <rule action='accept' direction='in'>`,
+		`    <udp srcipaddr='192.168.122.0' srcipmask='24' dstportstart='5000' dstportend='5010'/>`,
+		`  </rule>`,
+		`  <rule action='accept' direction='in' statematch='false'>`,
+		`    <tcp srcipfrom='10.0.0.10' srcipto='10.0.0.20' flags='SYN/SYN'/>`,
+		`  </rule>`,
+		`  <rule action='reject' direction='out'>`,
+		`    <tcp dstportstart='25' connlimit-above='2'/>`,
+		`  </rule>`,
+		`  <rule action='accept' direction='out'>`,
+		`    <all/>`,
+		`  </rule>`,
+		`  <rule action='drop' direction='inout' priority='1000'>`,
+		`    <all/>`,
+		`  </rule>`,
+		`</filter>`,
+	}, "\n"),
+	strings.Join([]string{
+		`<filter name='no-mac-spoofing' chain='mac'>`,
+		`  <rule action='return' direction='out'>`,
+		`    <mac srcmacaddr='$MAC' srcmacmask='ff:ff:ff:ff:ff:ff'/>`,
+		`  </rule>`,
+		`  <rule action='drop' direction='out'>`,
+		`    <mac/>`,
+		`  </rule>`,
+		`</filter>`,
+	}, "\n"),
+	strings.Join([]string{
+		`<filter name='no-ip-spoofing' chain='ipv4-ip'>`,
+		`  <rule action='return' direction='out'>`,
+		`    <ip srcipaddr='$IP'/>`,
+		`  </rule>`,
+		`  <rule action='drop' direction='out'>`,
+		`    <ip/>`,
+		`  </rule>`,
+		`</filter>`,
+	}, "\n"),
+}
+
+const (
+	nwfilterEvaluateGuestMAC = "52:54:00:11:22:33"
+	nwfilterEvaluateGuestIP  = "192.168.122.5"
+)
+
+var nwfilterEvaluateTestData = []struct {
+	Name   string
+	Packet NWFilterPacket
+	Action string
+	// Index of the deciding rule within the resolved rules, or -1
+	Rule int
+}{
+	{
+		Name: "outbound tcp",
+		Packet: NWFilterPacket{
+			Direction: "out",
+			SrcMAC:    nwfilterEvaluateGuestMAC,
+			IP:        &NWFilterPacketIP{SrcIP: nwfilterEvaluateGuestIP, DstIP: "8.8.8.8", Protocol: 6},
+			L4:        &NWFilterPacketL4{SrcPort: 40000, DstPort: 443, TCPFlags: "SYN"},
+		},
+		Action: "accept",
+		Rule:   6,
+	},
+	{
+		Name: "spoofed mac",
+		Packet: NWFilterPacket{
+			Direction: "out",
+			SrcMAC:    "52:54:00:11:22:34",
+			IP:        &NWFilterPacketIP{SrcIP: nwfilterEvaluateGuestIP, DstIP: "8.8.8.8", Protocol: 6},
+		},
+		Action: "drop",
+		Rule:   9,
+	},
+	{
+		Name: "spoofed ip",
+		Packet: NWFilterPacket{
+			Direction: "out",
+			SrcMAC:    nwfilterEvaluateGuestMAC,
+			IP:        &NWFilterPacketIP{SrcIP: "192.168.122.6", DstIP: "8.8.8.8", Protocol: 17},
+		},
+		Action: "drop",
+		Rule:   11,
+	},
+	{
+		Name: "inbound ssh",
+		Packet: NWFilterPacket{
+			Direction: "in",
+			DstMAC:    nwfilterEvaluateGuestMAC,
+			IP:        &NWFilterPacketIP{SrcIP: "10.1.1.1", DstIP: nwfilterEvaluateGuestIP, Protocol: 6},
+			L4:        &NWFilterPacketL4{SrcPort: 50000, DstPort: 22, TCPFlags: "SYN"},
+		},
+		Action: "accept",
+		Rule:   2,
+	},
+	{
+		Name: "inbound http",
+		Packet: NWFilterPacket{
+			Direction: "in",
+			DstMAC:    nwfilterEvaluateGuestMAC,
+			IP:        &NWFilterPacketIP{SrcIP: "10.1.1.1", DstIP: nwfilterEvaluateGuestIP, Protocol: 6},
+			L4:        &NWFilterPacketL4{SrcPort: 50000, DstPort: 80, TCPFlags: "SYN"},
+		},
+		Action: "drop",
+		Rule:   7,
+	},
+	{
+		Name: "inbound reply",
+		Packet: NWFilterPacket{
+			Direction: "in",
+			State:     "ESTABLISHED",
+			DstMAC:    nwfilterEvaluateGuestMAC,
+			IP:        &NWFilterPacketIP{SrcIP: "8.8.8.8", DstIP: nwfilterEvaluateGuestIP, Protocol: 6},
+			L4:        &NWFilterPacketL4{SrcPort: 443, DstPort: 40000, TCPFlags: "ACK"},
+		},
+		Action: "accept",
+		Rule:   6,
+	},
+	{
+		Name: "inbound udp in range",
+		Packet: NWFilterPacket{
+			Direction: "in",
+			IP:        &NWFilterPacketIP{SrcIP: "192.168.122.77", DstIP: nwfilterEvaluateGuestIP, Protocol: 17},
+			L4:        &NWFilterPacketL4{SrcPort: 1234, DstPort: 5005},
+		},
+		Action: "accept",
+		Rule:   3,
+	},
+	{
+		Name: "inbound udp outside mask",
+		Packet: NWFilterPacket{
+			Direction: "in",
+			IP:        &NWFilterPacketIP{SrcIP: "192.168.123.77", DstIP: nwfilterEvaluateGuestIP, Protocol: 17},
+			L4:        &NWFilterPacketL4{SrcPort: 1234, DstPort: 5005},
+		},
+		Action: "drop",
+		Rule:   7,
+	},
+	{
+		Name: "inbound syn from range",
+		Packet: NWFilterPacket{
+			Direction: "in",
+			State:     "INVALID",
+			IP:        &NWFilterPacketIP{SrcIP: "10.0.0.15", DstIP: nwfilterEvaluateGuestIP, Protocol: 6},
+			L4:        &NWFilterPacketL4{SrcPort: 1234, DstPort: 8080, TCPFlags: "SYN"},
+		},
+		Action: "accept",
+		Rule:   4,
+	},
+	{
+		Name: "inbound ack from range",
+		Packet: NWFilterPacket{
+			Direction: "in",
+			IP:        &NWFilterPacketIP{SrcIP: "10.0.0.15", DstIP: nwfilterEvaluateGuestIP, Protocol: 6},
+			L4:        &NWFilterPacketL4{SrcPort: 1234, DstPort: 8080, TCPFlags: "ACK"},
+		},
+		Action: "drop",
+		Rule:   7,
+	},
+	{
+		Name: "smtp under limit",
+		Packet: NWFilterPacket{
+			Direction:   "out",
+			Connections: 2,
+			SrcMAC:      nwfilterEvaluateGuestMAC,
+			IP:          &NWFilterPacketIP{SrcIP: nwfilterEvaluateGuestIP, DstIP: "10.2.2.2", Protocol: 6},
+			L4:          &NWFilterPacketL4{SrcPort: 40000, DstPort: 25},
+		},
+		Action: "accept",
+		Rule:   6,
+	},
+	{
+		Name: "smtp over limit",
+		Packet: NWFilterPacket{
+			Direction:   "out",
+			Connections: 3,
+			SrcMAC:      nwfilterEvaluateGuestMAC,
+			IP:          &NWFilterPacketIP{SrcIP: nwfilterEvaluateGuestIP, DstIP: "10.2.2.2", Protocol: 6},
+			L4:          &NWFilterPacketL4{SrcPort: 40000, DstPort: 25},
+		},
+		Action: "reject",
+		Rule:   5,
+	},
+	{
+		Name: "arp request",
+		Packet: NWFilterPacket{
+			Direction: "out",
+			SrcMAC:    nwfilterEvaluateGuestMAC,
+			DstMAC:    "ff:ff:ff:ff:ff:ff",
+			ARP:       &NWFilterPacketARP{OpCode: 1, SrcIP: nwfilterEvaluateGuestIP, DstIP: "192.168.122.1"},
+		},
+		Action: "accept",
+		Rule:   0,
+	},
+	{
+		Name: "arp reply",
+		Packet: NWFilterPacket{
+			Direction: "in",
+			DstMAC:    nwfilterEvaluateGuestMAC,
+			ARP:       &NWFilterPacketARP{OpCode: 2, SrcIP: "192.168.122.1", DstIP: nwfilterEvaluateGuestIP},
+		},
+		Action: "drop",
+		Rule:   1,
+	},
+}
+
+func TestEvaluateNWFilter(t *testing.T) {
+	var filters []NWFilter
+	for _, doc := range nwfilterEvaluateFilters {
+		filter := NWFilter{}
+		if err := filter.Unmarshal(doc); err != nil {
+			t.Fatal(err)
+		}
+		filters = append(filters, filter)
+	}
+	rules, err := filters[0].Resolve(filters, []NWFilterParameter{
+		{Name: "MAC", Value: nwfilterEvaluateGuestMAC},
+		{Name: "IP", Value: nwfilterEvaluateGuestIP},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range nwfilterEvaluateTestData {
+		verdict, err := EvaluateNWFilter(rules, &test.Packet)
+		if err != nil {
+			t.Errorf("%s: %s", test.Name, err)
+			continue
+		}
+		if verdict.Action != test.Action {
+			t.Errorf("%s: expected %s, got %s", test.Name, test.Action, verdict.Action)
+		}
+		if test.Rule < 0 {
+			if verdict.Rule != nil {
+				t.Errorf("%s: expected no rule, got %v", test.Name, verdict.Rule.Rule)
+			}
+		} else if verdict.Rule != &rules[test.Rule] {
+			t.Errorf("%s: expected rule %d, got %v", test.Name, test.Rule, verdict.Rule)
+		}
+	}
+}
+
+func TestEvaluateNWFilterErrors(t *testing.T) {
+	filter := NWFilter{}
+	err := filter.Unmarshal(strings.Join([]string{
+		`<filter name='ipset'>`,
+		`  <rule action='accept' direction='out'>`,
+		`    <all ipset='trusted' ipsetflags='dst'/>`,
+		`  </rule>`,
+		`</filter>`,
+	}, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules, err := filter.Resolve(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []NWFilterPacket{
+		{Direction: "sideways"},
+		{Direction: "out", SrcMAC: "52:54:00"},
+		{Direction: "out", State: "OPEN"},
+		{Direction: "out", IP: &NWFilterPacketIP{SrcIP: "10.0.0.1", DstIP: "10.0.0.2"}},
+	}
+	for _, test := range tests {
+		if _, err := EvaluateNWFilter(rules, &test); err == nil {
+			t.Errorf("Expected error for %v", test)
+		}
+	}
+
+	verdict, err := EvaluateNWFilter(rules, &NWFilterPacket{
+		Direction: "out",
+		ARP:       &NWFilterPacketARP{OpCode: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verdict.Action != "accept" || verdict.Rule != nil {
+		t.Errorf("Expected ARP to pass without a rule, got %v", verdict)
+	}
+}
@@ -0,0 +1,1524 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// NWFilterFirewallBackend selects the tools that a network filter is
+// compiled for
+type NWFilterFirewallBackend string
+
+const (
+	NWFilterFirewallBackendIPTables NWFilterFirewallBackend = "iptables"
+	NWFilterFirewallBackendNftables NWFilterFirewallBackend = "nftables"
+)
+
+// NWFilterFirewallOptions controls how a network filter is compiled
+type NWFilterFirewallOptions struct {
+	// Tools to generate commands for, defaulting to ebtables,
+	// iptables and ip6tables
+	Backend NWFilterFirewallBackend
+	// Host side name of the guest interface, defaulting to vnet0
+	IfName string
+	// Filters which may be referenced by name
+	Filters []NWFilter
+	// Values of the variables used by the filters. A name which is
+	// given more than once holds a list of values
+	Parameters []NWFilterParameter
+}
+
+// NWFilterFirewall is the sequence of commands which would apply a
+// network filter to a guest interface
+type NWFilterFirewall struct {
+	Commands [][]string
+}
+
+const (
+	nwfilterDefaultIfName = "vnet0"
+	nwfilterMACBGA        = "01:80:c2:00:00:00"
+)
+
+type nwfilterL2Proto struct {
+	name      string
+	ethertype uint
+}
+
+// nwfilterL2Protocols are the protocols which may have their own
+// ebtables sub chain, matched against the start of the chain name
+var nwfilterL2Protocols = []nwfilterL2Proto{
+	{"ipv4", 0x0800},
+	{"ipv6", 0x86dd},
+	{"arp", 0x0806},
+	{"rarp", 0x8035},
+	{"vlan", 0x8100},
+	{"stp", 0},
+	{"mac", 0},
+}
+
+var nwfilterEtherTypes = map[string]uint64{
+	"arp":  0x0806,
+	"rarp": 0x8035,
+	"ipv4": 0x0800,
+	"ipv6": 0x86dd,
+}
+
+var nwfilterIPProtocols = map[string]uint64{
+	"icmp":    1,
+	"igmp":    2,
+	"tcp":     6,
+	"udp":     17,
+	"esp":     50,
+	"ah":      51,
+	"icmpv6":  58,
+	"sctp":    132,
+	"udplite": 136,
+}
+
+var nwfilterARPOpcodes = []string{
+	"Request",
+	"Reply",
+	"Request_Reverse",
+	"Reply_Reverse",
+	"DRARP_Request",
+	"DRARP_Reply",
+	"DRARP_Error",
+	"InARP_Request",
+	"ARP_NAK",
+}
+
+var nwfilterTCPFlags = []string{"FIN", "SYN", "RST", "PSH", "ACK", "URG"}
+
+var nwfilterStates = []string{"NEW", "ESTABLISHED", "RELATED", "INVALID"}
+
+// nwfilterRuleInst is a resolved rule being compiled, whose priority
+// may be raised to that of its chain
+type nwfilterRuleInst struct {
+	index         int
+	rule          *NWFilterRule
+	chain         string
+	chainPriority int
+	priority      int
+}
+
+func nwfilterHas(f *NWFilterField) bool {
+	return f != nil && (f.Var != "" || f.Str != "" || f.Uint != nil)
+}
+
+// nwfilterValue returns the value of a field of a resolved rule
+func nwfilterValue(f *NWFilterField) (string, error) {
+	if f.Var != "" {
+		return "", fmt.Errorf("Variable '%s' has not been resolved", f.Var)
+	}
+	if f.Str != "" {
+		return f.Str, nil
+	}
+	if f.Uint != nil {
+		return fmt.Sprintf("0x%x", *f.Uint), nil
+	}
+	return "", nil
+}
+
+type nwfilterDataType int
+
+const (
+	nwfilterTypeString nwfilterDataType = iota
+	nwfilterTypeMAC
+	nwfilterTypeIPv4
+	nwfilterTypeIPv4Mask
+	nwfilterTypeIPv6
+	nwfilterTypeIPv6Mask
+	nwfilterTypeUint8
+	nwfilterTypeUint16
+	nwfilterTypeUint32
+	nwfilterTypeEtherType
+	nwfilterTypeIPProtocol
+	nwfilterTypeARPOpcode
+	nwfilterTypeBool
+)
+
+func nwfilterParseMAC(val string) (net.HardwareAddr, error) {
+	parts := strings.Split(val, ":")
+	if len(parts) != 6 {
+		return nil, fmt.Errorf("Invalid MAC address '%s'", val)
+	}
+	mac := make(net.HardwareAddr, 6)
+	for i, part := range parts {
+		if len(part) < 1 || len(part) > 2 {
+			return nil, fmt.Errorf("Invalid MAC address '%s'", val)
+		}
+		b, err := strconv.ParseUint(part, 16, 8)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid MAC address '%s'", val)
+		}
+		mac[i] = byte(b)
+	}
+	return mac, nil
+}
+
+// nwfilterParseMask returns the prefix length of a mask given either
+// as a number or in address form
+func nwfilterParseMask(val string, bits int) (int, error) {
+	if n, err := strconv.ParseUint(val, 10, 8); err == nil {
+		if int(n) > bits {
+			return 0, fmt.Errorf("Invalid mask '%s'", val)
+		}
+		return int(n), nil
+	}
+	ip := net.ParseIP(val)
+	if ip == nil {
+		return 0, fmt.Errorf("Invalid mask '%s'", val)
+	}
+	if bits == 32 {
+		ip = ip.To4()
+		if ip == nil {
+			return 0, fmt.Errorf("Invalid mask '%s'", val)
+		}
+	}
+	ones, size := net.IPMask(ip).Size()
+	if size == 0 {
+		return 0, fmt.Errorf("Invalid mask '%s'", val)
+	}
+	return ones, nil
+}
+
+func nwfilterParseUint(val string, typ nwfilterDataType) (uint64, error) {
+	switch typ {
+	case nwfilterTypeEtherType:
+		if n, ok := nwfilterEtherTypes[val]; ok {
+			return n, nil
+		}
+	case nwfilterTypeIPProtocol:
+		if n, ok := nwfilterIPProtocols[val]; ok {
+			return n, nil
+		}
+	case nwfilterTypeARPOpcode:
+		for i, name := range nwfilterARPOpcodes {
+			if strings.EqualFold(name, val) {
+				return uint64(i + 1), nil
+			}
+		}
+	}
+
+	bits := 16
+	switch typ {
+	case nwfilterTypeUint8, nwfilterTypeIPProtocol:
+		bits = 8
+	case nwfilterTypeUint32:
+		bits = 32
+	}
+	var n uint64
+	var err error
+	if strings.HasPrefix(val, "0x") {
+		n, err = strconv.ParseUint(val[2:], 16, bits)
+	} else {
+		n, err = strconv.ParseUint(val, 10, bits)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("Invalid value '%s'", val)
+	}
+	return n, nil
+}
+
+func nwfilterParseBool(val string) (bool, error) {
+	switch val {
+	case "true", "yes", "1":
+		return true, nil
+	case "false", "no", "0":
+		return false, nil
+	}
+	return false, fmt.Errorf("Invalid boolean '%s'", val)
+}
+
+// nwfilterFormatValue normalizes a value from the filter XML into
+// the form used on the command line
+func nwfilterFormatValue(val string, typ nwfilterDataType, hex bool) (string, error) {
+	switch typ {
+	case nwfilterTypeMAC:
+		mac, err := nwfilterParseMAC(val)
+		if err != nil {
+			return "", err
+		}
+		return mac.String(), nil
+	case nwfilterTypeIPv4:
+		ip := net.ParseIP(val).To4()
+		if ip == nil {
+			return "", fmt.Errorf("Invalid IPv4 address '%s'", val)
+		}
+		return ip.String(), nil
+	case nwfilterTypeIPv6:
+		ip := net.ParseIP(val)
+		if ip == nil || !strings.Contains(val, ":") {
+			return "", fmt.Errorf("Invalid IPv6 address '%s'", val)
+		}
+		return ip.String(), nil
+	case nwfilterTypeIPv4Mask:
+		n, err := nwfilterParseMask(val, 32)
+		if err != nil {
+			return "", err
+		}
+		return strconv.Itoa(n), nil
+	case nwfilterTypeIPv6Mask:
+		n, err := nwfilterParseMask(val, 128)
+		if err != nil {
+			return "", err
+		}
+		return strconv.Itoa(n), nil
+	case nwfilterTypeBool:
+		b, err := nwfilterParseBool(val)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatBool(b), nil
+	case nwfilterTypeString:
+		return val, nil
+	}
+
+	n, err := nwfilterParseUint(val, typ)
+	if err != nil {
+		return "", err
+	}
+	if hex {
+		return fmt.Sprintf("0x%x", n), nil
+	}
+	return strconv.FormatUint(n, 10), nil
+}
+
+// nwfilterStateFlags parses the state attribute of a rule, returning
+// the states to match in the order iptables lists them
+func nwfilterStateFlags(val string) (string, bool, error) {
+	if val == "" {
+		return "", false, nil
+	}
+	set := make(map[string]bool)
+	for _, state := range strings.Split(val, ",") {
+		state = strings.ToUpper(strings.TrimSpace(state))
+		if state != "NONE" {
+			found := false
+			for _, name := range nwfilterStates {
+				if name == state {
+					found = true
+				}
+			}
+			if !found {
+				return "", false, fmt.Errorf("Invalid connection state '%s'", state)
+			}
+		}
+		set[state] = true
+	}
+	var states []string
+	if !set["NONE"] {
+		for _, name := range nwfilterStates {
+			if set[name] {
+				states = append(states, name)
+			}
+		}
+	}
+	return strings.Join(states, ","), true, nil
+}
+
+func nwfilterParseTCPFlagSet(val string) (uint, error) {
+	switch val {
+	case "ALL":
+		return 0x3f, nil
+	case "NONE":
+		return 0, nil
+	}
+	var flags uint
+	for _, name := range strings.Split(val, ",") {
+		found := false
+		for i, flag := range nwfilterTCPFlags {
+			if strings.EqualFold(flag, name) {
+				flags |= 1 << uint(i)
+				found = true
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("Invalid TCP flag '%s'", name)
+		}
+	}
+	return flags, nil
+}
+
+// nwfilterParseTCPFlags parses a TCP flags attribute of the form
+// "mask/flags"
+func nwfilterParseTCPFlags(val string) (uint, uint, error) {
+	parts := strings.Split(val, "/")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("Invalid TCP flags '%s'", val)
+	}
+	mask, err := nwfilterParseTCPFlagSet(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	flags, err := nwfilterParseTCPFlagSet(parts[1])
+	if err != nil {
+		return 0, 0, err
+	}
+	return mask, flags, nil
+}
+
+func nwfilterFormatTCPFlags(flags uint) string {
+	switch flags {
+	case 0:
+		return "NONE"
+	case 0x3f:
+		return "ALL"
+	}
+	var names []string
+	for i, name := range nwfilterTCPFlags {
+		if flags&(1<<uint(i)) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+type nwfilterLayer int
+
+const (
+	nwfilterLayerEthernet nwfilterLayer = iota
+	nwfilterLayerIPv4
+	nwfilterLayerIPv6
+)
+
+// nwfilterMatch is one match of a firewall rule, in the form of an
+// ebtables or iptables option, with the iptables match extension
+// which provides it
+type nwfilterMatch struct {
+	module string
+	opt    string
+	neg    bool
+	args   []string
+}
+
+// nwfilterFWRule is a rule for one of the per-interface chains.
+// Chains are identified by libvirt's prefixes, 'J' for traffic from
+// the guest and 'P' for traffic to the guest, with ebtables rules
+// also having the name of the filter chain they are placed in
+type nwfilterFWRule struct {
+	inst    *nwfilterRuleInst
+	layer   nwfilterLayer
+	prefix  string
+	chain   string
+	matches []nwfilterMatch
+	target  string
+	comment string
+}
+
+func (r *nwfilterFWRule) add(module, opt string, neg bool, args ...string) {
+	r.matches = append(r.matches, nwfilterMatch{module, opt, neg, args})
+}
+
+// nwfilterSubChain is an ebtables chain for the rules of filters
+// that are not in the root chain
+type nwfilterSubChain struct {
+	incoming bool
+	name     string
+	priority int
+}
+
+// nwfilterStep is either the creation of an ebtables sub chain or
+// an ebtables rule, since the two are interleaved by priority
+type nwfilterStep struct {
+	chain *nwfilterSubChain
+	rule  *nwfilterFWRule
+}
+
+// nwfilterPlan is the firewall setup for a filter independent of
+// the tools used to apply it
+type nwfilterPlan struct {
+	chainsIn  bool
+	chainsOut bool
+	ebtables  []nwfilterStep
+	haveIPv4  bool
+	haveIPv6  bool
+	ipv4      []*nwfilterFWRule
+	ipv6      []*nwfilterFWRule
+}
+
+// nwfilterL2Protocol returns the protocol of an ebtables sub chain,
+// or nil for the root chain
+func nwfilterL2Protocol(chain string) *nwfilterL2Proto {
+	for i := range nwfilterL2Protocols {
+		if strings.HasPrefix(chain, nwfilterL2Protocols[i].name) {
+			return &nwfilterL2Protocols[i]
+		}
+	}
+	return nil
+}
+
+// nwfilterL3Rule is a view of the rules which are handled by
+// iptables, since they share most of their attributes
+type nwfilterL3Rule struct {
+	proto    string
+	match    string
+	ip       *NWFilterRuleCommonIP
+	port     *NWFilterRuleCommonPort
+	flags    *NWFilterField
+	option   *NWFilterField
+	icmpType *NWFilterField
+	icmpCode *NWFilterField
+	comment  string
+}
+
+// nwfilterRuleLayer returns the layer a rule is applied at, along
+// with the view of its attributes for rules handled by iptables
+func nwfilterRuleLayer(rule *NWFilterRule) (nwfilterLayer, *nwfilterL3Rule, error) {
+	switch {
+	case rule.MAC != nil, rule.VLAN != nil, rule.STP != nil, rule.ARP != nil,
+		rule.RARP != nil, rule.IP != nil, rule.IPv6 != nil:
+		return nwfilterLayerEthernet, nil, nil
+	case rule.TCP != nil:
+		r := rule.TCP
+		return nwfilterLayerIPv4, &nwfilterL3Rule{
+			proto: "tcp", match: r.Match, ip: &r.NWFilterRuleCommonIP, port: &r.NWFilterRuleCommonPort,
+			flags: &r.Flags, option: &r.Option, comment: r.Comment,
+		}, nil
+	case rule.UDP != nil:
+		r := rule.UDP
+		return nwfilterLayerIPv4, &nwfilterL3Rule{
+			proto: "udp", match: r.Match, ip: &r.NWFilterRuleCommonIP, port: &r.NWFilterRuleCommonPort,
+			comment: r.Comment,
+		}, nil
+	case rule.UDPLite != nil:
+		r := rule.UDPLite
+		return nwfilterLayerIPv4, &nwfilterL3Rule{
+			proto: "udplite", match: r.Match, ip: &r.NWFilterRuleCommonIP, comment: r.Comment,
+		}, nil
+	case rule.ESP != nil:
+		r := rule.ESP
+		return nwfilterLayerIPv4, &nwfilterL3Rule{
+			proto: "esp", match: r.Match, ip: &r.NWFilterRuleCommonIP, comment: r.Comment,
+		}, nil
+	case rule.AH != nil:
+		r := rule.AH
+		return nwfilterLayerIPv4, &nwfilterL3Rule{
+			proto: "ah", match: r.Match, ip: &r.NWFilterRuleCommonIP, comment: r.Comment,
+		}, nil
+	case rule.SCTP != nil:
+		r := rule.SCTP
+		return nwfilterLayerIPv4, &nwfilterL3Rule{
+			proto: "sctp", match: r.Match, ip: &r.NWFilterRuleCommonIP, port: &r.NWFilterRuleCommonPort,
+			comment: r.Comment,
+		}, nil
+	case rule.ICMP != nil:
+		r := rule.ICMP
+		return nwfilterLayerIPv4, &nwfilterL3Rule{
+			proto: "icmp", match: r.Match, ip: &r.NWFilterRuleCommonIP,
+			icmpType: &r.Type, icmpCode: &r.Code, comment: r.Comment,
+		}, nil
+	case rule.IGMP != nil:
+		r := rule.IGMP
+		return nwfilterLayerIPv4, &nwfilterL3Rule{
+			proto: "igmp", match: r.Match, ip: &r.NWFilterRuleCommonIP, comment: r.Comment,
+		}, nil
+	case rule.All != nil:
+		r := rule.All
+		return nwfilterLayerIPv4, &nwfilterL3Rule{
+			proto: "all", match: r.Match, ip: &r.NWFilterRuleCommonIP, comment: r.Comment,
+		}, nil
+	case rule.TCPIPv6 != nil:
+		r := rule.TCPIPv6
+		return nwfilterLayerIPv6, &nwfilterL3Rule{
+			proto: "tcp", match: r.Match, ip: &r.NWFilterRuleCommonIP, port: &r.NWFilterRuleCommonPort,
+			option: &r.Option, comment: r.Comment,
+		}, nil
+	case rule.UDPIPv6 != nil:
+		r := rule.UDPIPv6
+		return nwfilterLayerIPv6, &nwfilterL3Rule{
+			proto: "udp", match: r.Match, ip: &r.NWFilterRuleCommonIP, port: &r.NWFilterRuleCommonPort,
+			comment: r.Comment,
+		}, nil
+	case rule.UDPLiteIPv6 != nil:
+		r := rule.UDPLiteIPv6
+		return nwfilterLayerIPv6, &nwfilterL3Rule{
+			proto: "udplite", match: r.Match, ip: &r.NWFilterRuleCommonIP, comment: r.Comment,
+		}, nil
+	case rule.ESPIPv6 != nil:
+		r := rule.ESPIPv6
+		return nwfilterLayerIPv6, &nwfilterL3Rule{
+			proto: "esp", match: r.Match, ip: &r.NWFilterRuleCommonIP, comment: r.Comment,
+		}, nil
+	case rule.AHIPv6 != nil:
+		r := rule.AHIPv6
+		return nwfilterLayerIPv6, &nwfilterL3Rule{
+			proto: "ah", match: r.Match, ip: &r.NWFilterRuleCommonIP, comment: r.Comment,
+		}, nil
+	case rule.SCTPIPv6 != nil:
+		r := rule.SCTPIPv6
+		return nwfilterLayerIPv6, &nwfilterL3Rule{
+			proto: "sctp", match: r.Match, ip: &r.NWFilterRuleCommonIP, port: &r.NWFilterRuleCommonPort,
+			comment: r.Comment,
+		}, nil
+	case rule.ICMPv6 != nil:
+		r := rule.ICMPv6
+		return nwfilterLayerIPv6, &nwfilterL3Rule{
+			proto: "icmpv6", match: r.Match, ip: &r.NWFilterRuleCommonIP,
+			icmpType: &r.Type, icmpCode: &r.Code, comment: r.Comment,
+		}, nil
+	case rule.AllIPv6 != nil:
+		r := rule.AllIPv6
+		return nwfilterLayerIPv6, &nwfilterL3Rule{
+			proto: "all", match: r.Match, ip: &r.NWFilterRuleCommonIP, comment: r.Comment,
+		}, nil
+	}
+	return 0, nil, fmt.Errorf("Rule has no protocol")
+}
+
+func nwfilterJumpTarget(action string) (string, error) {
+	switch action {
+	case "drop", "accept", "reject", "return", "continue":
+		return strings.ToUpper(action), nil
+	case "":
+		return "", fmt.Errorf("Rule has no action")
+	}
+	return "", fmt.Errorf("Invalid rule action '%s'", action)
+}
+
+// nwfilterRuleBuilder accumulates the matches of a firewall rule,
+// remembering the first error hit while formatting values
+type nwfilterRuleBuilder struct {
+	rule *nwfilterFWRule
+	err  error
+}
+
+func (b *nwfilterRuleBuilder) format(f *NWFilterField, typ nwfilterDataType, hex bool) string {
+	if b.err != nil || !nwfilterHas(f) {
+		return ""
+	}
+	val, err := nwfilterValue(f)
+	if err == nil {
+		val, err = nwfilterFormatValue(val, typ, hex)
+	}
+	if err != nil {
+		b.err = err
+		return ""
+	}
+	return val
+}
+
+func (b *nwfilterRuleBuilder) print(f *NWFilterField, typ nwfilterDataType) string {
+	return b.format(f, typ, false)
+}
+
+func (b *nwfilterRuleBuilder) printHex(f *NWFilterField, typ nwfilterDataType) string {
+	return b.format(f, typ, true)
+}
+
+// joined prints a field, followed by a second one such as a mask
+// or the end of a range if that is also set
+func (b *nwfilterRuleBuilder) joined(f *NWFilterField, typ nwfilterDataType,
+	hi *NWFilterField, hiTyp nwfilterDataType, sep string) string {
+	val := b.print(f, typ)
+	if val != "" && nwfilterHas(hi) {
+		val += sep + b.print(hi, hiTyp)
+	}
+	return val
+}
+
+// item adds an option when its value is set
+func (b *nwfilterRuleBuilder) item(module, opt string, neg bool, val string) {
+	if val != "" {
+		b.rule.add(module, opt, neg, val)
+	}
+}
+
+func (b *nwfilterRuleBuilder) ethHdr(eth *NWFilterRuleCommonMAC, neg, reverse bool) {
+	src, dst := "-s", "-d"
+	if reverse {
+		src, dst = dst, src
+	}
+	b.item("", src, neg, b.joined(&eth.SrcMACAddr, nwfilterTypeMAC, &eth.SrcMACMask, nwfilterTypeMAC, "/"))
+	b.item("", dst, neg, b.joined(&eth.DstMACAddr, nwfilterTypeMAC, &eth.DstMACMask, nwfilterTypeMAC, "/"))
+}
+
+func (b *nwfilterRuleBuilder) arpHdr(arp *NWFilterRuleARP, ethertype uint, reverse bool) {
+	neg := arp.Match == "no"
+	b.ethHdr(&arp.NWFilterRuleCommonMAC, neg, reverse)
+	b.rule.add("", "-p", false, fmt.Sprintf("0x%x", ethertype))
+	b.item("", "--arp-htype", neg, b.print(&arp.HWType, nwfilterTypeUint16))
+	b.item("", "--arp-opcode", neg, b.print(&arp.OpCode, nwfilterTypeARPOpcode))
+	b.item("", "--arp-ptype", neg, b.printHex(&arp.ProtocolType, nwfilterTypeUint16))
+
+	ipsrc, ipdst := "--arp-ip-src", "--arp-ip-dst"
+	macsrc, macdst := "--arp-mac-src", "--arp-mac-dst"
+	if reverse {
+		ipsrc, ipdst = ipdst, ipsrc
+		macsrc, macdst = macdst, macsrc
+	}
+	b.item("", ipsrc, neg, b.arpIP(&arp.ARPSrcIPAddr, &arp.ARPSrcIPMask))
+	b.item("", ipdst, neg, b.arpIP(&arp.ARPDstIPAddr, &arp.ARPDstIPMask))
+	b.item("", macsrc, neg, b.print(&arp.ARPSrcMACAddr, nwfilterTypeMAC))
+	b.item("", macdst, neg, b.print(&arp.ARPDstMACAddr, nwfilterTypeMAC))
+	if b.print(&arp.Gratuitous, nwfilterTypeBool) == "true" {
+		b.rule.add("", "--arp-gratuitous", neg)
+	}
+}
+
+// arpIP prints an ARP IP address, which always has a mask
+func (b *nwfilterRuleBuilder) arpIP(addr, mask *NWFilterField) string {
+	val := b.print(addr, nwfilterTypeIPv4)
+	if val == "" {
+		return ""
+	}
+	if nwfilterHas(mask) {
+		return val + "/" + b.print(mask, nwfilterTypeIPv4Mask)
+	}
+	return val + "/32"
+}
+
+// nwfilterEbtablesRule mirrors libvirt's ebtablesCreateRuleInstance,
+// where reverse swaps the source and destination attributes
+func nwfilterEbtablesRule(inst *nwfilterRuleInst, prefix string, reverse bool) (*nwfilterFWRule, error) {
+	rule := inst.rule
+	b := &nwfilterRuleBuilder{
+		rule: &nwfilterFWRule{
+			inst:   inst,
+			layer:  nwfilterLayerEthernet,
+			prefix: prefix,
+			chain:  inst.chain,
+		},
+	}
+
+	switch {
+	case rule.MAC != nil:
+		mac := rule.MAC
+		neg := mac.Match == "no"
+		b.ethHdr(&mac.NWFilterRuleCommonMAC, neg, reverse)
+		b.item("", "-p", neg, b.printHex(&mac.ProtocolID, nwfilterTypeEtherType))
+
+	case rule.VLAN != nil:
+		vlan := rule.VLAN
+		neg := vlan.Match == "no"
+		b.ethHdr(&vlan.NWFilterRuleCommonMAC, neg, reverse)
+		b.rule.add("", "-p", false, "0x8100")
+		b.item("", "--vlan-id", neg, b.print(&vlan.VLANID, nwfilterTypeUint16))
+		b.item("", "--vlan-encap", neg, b.print(&vlan.EncapProtocol, nwfilterTypeEtherType))
+
+	case rule.STP != nil:
+		stp := rule.STP
+		neg := stp.Match.Str == "no"
+		// The reverse direction matches the BPDU destination address
+		// so cannot also match the source address
+		if reverse && nwfilterHas(&stp.SrcMACAddr) {
+			return nil, fmt.Errorf("STP filtering in inout direction with source MAC address set is not supported")
+		}
+		b.ethHdr(&NWFilterRuleCommonMAC{
+			SrcMACAddr: stp.SrcMACAddr,
+			SrcMACMask: stp.SrcMACMask,
+		}, neg, reverse)
+		b.rule.add("", "-d", false, nwfilterMACBGA)
+		b.item("", "--stp-type", neg, b.print(&stp.Type, nwfilterTypeUint8))
+		b.item("", "--stp-flags", neg, b.print(&stp.Flags, nwfilterTypeUint8))
+		b.item("", "--stp-root-pri", neg,
+			b.joined(&stp.RootPriority, nwfilterTypeUint16, &stp.RootPriorityHi, nwfilterTypeUint16, ":"))
+		b.item("", "--stp-root-addr", neg,
+			b.joined(&stp.RootAddress, nwfilterTypeMAC, &stp.RootAddressMask, nwfilterTypeMAC, "/"))
+		b.item("", "--stp-root-cost", neg,
+			b.joined(&stp.RootCost, nwfilterTypeUint32, &stp.RootCostHi, nwfilterTypeUint32, ":"))
+		b.item("", "--stp-sender-prio", neg,
+			b.joined(&stp.SenderPriority, nwfilterTypeUint16, &stp.SenderPriorityHi, nwfilterTypeUint16, ":"))
+		b.item("", "--stp-sender-addr", neg,
+			b.joined(&stp.SenderAddress, nwfilterTypeMAC, &stp.SenderAddressMask, nwfilterTypeMAC, "/"))
+		b.item("", "--stp-port", neg,
+			b.joined(&stp.Port, nwfilterTypeUint16, &stp.PortHi, nwfilterTypeUint16, ":"))
+		b.item("", "--stp-msg-age", neg,
+			b.joined(&stp.Age, nwfilterTypeUint16, &stp.AgeHi, nwfilterTypeUint16, ":"))
+		b.item("", "--stp-max-age", neg,
+			b.joined(&stp.MaxAge, nwfilterTypeUint16, &stp.MaxAgeHi, nwfilterTypeUint16, ":"))
+		b.item("", "--stp-hello-time", neg,
+			b.joined(&stp.HelloTime, nwfilterTypeUint16, &stp.HelloTimeHi, nwfilterTypeUint16, ":"))
+		b.item("", "--stp-forward-delay", neg,
+			b.joined(&stp.ForwardDelay, nwfilterTypeUint16, &stp.ForwardDelayHi, nwfilterTypeUint16, ":"))
+
+	case rule.ARP != nil:
+		b.arpHdr(rule.ARP, 0x0806, reverse)
+
+	case rule.RARP != nil:
+		rarp := NWFilterRuleARP(*rule.RARP)
+		b.arpHdr(&rarp, 0x8035, reverse)
+
+	case rule.IP != nil:
+		ip := rule.IP
+		neg := ip.Match == "no"
+		src, dst := "--ip-source", "--ip-destination"
+		sport, dport := "--ip-source-port", "--ip-destination-port"
+		if reverse {
+			src, dst = dst, src
+			sport, dport = dport, sport
+		}
+		b.ethHdr(&ip.NWFilterRuleCommonMAC, neg, reverse)
+		b.rule.add("", "-p", false, "ipv4")
+		b.item("", src, neg, b.joined(&ip.SrcIPAddr, nwfilterTypeIPv4, &ip.SrcIPMask, nwfilterTypeIPv4Mask, "/"))
+		b.item("", dst, neg, b.joined(&ip.DstIPAddr, nwfilterTypeIPv4, &ip.DstIPMask, nwfilterTypeIPv4Mask, "/"))
+		b.item("", "--ip-protocol", neg, b.print(&ip.Protocol, nwfilterTypeIPProtocol))
+		b.item("", sport, neg,
+			b.joined(&ip.SrcPortStart, nwfilterTypeUint16, &ip.SrcPortEnd, nwfilterTypeUint16, ":"))
+		b.item("", dport, neg,
+			b.joined(&ip.DstPortStart, nwfilterTypeUint16, &ip.DstPortEnd, nwfilterTypeUint16, ":"))
+		b.item("", "--ip-tos", neg, b.printHex(&ip.DSCP, nwfilterTypeUint8))
+
+	case rule.IPv6 != nil:
+		ip := rule.IPv6
+		neg := ip.Match == "no"
+		src, dst := "--ip6-source", "--ip6-destination"
+		sport, dport := "--ip6-source-port", "--ip6-destination-port"
+		if reverse {
+			src, dst = dst, src
+			sport, dport = dport, sport
+		}
+		b.ethHdr(&ip.NWFilterRuleCommonMAC, neg, reverse)
+		b.rule.add("", "-p", false, "ipv6")
+		b.item("", src, neg, b.joined(&ip.SrcIPAddr, nwfilterTypeIPv6, &ip.SrcIPMask, nwfilterTypeIPv6Mask, "/"))
+		b.item("", dst, neg, b.joined(&ip.DstIPAddr, nwfilterTypeIPv6, &ip.DstIPMask, nwfilterTypeIPv6Mask, "/"))
+		b.item("", "--ip6-protocol", neg, b.print(&ip.Protocol, nwfilterTypeIPProtocol))
+		b.item("", sport, neg,
+			b.joined(&ip.SrcPortStart, nwfilterTypeUint16, &ip.SrcPortEnd, nwfilterTypeUint16, ":"))
+		b.item("", dport, neg,
+			b.joined(&ip.DstPortStart, nwfilterTypeUint16, &ip.DstPortEnd, nwfilterTypeUint16, ":"))
+		if nwfilterHas(&ip.Type) || nwfilterHas(&ip.TypeEnd) ||
+			nwfilterHas(&ip.Code) || nwfilterHas(&ip.CodeEnd) {
+			b.rule.add("", "--ip6-icmp-type", neg,
+				b.icmpRange(&ip.Type, &ip.TypeEnd)+"/"+b.icmpRange(&ip.Code, &ip.CodeEnd))
+		}
+
+	default:
+		return nil, fmt.Errorf("Rule has no ethernet protocol")
+	}
+
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	target, err := nwfilterJumpTarget(rule.Action)
+	if err != nil {
+		return nil, err
+	}
+	// ebtables has no REJECT target
+	if target == "REJECT" {
+		target = "DROP"
+	}
+	b.rule.target = target
+	return b.rule, nil
+}
+
+// icmpRange prints an ICMPv6 type or code range for ebtables, where
+// a missing start means 0 and a missing end means the start, or 255
+// if neither is given
+func (b *nwfilterRuleBuilder) icmpRange(start, end *NWFilterField) string {
+	lo := "0"
+	hi := "255"
+	if nwfilterHas(start) {
+		lo = b.print(start, nwfilterTypeUint8)
+		hi = lo
+	}
+	if nwfilterHas(end) {
+		hi = b.print(end, nwfilterTypeUint8)
+	}
+	return lo + ":" + hi
+}
+
+// nwfilterEbtablesRules creates the ebtables rules for a rule,
+// where traffic from the guest goes through the 'J' chains and
+// traffic to the guest through the 'P' chains. The attributes of
+// inout rules describe the traffic to the guest
+func nwfilterEbtablesRules(inst *nwfilterRuleInst) ([]*nwfilterFWRule, error) {
+	var rules []*nwfilterFWRule
+	add := func(prefix string, reverse bool) error {
+		rule, err := nwfilterEbtablesRule(inst, prefix, reverse)
+		if err != nil {
+			return err
+		}
+		rules = append(rules, rule)
+		return nil
+	}
+
+	var err error
+	switch inst.rule.Direction {
+	case "inout":
+		err = add("J", true)
+		if err == nil {
+			err = add("P", false)
+		}
+	case "out":
+		err = add("J", false)
+	case "in":
+		err = add("P", false)
+	default:
+		err = fmt.Errorf("Invalid rule direction '%s'", inst.rule.Direction)
+	}
+	return rules, err
+}
+
+const (
+	nwfilterMatchStateOut = "NEW,ESTABLISHED"
+	nwfilterMatchStateIn  = "ESTABLISHED"
+)
+
+// nwfilterIPTablesRule mirrors libvirt's _iptablesCreateRuleInstance,
+// returning nil if the rule does not apply to the chain. Where dirIn
+// is set, the source and destination attributes are swapped
+func nwfilterIPTablesRule(inst *nwfilterRuleInst, l3 *nwfilterL3Rule, layer nwfilterLayer,
+	prefix string, dirIn bool, state string, defMatch bool, acceptTarget string,
+	maySkipICMP bool) (*nwfilterFWRule, error) {
+	b := &nwfilterRuleBuilder{
+		rule: &nwfilterFWRule{
+			inst:    inst,
+			layer:   layer,
+			prefix:  prefix,
+			comment: l3.comment,
+		},
+	}
+	neg := l3.match == "no"
+	ip := l3.ip
+	addrType, maskType := nwfilterTypeIPv4, nwfilterTypeIPv4Mask
+	if layer == nwfilterLayerIPv6 {
+		addrType, maskType = nwfilterTypeIPv6, nwfilterTypeIPv6Mask
+	}
+
+	b.rule.add("", "-p", false, l3.proto)
+	nmatches := len(b.rule.matches)
+
+	// The source MAC address is only known for traffic from the guest
+	srcMACSkipped := false
+	if nwfilterHas(&ip.SrcMACAddr) {
+		if dirIn {
+			srcMACSkipped = true
+		} else {
+			b.item("mac", "--mac-source", neg, b.print(&ip.SrcMACAddr, nwfilterTypeMAC))
+		}
+	}
+
+	src, dst := "--source", "--destination"
+	srcRange, dstRange := "--src-range", "--dst-range"
+	if dirIn {
+		src, dst = dst, src
+		srcRange, dstRange = dstRange, srcRange
+	}
+	if nwfilterHas(&ip.SrcIPAddr) {
+		b.item("", src, neg, b.joined(&ip.SrcIPAddr, addrType, &ip.SrcIPMask, maskType, "/"))
+	} else if nwfilterHas(&ip.SrcIPFrom) {
+		b.item("iprange", srcRange, neg, b.joined(&ip.SrcIPFrom, addrType, &ip.SrcIPTo, addrType, "-"))
+	}
+	if nwfilterHas(&ip.DstIPAddr) {
+		b.item("", dst, neg, b.joined(&ip.DstIPAddr, addrType, &ip.DstIPMask, maskType, "/"))
+	} else if nwfilterHas(&ip.DstIPFrom) {
+		b.item("iprange", dstRange, neg, b.joined(&ip.DstIPFrom, addrType, &ip.DstIPTo, addrType, "-"))
+	}
+	b.item("dscp", "--dscp", neg, b.print(&ip.DSCP, nwfilterTypeUint8))
+
+	// Connection limits only apply to traffic from the guest, and
+	// replace the state match
+	skipRule := false
+	skipMatch := false
+	if nwfilterHas(&ip.ConnLimitAbove) {
+		if dirIn {
+			skipRule = true
+		} else {
+			skipMatch = true
+		}
+	}
+
+	if nwfilterHas(l3.flags) && b.err == nil {
+		val, err := nwfilterValue(l3.flags)
+		if err != nil {
+			return nil, err
+		}
+		mask, flags, err := nwfilterParseTCPFlags(val)
+		if err != nil {
+			return nil, err
+		}
+		b.rule.add("", "--tcp-flags", neg, nwfilterFormatTCPFlags(mask), nwfilterFormatTCPFlags(flags))
+	}
+
+	if l3.port != nil {
+		port := l3.port
+		sport, dport := "--sport", "--dport"
+		if dirIn {
+			sport, dport = dport, sport
+		}
+		b.item("", sport, neg,
+			b.joined(&port.SrcPortStart, nwfilterTypeUint16, &port.SrcPortEnd, nwfilterTypeUint16, ":"))
+		b.item("", dport, neg,
+			b.joined(&port.DstPortStart, nwfilterTypeUint16, &port.DstPortEnd, nwfilterTypeUint16, ":"))
+	}
+
+	b.item("", "--tcp-option", neg, b.print(l3.option, nwfilterTypeUint8))
+
+	if nwfilterHas(l3.icmpType) {
+		// ICMP types are only matched in the direction they are
+		// sent in
+		if maySkipICMP {
+			return nil, nil
+		}
+		opt := "--icmp-type"
+		if l3.proto == "icmpv6" {
+			opt = "--icmpv6-type"
+		}
+		val := b.print(l3.icmpType, nwfilterTypeUint8)
+		if nwfilterHas(l3.icmpCode) {
+			val += "/" + b.print(l3.icmpCode, nwfilterTypeUint8)
+		}
+		b.rule.add("", opt, neg, val)
+	}
+
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	if (srcMACSkipped && len(b.rule.matches) == nmatches) || skipRule {
+		return nil, nil
+	}
+
+	target := acceptTarget
+	if inst.rule.Action != "accept" {
+		var err error
+		target, err = nwfilterJumpTarget(inst.rule.Action)
+		if err != nil {
+			return nil, err
+		}
+		skipMatch = defMatch
+	}
+
+	if state != "" && !skipMatch {
+		b.rule.add("state", "--state", false, state)
+	}
+
+	if nwfilterHas(&ip.IPSet) && nwfilterHas(&ip.IPSetFlags) {
+		name := b.print(&ip.IPSet, nwfilterTypeString)
+		flags := b.ipsetFlags(&ip.IPSetFlags, dirIn)
+		if b.err != nil {
+			return nil, b.err
+		}
+		b.rule.add("set", "--match-set", neg, name, flags)
+	}
+
+	// Placed after the state match since this is the most useful
+	// order
+	if !dirIn {
+		b.item("connlimit", "--connlimit-above", neg, b.print(&ip.ConnLimitAbove, nwfilterTypeUint16))
+	}
+
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	b.rule.target = target
+	return b.rule, nil
+}
+
+// ipsetFlags prints the ipset flags, which refer to the other end
+// of the connection when dirIn is set
+func (b *nwfilterRuleBuilder) ipsetFlags(f *NWFilterField, dirIn bool) string {
+	if b.err != nil {
+		return ""
+	}
+	val, err := nwfilterValue(f)
+	if err != nil {
+		b.err = err
+		return ""
+	}
+	flags := strings.Split(val, ",")
+	if len(flags) > 6 {
+		b.err = fmt.Errorf("Too many ipset flags in '%s'", val)
+		return ""
+	}
+	for i, flag := range flags {
+		switch flag {
+		case "src":
+			if dirIn {
+				flags[i] = "dst"
+			}
+		case "dst":
+			if dirIn {
+				flags[i] = "src"
+			}
+		default:
+			b.err = fmt.Errorf("Invalid ipset flag '%s'", flag)
+			return ""
+		}
+	}
+	return strings.Join(flags, ",")
+}
+
+// nwfilterIPTablesRules mirrors libvirt's iptablesCreateRuleInstance,
+// creating rules for the chains seeing traffic from the guest (FJ),
+// traffic to the guest (FP) and traffic from the guest to the host
+// (HJ)
+func nwfilterIPTablesRules(inst *nwfilterRuleInst, layer nwfilterLayer, l3 *nwfilterL3Rule) ([]*nwfilterFWRule, error) {
+	rule := inst.rule
+	var dirIn, inout bool
+	switch rule.Direction {
+	case "in":
+		dirIn = true
+	case "inout":
+		dirIn = true
+		inout = true
+	case "out":
+	default:
+		return nil, fmt.Errorf("Invalid rule direction '%s'", rule.Direction)
+	}
+
+	states, haveStates, err := nwfilterStateFlags(l3.ip.State.Str)
+	if err != nil {
+		return nil, err
+	}
+	noStateMatch := rule.StateMatch == "0" || rule.StateMatch == "false"
+
+	var rules []*nwfilterFWRule
+	create := func(prefix string, dirIn bool, state string, defMatch bool,
+		acceptTarget string, maySkipICMP bool) error {
+		r, err := nwfilterIPTablesRule(inst, l3, layer, prefix, dirIn, state,
+			defMatch, acceptTarget, maySkipICMP)
+		if err != nil {
+			return err
+		}
+		if r != nil {
+			rules = append(rules, r)
+		}
+		return nil
+	}
+
+	// An explicit state only applies in the direction of the rule
+	if !noStateMatch && haveStates {
+		if !dirIn || inout {
+			if err := create("FJ", dirIn, states, false, "RETURN", false); err != nil {
+				return nil, err
+			}
+		}
+		if dirIn {
+			if err := create("FP", !dirIn, states, false, "ACCEPT", false); err != nil {
+				return nil, err
+			}
+		}
+		if !dirIn || inout {
+			if err := create("HJ", dirIn, states, false, "RETURN", false); err != nil {
+				return nil, err
+			}
+		}
+		return rules, nil
+	}
+
+	needState := !noStateMatch && !inout
+	state := func(out bool) string {
+		if !needState {
+			return ""
+		}
+		if out {
+			return nwfilterMatchStateOut
+		}
+		return nwfilterMatchStateIn
+	}
+
+	if err := create("FJ", dirIn, state(!dirIn), true, "RETURN", dirIn || inout); err != nil {
+		return nil, err
+	}
+	if err := create("FP", !dirIn, state(dirIn), true, "ACCEPT", !dirIn || inout); err != nil {
+		return nil, err
+	}
+	if err := create("HJ", dirIn, state(!dirIn), true, "RETURN", dirIn); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// nwfilterSubChains returns the ebtables sub chains needed for a
+// direction in order of priority
+func nwfilterSubChains(chains map[string]int, incoming bool) []*nwfilterSubChain {
+	var ret []*nwfilterSubChain
+	for name, priority := range chains {
+		if nwfilterL2Protocol(name) == nil {
+			continue
+		}
+		ret = append(ret, &nwfilterSubChain{incoming, name, priority})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].priority != ret[j].priority {
+			return ret[i].priority < ret[j].priority
+		}
+		return ret[i].name < ret[j].name
+	})
+	return ret
+}
+
+// nwfilterBuildPlan mirrors libvirt's ebiptablesApplyNewRules, which
+// expects the rules to be sorted already
+func nwfilterBuildPlan(insts []*nwfilterRuleInst) (*nwfilterPlan, error) {
+	plan := &nwfilterPlan{}
+
+	chainsIn := make(map[string]int)
+	chainsOut := make(map[string]int)
+	for _, inst := range insts {
+		layer, _, err := nwfilterRuleLayer(inst.rule)
+		if err != nil {
+			return nil, err
+		}
+		if layer != nwfilterLayerEthernet {
+			continue
+		}
+		dir := inst.rule.Direction
+		if dir == "out" || dir == "inout" {
+			chainsIn[inst.chain] = inst.chainPriority
+		}
+		if dir == "in" || dir == "inout" {
+			chainsOut[inst.chain] = inst.chainPriority
+		}
+	}
+	plan.chainsIn = len(chainsIn) > 0
+	plan.chainsOut = len(chainsOut) > 0
+
+	subchains := append(nwfilterSubChains(chainsIn, true), nwfilterSubChains(chainsOut, false)...)
+	sort.SliceStable(subchains, func(i, j int) bool {
+		return subchains[i].priority < subchains[j].priority
+	})
+
+	// Raise the priority of rules to that of their chain, so that
+	// the chain is created before its rules
+	for _, inst := range insts {
+		if inst.chain != nwfilterRootChain && inst.chainPriority > inst.priority {
+			inst.priority = inst.chainPriority
+		}
+	}
+
+	next := 0
+	for _, inst := range insts {
+		layer, l3, _ := nwfilterRuleLayer(inst.rule)
+		switch layer {
+		case nwfilterLayerEthernet:
+			for next < len(subchains) && subchains[next].priority <= inst.priority {
+				plan.ebtables = append(plan.ebtables, nwfilterStep{chain: subchains[next]})
+				next++
+			}
+			rules, err := nwfilterEbtablesRules(inst)
+			if err != nil {
+				return nil, err
+			}
+			for _, rule := range rules {
+				plan.ebtables = append(plan.ebtables, nwfilterStep{rule: rule})
+			}
+		case nwfilterLayerIPv4:
+			plan.haveIPv4 = true
+			rules, err := nwfilterIPTablesRules(inst, layer, l3)
+			if err != nil {
+				return nil, err
+			}
+			plan.ipv4 = append(plan.ipv4, rules...)
+		case nwfilterLayerIPv6:
+			plan.haveIPv6 = true
+			rules, err := nwfilterIPTablesRules(inst, layer, l3)
+			if err != nil {
+				return nil, err
+			}
+			plan.ipv6 = append(plan.ipv6, rules...)
+		}
+	}
+	for ; next < len(subchains); next++ {
+		plan.ebtables = append(plan.ebtables, nwfilterStep{chain: subchains[next]})
+	}
+
+	return plan, nil
+}
+
+// nwfilterIPTablesChain returns the name libvirt uses for one of the
+// per-interface chains
+func nwfilterIPTablesChain(layer nwfilterLayer, prefix, chain, ifname string) string {
+	if layer != nwfilterLayerEthernet {
+		return prefix + "-" + ifname
+	}
+	if chain == nwfilterRootChain {
+		return "libvirt-" + prefix + "-" + ifname
+	}
+	return prefix + "-" + ifname + "-" + chain
+}
+
+func nwfilterIPTablesRuleArgs(rule *nwfilterFWRule, ifname string) []string {
+	chain := nwfilterIPTablesChain(rule.layer, rule.prefix, rule.chain, ifname)
+	var args []string
+	switch rule.layer {
+	case nwfilterLayerEthernet:
+		args = []string{"ebtables", "--concurrent", "-t", "nat", "-A", chain}
+		for _, m := range rule.matches {
+			if len(m.args) == 0 {
+				if m.neg {
+					args = append(args, "!")
+				}
+				args = append(args, m.opt)
+				continue
+			}
+			args = append(args, m.opt)
+			if m.neg {
+				args = append(args, "!")
+			}
+			args = append(args, m.args...)
+		}
+	case nwfilterLayerIPv4, nwfilterLayerIPv6:
+		tool := "iptables"
+		if rule.layer == nwfilterLayerIPv6 {
+			tool = "ip6tables"
+		}
+		args = []string{tool, "-w", "-A", chain}
+		for _, m := range rule.matches {
+			if m.module != "" {
+				args = append(args, "-m", m.module)
+			}
+			if m.neg {
+				args = append(args, "!")
+			}
+			args = append(args, m.opt)
+			args = append(args, m.args...)
+		}
+		// Comments are kept after everything else since they
+		// do not affect matching
+		if rule.comment != "" {
+			args = append(args, "-m", "comment", "--comment", rule.comment)
+		}
+	}
+	return append(args, "-j", rule.target)
+}
+
+// nwfilterIPTablesCommands renders the plan as the ebtables, iptables
+// and ip6tables commands libvirt runs to apply a filter
+func nwfilterIPTablesCommands(plan *nwfilterPlan, ifname string) [][]string {
+	var cmds [][]string
+	ebtables := func(args ...string) {
+		cmds = append(cmds, append([]string{"ebtables", "--concurrent", "-t", "nat"}, args...))
+	}
+	rootIn := nwfilterIPTablesChain(nwfilterLayerEthernet, "J", nwfilterRootChain, ifname)
+	rootOut := nwfilterIPTablesChain(nwfilterLayerEthernet, "P", nwfilterRootChain, ifname)
+
+	// Clean up anything left from an earlier attempt
+	ebtables("-D", "PREROUTING", "-i", ifname, "-j", rootIn)
+	ebtables("-D", "POSTROUTING", "-o", ifname, "-j", rootOut)
+	ebtables("-L", rootIn)
+	ebtables("-L", rootOut)
+	ebtables("-F", rootIn)
+	ebtables("-X", rootIn)
+	ebtables("-F", rootOut)
+	ebtables("-X", rootOut)
+
+	if plan.chainsIn {
+		ebtables("-N", rootIn)
+	}
+	if plan.chainsOut {
+		ebtables("-N", rootOut)
+	}
+
+	for _, step := range plan.ebtables {
+		if step.rule != nil {
+			cmds = append(cmds, nwfilterIPTablesRuleArgs(step.rule, ifname))
+			continue
+		}
+		prefix := "P"
+		if step.chain.incoming {
+			prefix = "J"
+		}
+		root := nwfilterIPTablesChain(nwfilterLayerEthernet, prefix, nwfilterRootChain, ifname)
+		chain := nwfilterIPTablesChain(nwfilterLayerEthernet, prefix, step.chain.name, ifname)
+		ebtables("-F", chain)
+		ebtables("-X", chain)
+		ebtables("-N", chain)
+		args := []string{"-A", root}
+		switch proto := nwfilterL2Protocol(step.chain.name); proto.name {
+		case "mac":
+		case "stp":
+			args = append(args, "-d", nwfilterMACBGA)
+		default:
+			args = append(args, "-p", fmt.Sprintf("0x%04x", proto.ethertype))
+		}
+		ebtables(append(args, "-j", chain)...)
+	}
+
+	if plan.haveIPv4 {
+		cmds = append(cmds, nwfilterIPTablesChainCommands("iptables", ifname)...)
+		for _, rule := range plan.ipv4 {
+			cmds = append(cmds, nwfilterIPTablesRuleArgs(rule, ifname))
+		}
+	}
+	if plan.haveIPv6 {
+		cmds = append(cmds, nwfilterIPTablesChainCommands("ip6tables", ifname)...)
+		for _, rule := range plan.ipv6 {
+			cmds = append(cmds, nwfilterIPTablesRuleArgs(rule, ifname))
+		}
+	}
+
+	if plan.chainsIn {
+		ebtables("-A", "PREROUTING", "-i", ifname, "-j", rootIn)
+	}
+	if plan.chainsOut {
+		ebtables("-A", "POSTROUTING", "-o", ifname, "-j", rootOut)
+	}
+	return cmds
+}
+
+// nwfilterIPTablesChainCommands sets up the libvirt chains shared by
+// all interfaces and the per-interface chains for iptables or
+// ip6tables
+func nwfilterIPTablesChainCommands(tool, ifname string) [][]string {
+	var cmds [][]string
+	iptables := func(args ...string) {
+		cmds = append(cmds, append([]string{tool, "-w"}, args...))
+	}
+	fp := "FP-" + ifname
+	fj := "FJ-" + ifname
+	hj := "HJ-" + ifname
+
+	iptables("-D", "libvirt-out", "-m", "physdev", "--physdev-is-bridged", "--physdev-out", ifname, "-g", fp)
+	iptables("-D", "libvirt-out", "-m", "physdev", "--physdev-out", ifname, "-g", fp)
+	iptables("-D", "libvirt-in", "-m", "physdev", "--physdev-in", ifname, "-g", fj)
+	iptables("-D", "libvirt-host-in", "-m", "physdev", "--physdev-in", ifname, "-g", hj)
+	for _, chain := range []string{fp, fj, hj} {
+		iptables("-F", chain)
+		iptables("-X", chain)
+	}
+
+	for _, chain := range []string{"libvirt-in", "libvirt-out", "libvirt-in-post", "libvirt-host-in"} {
+		iptables("-N", chain)
+	}
+	iptables("-D", "FORWARD", "-j", "libvirt-in")
+	iptables("-D", "FORWARD", "-j", "libvirt-out")
+	iptables("-D", "FORWARD", "-j", "libvirt-in-post")
+	iptables("-D", "INPUT", "-j", "libvirt-host-in")
+	iptables("-I", "FORWARD", "1", "-j", "libvirt-in")
+	iptables("-I", "FORWARD", "2", "-j", "libvirt-out")
+	iptables("-I", "FORWARD", "3", "-j", "libvirt-in-post")
+	iptables("-I", "INPUT", "1", "-j", "libvirt-host-in")
+
+	for _, chain := range []string{fp, fj, hj} {
+		iptables("-N", chain)
+	}
+	iptables("-A", "libvirt-out", "-m", "physdev", "--physdev-is-bridged", "--physdev-out", ifname, "-g", fp)
+	iptables("-A", "libvirt-in", "-m", "physdev", "--physdev-in", ifname, "-g", fj)
+	iptables("-A", "libvirt-host-in", "-m", "physdev", "--physdev-in", ifname, "-g", hj)
+	iptables("-D", "libvirt-in-post", "-m", "physdev", "--physdev-in", ifname, "-j", "ACCEPT")
+	iptables("-A", "libvirt-in-post", "-m", "physdev", "--physdev-in", ifname, "-j", "ACCEPT")
+	return cmds
+}
+
+func nwfilterFirewall(rules []NWFilterResolvedRule, ifname string,
+	backend NWFilterFirewallBackend) (*NWFilterFirewall, error) {
+	if ifname == "" {
+		ifname = nwfilterDefaultIfName
+	}
+
+	insts := make([]*nwfilterRuleInst, len(rules))
+	for i, rule := range rules {
+		insts[i] = &nwfilterRuleInst{i, rule.Rule, rule.Chain, rule.ChainPriority, rule.Priority}
+	}
+	plan, err := nwfilterBuildPlan(insts)
+	if err != nil {
+		return nil, err
+	}
+
+	fw := &NWFilterFirewall{}
+	switch backend {
+	case "", NWFilterFirewallBackendIPTables:
+		fw.Commands = nwfilterIPTablesCommands(plan, ifname)
+	case NWFilterFirewallBackendNftables:
+		fw.Commands, err = nwfilterNftablesCommands(plan, ifname)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("Unknown firewall backend '%s'", backend)
+	}
+	return fw, nil
+}
+
+// Firewall compiles the filter into the commands which would apply
+// it to a guest interface, in the same way as libvirt's nwfilter
+// driver
+func (s *NWFilter) Firewall(opts *NWFilterFirewallOptions) (*NWFilterFirewall, error) {
+	if opts == nil {
+		opts = &NWFilterFirewallOptions{}
+	}
+	rules, err := s.Resolve(opts.Filters, opts.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	return nwfilterFirewall(rules, opts.IfName, opts.Backend)
+}
+
+// Firewall compiles the filter referenced by the binding into the
+// commands which would apply it to the bound port. The filter is
+// looked up in opts.Filters, and the binding parameters take
+// precedence over opts.Parameters. As with libvirt, the MAC variable
+// defaults to the MAC address of the binding
+func (s *NWFilterBinding) Firewall(opts *NWFilterFirewallOptions) (*NWFilterFirewall, error) {
+	if opts == nil {
+		opts = &NWFilterFirewallOptions{}
+	}
+	rules, err := s.resolve(opts.Filters, opts.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	ifname := opts.IfName
+	if s.PortDev != nil && s.PortDev.Name != "" {
+		ifname = s.PortDev.Name
+	}
+	return nwfilterFirewall(rules, ifname, opts.Backend)
+}
+
+// nwfilterShellMeta are the characters which cause libvirt to quote
+// an argument when logging a command
+const nwfilterShellMeta = "\r\t\n !\"#$&'()*;<>?[\\]^`{|}~"
+
+func nwfilterShellQuote(arg string) string {
+	if arg == "" {
+		return "''"
+	}
+	if !strings.ContainsAny(arg, nwfilterShellMeta) {
+		return arg
+	}
+	return "'" + strings.Replace(arg, "'", `'\''`, -1) + "'"
+}
+
+// formatFirewallCommands formats commands one per line, quoted for
+// the shell
+func formatFirewallCommands(cmds [][]string) string {
+	var buf strings.Builder
+	for _, cmd := range cmds {
+		for i, arg := range cmd {
+			if i > 0 {
+				buf.WriteString(" ")
+			}
+			buf.WriteString(nwfilterShellQuote(arg))
+		}
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
+
+// String formats the commands one per line, quoted for the shell
+func (f *NWFilterFirewall) String() string {
+	return formatFirewallCommands(f.Commands)
+}
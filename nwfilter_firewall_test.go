@@ -0,0 +1,424 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+// nwfilterTestRules returns the commands of a firewall which add the
+// rules of the filter, leaving out the common setup
+func nwfilterTestRules(fw *NWFilterFirewall) []string {
+	var rules []string
+	for _, line := range strings.Split(strings.TrimSuffix(fw.String(), "\n"), "\n") {
+		if strings.Contains(line, "physdev") || strings.Contains(line, "vmap") ||
+			strings.Contains(line, "ROUTING") {
+			continue
+		}
+		if strings.Contains(line, " -A ") || strings.Contains(line, " add rule ") {
+			rules = append(rules, line)
+		}
+	}
+	return rules
+}
+
+var nwfilterFirewallTestData = []struct {
+	Filter  string
+	Filters []string
+	Options NWFilterFirewallOptions
+	Rules   []string
+}{
+	{
+		Filter: strings.Join([]string{
+			`<filter name='tcp-test' chain='root'>`,
+			`  <rule action='accept' direction='out' priority='500'>`,
+			`    <tcp srcmacaddr='1:2:3:4:5:6' srcipaddr='10.1.2.3' srcipmask='255.255.255.0'`,
+			`         dstportstart='80' dstportend='90' flags='SYN,ACK/SYN' comment='web "x"'/>`,
+			`  </rule>`,
+			`  <rule action='reject' direction='out'>`,
+			`    <all state='NEW,ESTABLISHED' ipset='tck' ipsetflags='src,dst'/>`,
+			`  </rule>`,
+			`  <rule action='accept' direction='in' statematch='false'>`,
+			`    <icmpv6 type='128' code='0'/>`,
+			`  </rule>`,
+			`</filter>`,
+		}, "\n"),
+		Rules: []string{
+			`iptables -w -A FJ-vnet0 -p tcp -m mac --mac-source 01:02:03:04:05:06 --source 10.1.2.3/24 --tcp-flags SYN,ACK SYN --dport 80:90 -m state --state NEW,ESTABLISHED -m comment --comment 'web "x"' -j RETURN`,
+			`iptables -w -A FP-vnet0 -p tcp --destination 10.1.2.3/24 --tcp-flags SYN,ACK SYN --sport 80:90 -m state --state ESTABLISHED -m comment --comment 'web "x"' -j ACCEPT`,
+			`iptables -w -A HJ-vnet0 -p tcp -m mac --mac-source 01:02:03:04:05:06 --source 10.1.2.3/24 --tcp-flags SYN,ACK SYN --dport 80:90 -m state --state NEW,ESTABLISHED -m comment --comment 'web "x"' -j RETURN`,
+			`iptables -w -A FJ-vnet0 -p all -m state --state NEW,ESTABLISHED -m set --match-set tck src,dst -j REJECT`,
+			`iptables -w -A HJ-vnet0 -p all -m state --state NEW,ESTABLISHED -m set --match-set tck src,dst -j REJECT`,
+			`ip6tables -w -A FP-vnet0 -p icmpv6 --icmpv6-type 128/0 -j ACCEPT`,
+		},
+	},
+	{
+		Filter: strings.Join([]string{
+			`<filter name='tcp-test' chain='root'>`,
+			`  <rule action='accept' direction='out' priority='500'>`,
+			`    <tcp srcmacaddr='1:2:3:4:5:6' srcipaddr='10.1.2.3' srcipmask='255.255.255.0'`,
+			`         dstportstart='80' dstportend='90' flags='SYN,ACK/SYN' comment='web "x"'/>`,
+			`  </rule>`,
+			`  <rule action='reject' direction='out'>`,
+			`    <all state='NEW,ESTABLISHED' ipset='tck' ipsetflags='src,dst'/>`,
+			`  </rule>`,
+			`  <rule action='accept' direction='in' statematch='false'>`,
+			`    <icmpv6 type='128' code='0'/>`,
+			`  </rule>`,
+			`</filter>`,
+		}, "\n"),
+		Options: NWFilterFirewallOptions{Backend: NWFilterFirewallBackendNftables},
+		Rules: []string{
+			`nft add rule bridge libvirt_nwfilter FI-vnet0 ether type ip meta l4proto tcp ether saddr 01:02:03:04:05:06 ip saddr 10.1.2.0/24 tcp flags '&' '(syn|ack)' == syn tcp dport 80-90 ct state new,established return comment '"web '\''x'\''"'`,
+			`nft add rule bridge libvirt_nwfilter FO-vnet0 ether type ip meta l4proto tcp ip daddr 10.1.2.0/24 tcp flags '&' '(syn|ack)' == syn tcp sport 80-90 ct state established accept comment '"web '\''x'\''"'`,
+			`nft add rule bridge libvirt_nwfilter HI-vnet0 ether type ip meta l4proto tcp ether saddr 01:02:03:04:05:06 ip saddr 10.1.2.0/24 tcp flags '&' '(syn|ack)' == syn tcp dport 80-90 ct state new,established return comment '"web '\''x'\''"'`,
+			`nft add rule bridge libvirt_nwfilter FI-vnet0 ether type ip ct state new,established ip saddr . ip daddr @tck reject`,
+			`nft add rule bridge libvirt_nwfilter HI-vnet0 ether type ip ct state new,established ip saddr . ip daddr @tck reject`,
+			`nft add rule bridge libvirt_nwfilter FO-vnet0 ether type ip6 meta l4proto ipv6-icmp icmpv6 type 128 icmpv6 code 0 accept`,
+		},
+	},
+	{
+		Filter: strings.Join([]string{
+			`<filter name='root-test' chain='root'>`,
+			`  <rule action='drop' direction='inout'>`,
+			`    <mac srcmacaddr='01:02:03:04:05:06' srcmacmask='ff:ff:ff:ff:ff:00' protocolid='arp'/>`,
+			`  </rule>`,
+			`  <filterref filter='dns-test'>`,
+			`    <parameter name='IP' value='10.0.0.1'/>`,
+			`  </filterref>`,
+			`</filter>`,
+		}, "\n"),
+		Filters: []string{
+			strings.Join([]string{
+				`<filter name='dns-test' chain='ipv4'>`,
+				`  <rule action='accept' direction='inout'>`,
+				`    <ip srcipaddr='$IP' protocol='udp' dstportstart='53'/>`,
+				`  </rule>`,
+				`</filter>`,
+			}, "\n"),
+		},
+		Rules: []string{
+			`ebtables --concurrent -t nat -A libvirt-J-vnet0 -p 0x0800 -j J-vnet0-ipv4`,
+			`ebtables --concurrent -t nat -A libvirt-P-vnet0 -p 0x0800 -j P-vnet0-ipv4`,
+			`ebtables --concurrent -t nat -A libvirt-J-vnet0 -d 01:02:03:04:05:06/ff:ff:ff:ff:ff:00 -p 0x806 -j DROP`,
+			`ebtables --concurrent -t nat -A libvirt-P-vnet0 -s 01:02:03:04:05:06/ff:ff:ff:ff:ff:00 -p 0x806 -j DROP`,
+			`ebtables --concurrent -t nat -A J-vnet0-ipv4 -p ipv4 --ip-destination 10.0.0.1 --ip-protocol 17 --ip-source-port 53 -j ACCEPT`,
+			`ebtables --concurrent -t nat -A P-vnet0-ipv4 -p ipv4 --ip-source 10.0.0.1 --ip-protocol 17 --ip-destination-port 53 -j ACCEPT`,
+		},
+	},
+	{
+		Filter: strings.Join([]string{
+			`<filter name='root-test' chain='root'>`,
+			`  <rule action='drop' direction='inout'>`,
+			`    <mac srcmacaddr='01:02:03:04:05:06' srcmacmask='ff:ff:ff:ff:ff:00' protocolid='arp'/>`,
+			`  </rule>`,
+			`  <filterref filter='dns-test'>`,
+			`    <parameter name='IP' value='10.0.0.1'/>`,
+			`  </filterref>`,
+			`</filter>`,
+		}, "\n"),
+		Filters: []string{
+			strings.Join([]string{
+				`<filter name='dns-test' chain='ipv4'>`,
+				`  <rule action='accept' direction='inout'>`,
+				`    <ip srcipaddr='$IP' protocol='udp' dstportstart='53'/>`,
+				`  </rule>`,
+				`</filter>`,
+			}, "\n"),
+		},
+		Options: NWFilterFirewallOptions{Backend: NWFilterFirewallBackendNftables},
+		Rules: []string{
+			`nft add rule bridge libvirt_nwfilter libvirt-I-vnet0 ether type ip jump I-vnet0-ipv4`,
+			`nft add rule bridge libvirt_nwfilter libvirt-O-vnet0 ether type ip jump O-vnet0-ipv4`,
+			`nft add rule bridge libvirt_nwfilter libvirt-I-vnet0 ether daddr '&' ff:ff:ff:ff:ff:00 == 01:02:03:04:05:00 ether type arp drop`,
+			`nft add rule bridge libvirt_nwfilter libvirt-O-vnet0 ether saddr '&' ff:ff:ff:ff:ff:00 == 01:02:03:04:05:00 ether type arp drop`,
+			`nft add rule bridge libvirt_nwfilter I-vnet0-ipv4 ether type ip ip daddr 10.0.0.1 ip protocol 17 th sport 53 accept`,
+			`nft add rule bridge libvirt_nwfilter O-vnet0-ipv4 ether type ip ip saddr 10.0.0.1 ip protocol 17 th dport 53 accept`,
+		},
+	},
+	{
+		Filter: strings.Join([]string{
+			`<filter name='l2-test' chain='root'>`,
+			`  <rule action='return' direction='in'>`,
+			`    <vlan vlanid='0x123' encap-protocol='ipv4'/>`,
+			`  </rule>`,
+			`  <rule action='continue' direction='out' priority='-100'>`,
+			`    <stp type='0x12' flags='0x44' root-priority='0x1234' root-priority-hi='0x2345'`,
+			`         root-address='6:5:4:3:2:1' root-address-mask='ff:ff:ff:ff:ff:ff'/>`,
+			`  </rule>`,
+			`  <rule action='reject' direction='inout'>`,
+			`    <arp match='no' opcode='Request' arpsrcipaddr='$IP' arpdstipaddr='10.0.0.0' arpdstipmask='8' gratuitous='true'/>`,
+			`  </rule>`,
+			`  <rule action='accept' direction='out'>`,
+			`    <ipv6 srcipaddr='2001:db8::1' srcipmask='64' type='1' typeend='4'/>`,
+			`  </rule>`,
+			`</filter>`,
+		}, "\n"),
+		Options: NWFilterFirewallOptions{
+			Parameters: []NWFilterParameter{{Name: "IP", Value: "10.1.1.1"}},
+		},
+		Rules: []string{
+			`ebtables --concurrent -t nat -A libvirt-J-vnet0 -d 01:80:c2:00:00:00 --stp-type 18 --stp-flags 68 --stp-root-pri 4660:9029 --stp-root-addr 06:05:04:03:02:01/ff:ff:ff:ff:ff:ff -j CONTINUE`,
+			`ebtables --concurrent -t nat -A libvirt-P-vnet0 -p 0x8100 --vlan-id 291 --vlan-encap 2048 -j RETURN`,
+			`ebtables --concurrent -t nat -A libvirt-J-vnet0 -p 0x806 --arp-opcode '!' 1 --arp-ip-dst '!' 10.1.1.1/32 --arp-ip-src '!' 10.0.0.0/8 '!' --arp-gratuitous -j DROP`,
+			`ebtables --concurrent -t nat -A libvirt-P-vnet0 -p 0x806 --arp-opcode '!' 1 --arp-ip-src '!' 10.1.1.1/32 --arp-ip-dst '!' 10.0.0.0/8 '!' --arp-gratuitous -j DROP`,
+			`ebtables --concurrent -t nat -A libvirt-J-vnet0 -p ipv6 --ip6-source 2001:db8::1/64 --ip6-icmp-type 1:4/0:255 -j ACCEPT`,
+		},
+	},
+	{
+		Filter: strings.Join([]string{
+			`<filter name='l2-test' chain='root'>`,
+			`  <rule action='continue' direction='out' priority='-100'>`,
+			`    <stp type='0x12' root-address='6:5:4:3:2:1' root-address-mask='ff:ff:ff:00:00:00'/>`,
+			`  </rule>`,
+			`  <rule action='drop' direction='out'>`,
+			`    <rarp arpsrcipaddr='10.1.1.1' arpsrcipmask='24' opcode='Request_Reverse'/>`,
+			`  </rule>`,
+			`</filter>`,
+		}, "\n"),
+		Options: NWFilterFirewallOptions{Backend: NWFilterFirewallBackendNftables},
+		Rules: []string{
+			`nft add rule bridge libvirt_nwfilter libvirt-I-vnet0 ether daddr 01:80:c2:00:00:00 @ll,160,8 18 @ll,192,48 '&' 0xffffff000000 == 0x060504000000 continue`,
+			`nft add rule bridge libvirt_nwfilter libvirt-I-vnet0 ether type 0x8035 @nh,48,16 3 @nh,112,32 '&' 0xffffff00 == 0x0a010100 drop`,
+		},
+	},
+}
+
+func TestNWFilterFirewall(t *testing.T) {
+	for _, test := range nwfilterFirewallTestData {
+		filter := &NWFilter{}
+		if err := filter.Unmarshal(test.Filter); err != nil {
+			t.Fatal(err)
+		}
+		opts := test.Options
+		for _, doc := range test.Filters {
+			ref := NWFilter{}
+			if err := ref.Unmarshal(doc); err != nil {
+				t.Fatal(err)
+			}
+			opts.Filters = append(opts.Filters, ref)
+		}
+
+		fw, err := filter.Firewall(&opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rules := nwfilterTestRules(fw)
+		if strings.Join(rules, "\n") != strings.Join(test.Rules, "\n") {
+			t.Errorf("Bad rules for %s:\n%s\n does not match\n%s",
+				test.Filter, strings.Join(rules, "\n"), strings.Join(test.Rules, "\n"))
+		}
+	}
+}
+
+func TestNWFilterFirewallCommands(t *testing.T) {
+	filter := &NWFilter{
+		Name: "mac-test",
+		Entries: []NWFilterEntry{
+			{
+				Rule: &NWFilterRule{
+					Action:    "drop",
+					Direction: "in",
+					MAC: &NWFilterRuleMAC{
+						ProtocolID: NWFilterField{Str: "ipv6"},
+					},
+				},
+			},
+		},
+	}
+
+	fw, err := filter.Firewall(&NWFilterFirewallOptions{IfName: "tap0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := strings.Join([]string{
+		`ebtables --concurrent -t nat -D PREROUTING -i tap0 -j libvirt-J-tap0`,
+		`ebtables --concurrent -t nat -D POSTROUTING -o tap0 -j libvirt-P-tap0`,
+		`ebtables --concurrent -t nat -L libvirt-J-tap0`,
+		`ebtables --concurrent -t nat -L libvirt-P-tap0`,
+		`ebtables --concurrent -t nat -F libvirt-J-tap0`,
+		`ebtables --concurrent -t nat -X libvirt-J-tap0`,
+		`ebtables --concurrent -t nat -F libvirt-P-tap0`,
+		`ebtables --concurrent -t nat -X libvirt-P-tap0`,
+		`ebtables --concurrent -t nat -N libvirt-P-tap0`,
+		`ebtables --concurrent -t nat -A libvirt-P-tap0 -p 0x86dd -j DROP`,
+		`ebtables --concurrent -t nat -A POSTROUTING -o tap0 -j libvirt-P-tap0`,
+	}, "\n") + "\n"
+	if fw.String() != expect {
+		t.Errorf("Bad commands:\n%s\n does not match\n%s", fw.String(), expect)
+	}
+
+	fw, err = filter.Firewall(&NWFilterFirewallOptions{
+		IfName:  "tap0",
+		Backend: NWFilterFirewallBackendNftables,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect = strings.Join([]string{
+		`nft add table bridge libvirt_nwfilter`,
+		`nft add chain bridge libvirt_nwfilter prerouting '{ type filter hook prerouting priority -300 ; }'`,
+		`nft add chain bridge libvirt_nwfilter postrouting '{ type filter hook postrouting priority 300 ; }'`,
+		`nft add chain bridge libvirt_nwfilter forward '{ type filter hook forward priority -200 ; }'`,
+		`nft add chain bridge libvirt_nwfilter input '{ type filter hook input priority -200 ; }'`,
+		`nft add map bridge libvirt_nwfilter prerouting-iif '{ type ifname : verdict ; }'`,
+		`nft add map bridge libvirt_nwfilter postrouting-oif '{ type ifname : verdict ; }'`,
+		`nft add map bridge libvirt_nwfilter forward-iif '{ type ifname : verdict ; }'`,
+		`nft add map bridge libvirt_nwfilter forward-oif '{ type ifname : verdict ; }'`,
+		`nft add map bridge libvirt_nwfilter input-iif '{ type ifname : verdict ; }'`,
+		`nft flush chain bridge libvirt_nwfilter prerouting`,
+		`nft add rule bridge libvirt_nwfilter prerouting iifname vmap @prerouting-iif`,
+		`nft flush chain bridge libvirt_nwfilter postrouting`,
+		`nft add rule bridge libvirt_nwfilter postrouting oifname vmap @postrouting-oif`,
+		`nft flush chain bridge libvirt_nwfilter forward`,
+		`nft add rule bridge libvirt_nwfilter forward iifname vmap @forward-iif`,
+		`nft add rule bridge libvirt_nwfilter forward oifname vmap @forward-oif`,
+		`nft flush chain bridge libvirt_nwfilter input`,
+		`nft add rule bridge libvirt_nwfilter input iifname vmap @input-iif`,
+		`nft delete element bridge libvirt_nwfilter prerouting-iif '{ "tap0" }'`,
+		`nft delete element bridge libvirt_nwfilter postrouting-oif '{ "tap0" }'`,
+		`nft delete element bridge libvirt_nwfilter forward-iif '{ "tap0" }'`,
+		`nft delete element bridge libvirt_nwfilter forward-oif '{ "tap0" }'`,
+		`nft delete element bridge libvirt_nwfilter input-iif '{ "tap0" }'`,
+		`nft add chain bridge libvirt_nwfilter libvirt-O-tap0`,
+		`nft flush chain bridge libvirt_nwfilter libvirt-O-tap0`,
+		`nft add rule bridge libvirt_nwfilter libvirt-O-tap0 ether type ip6 drop`,
+		`nft add element bridge libvirt_nwfilter postrouting-oif '{ "tap0" : jump libvirt-O-tap0 }'`,
+	}, "\n") + "\n"
+	if fw.String() != expect {
+		t.Errorf("Bad commands:\n%s\n does not match\n%s", fw.String(), expect)
+	}
+}
+
+func TestNWFilterBindingFirewall(t *testing.T) {
+	filter := NWFilter{}
+	err := filter.Unmarshal(strings.Join([]string{
+		`<filter name='no-spoof' chain='root'>`,
+		`  <rule action='drop' direction='out'>`,
+		`    <mac match='no' srcmacaddr='$MAC'/>`,
+		`  </rule>`,
+		`  <rule action='drop' direction='out'>`,
+		`    <ip match='no' srcipaddr='$IP'/>`,
+		`  </rule>`,
+		`</filter>`,
+	}, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	binding := &NWFilterBinding{}
+	err = binding.Unmarshal(strings.Join([]string{
+		`<filterbinding>`,
+		`  <owner>`,
+		`    <name>guest</name>`,
+		`    <uuid>d54df46f-1ab5-4a22-8618-4560ef5fac2c</uuid>`,
+		`  </owner>`,
+		`  <portdev name='tap1'/>`,
+		`  <mac address='52:54:00:11:22:33'/>`,
+		`  <filterref filter='no-spoof'>`,
+		`    <parameter name='IP' value='192.168.122.5'/>`,
+		`  </filterref>`,
+		`</filterbinding>`,
+	}, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fw, err := binding.Firewall(&NWFilterFirewallOptions{
+		Filters:    []NWFilter{filter},
+		Parameters: []NWFilterParameter{{Name: "IP", Value: "192.168.122.9"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules := strings.Join(nwfilterTestRules(fw), "\n")
+	expect := strings.Join([]string{
+		`ebtables --concurrent -t nat -A libvirt-J-tap1 -s '!' 52:54:00:11:22:33 -j DROP`,
+		`ebtables --concurrent -t nat -A libvirt-J-tap1 -p ipv4 --ip-source '!' 192.168.122.5 -j DROP`,
+	}, "\n")
+	if rules != expect {
+		t.Errorf("Bad rules:\n%s\n does not match\n%s", rules, expect)
+	}
+}
+
+func TestNWFilterFirewallErrors(t *testing.T) {
+	var tests = []struct {
+		Filter  string
+		Options NWFilterFirewallOptions
+	}{
+		{
+			Filter: `<filter name='a'><filterref filter='missing'/></filter>`,
+		},
+		{
+			Filter: `<filter name='a'><filterref filter='a'/></filter>`,
+			Options: NWFilterFirewallOptions{
+				Filters: []NWFilter{{Name: "a", Entries: []NWFilterEntry{{Ref: &NWFilterRef{Filter: "a"}}}}},
+			},
+		},
+		{
+			Filter: `<filter name='a'><rule action='drop' direction='out'><ip srcipaddr='$IP'/></rule></filter>`,
+		},
+		{
+			Filter: `<filter name='a'><rule action='drop' direction='out'><ip srcipaddr='$IP' dstipaddr='$DST'/></rule></filter>`,
+			Options: NWFilterFirewallOptions{
+				Parameters: []NWFilterParameter{
+					{Name: "IP", Value: "10.0.0.1"},
+					{Name: "IP", Value: "10.0.0.2"},
+					{Name: "DST", Value: "10.0.0.3"},
+				},
+			},
+		},
+		{
+			Filter: `<filter name='a'><rule action='drop' direction='out'><ip srcipaddr='10.0.0.300'/></rule></filter>`,
+		},
+		{
+			Filter: `<filter name='a'><rule action='drop' direction='inout'><stp srcmacaddr='1:2:3:4:5:6'/></rule></filter>`,
+		},
+		{
+			Filter: `<filter name='a'><rule action='drop' direction='out'><arp gratuitous='true'/></rule></filter>`,
+			Options: NWFilterFirewallOptions{
+				Backend: NWFilterFirewallBackendNftables,
+			},
+		},
+		{
+			Filter: `<filter name='a'><rule action='drop' direction='out'><tcp/></rule></filter>`,
+			Options: NWFilterFirewallOptions{
+				Backend: "pf",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		filter := &NWFilter{}
+		if err := filter.Unmarshal(test.Filter); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := filter.Firewall(&test.Options); err == nil {
+			t.Errorf("Expected error for %s", test.Filter)
+		}
+	}
+}
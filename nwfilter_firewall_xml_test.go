@@ -0,0 +1,121 @@
+//go:build xmlroundtrip
+// +build xmlroundtrip
+
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Mirrors the variables defined by libvirt's nwfilterxml2firewalltest
+var nwfilterFixtureParams = []NWFilterParameter{
+	{Name: "IPSETNAME", Value: "tck_test"},
+	{Name: "A", Value: "1.1.1.1"},
+	{Name: "A", Value: "2.2.2.2"},
+	{Name: "A", Value: "3.3.3.3"},
+	{Name: "A", Value: "3.3.3.3"},
+	{Name: "B", Value: "80"},
+	{Name: "B", Value: "90"},
+	{Name: "B", Value: "80"},
+	{Name: "B", Value: "80"},
+	{Name: "C", Value: "1080"},
+	{Name: "C", Value: "1090"},
+	{Name: "C", Value: "1100"},
+	{Name: "C", Value: "1110"},
+}
+
+// nwfilterFixtureLines splits commands into lines, dropping the
+// setup which libvirt's test strips from its output
+func nwfilterFixtureLines(cmds string) []string {
+	common := make(map[string]bool)
+	setup := &NWFilterFirewall{
+		Commands: nwfilterIPTablesCommands(&nwfilterPlan{}, nwfilterDefaultIfName),
+	}
+	setup.Commands = append(setup.Commands, nwfilterIPTablesChainCommands("iptables", nwfilterDefaultIfName)...)
+	setup.Commands = append(setup.Commands, nwfilterIPTablesChainCommands("ip6tables", nwfilterDefaultIfName)...)
+	for _, line := range strings.Split(setup.String(), "\n") {
+		common[line] = true
+	}
+
+	var lines []string
+	cmds = strings.Replace(cmds, " \\\n", " ", -1)
+	for _, line := range strings.Split(cmds, "\n") {
+		if !common[line] {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+func TestNWFilterFirewallFixtures(t *testing.T) {
+	syncGit(t)
+
+	dir := "testdata/libvirt/tests/nwfilterxml2firewalldata"
+	files, err := filepath.Glob(filepath.Join(dir, "*.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	compared := 0
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".xml")
+		want, err := ioutil.ReadFile(filepath.Join(dir, name+"-linux.args"))
+		if err != nil {
+			continue
+		}
+
+		xml, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		filter := &NWFilter{}
+		if err := filter.Unmarshal(string(xml)); err != nil {
+			t.Fatal(err)
+		}
+
+		compared++
+		fw, err := filter.Firewall(&NWFilterFirewallOptions{Parameters: nwfilterFixtureParams})
+		if err != nil {
+			t.Errorf("%s: %s", file, err)
+			continue
+		}
+
+		got := strings.Join(nwfilterFixtureLines(fw.String()), "\n")
+		expect := strings.Join(nwfilterFixtureLines(string(want)), "\n")
+		if got != expect {
+			t.Errorf("%s: bad commands:\n%s\n does not match\n%s", file, got, expect)
+		}
+	}
+	if compared == 0 {
+		t.Fatalf("No nwfilter fixtures found in %s", dir)
+	}
+}
@@ -0,0 +1,561 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+const nwfilterNftTable = "libvirt_nwfilter"
+
+// nwfilterNftBaseChains hook the bridge at the same points as the
+// ebtables nat table and the iptables filter table
+var nwfilterNftBaseChains = []struct {
+	name     string
+	priority int
+}{
+	{"prerouting", -300},
+	{"postrouting", 300},
+	{"forward", -200},
+	{"input", -200},
+}
+
+// nwfilterNftMaps dispatch traffic to the per-interface chains, in
+// place of the ebtables and iptables rules jumping to them
+var nwfilterNftMaps = []struct {
+	name  string
+	chain string
+	match string
+}{
+	{"prerouting-iif", "prerouting", "iifname"},
+	{"postrouting-oif", "postrouting", "oifname"},
+	{"forward-iif", "forward", "iifname"},
+	{"forward-oif", "forward", "oifname"},
+	{"input-iif", "input", "iifname"},
+}
+
+var nwfilterNftTCPOptions = map[uint64]string{
+	0: "eol",
+	1: "nop",
+	2: "maxseg",
+	3: "window",
+	4: "sack-perm",
+	5: "sack",
+	8: "timestamp",
+}
+
+// nwfilterNftSTPFields are the offsets and sizes in bits of the
+// BPDU fields, relative to the link layer header
+var nwfilterNftSTPFields = map[string][2]int{
+	"--stp-type":          {160, 8},
+	"--stp-flags":         {168, 8},
+	"--stp-root-pri":      {176, 16},
+	"--stp-root-addr":     {192, 48},
+	"--stp-root-cost":     {240, 32},
+	"--stp-sender-prio":   {272, 16},
+	"--stp-sender-addr":   {288, 48},
+	"--stp-port":          {336, 16},
+	"--stp-msg-age":       {352, 16},
+	"--stp-max-age":       {368, 16},
+	"--stp-hello-time":    {384, 16},
+	"--stp-forward-delay": {400, 16},
+}
+
+// nwfilterNftRARPFields are the offsets and sizes in bits of the
+// RARP header fields, which nftables has no names for
+var nwfilterNftRARPFields = map[string][2]int{
+	"--arp-htype":   {0, 16},
+	"--arp-ptype":   {16, 16},
+	"--arp-opcode":  {48, 16},
+	"--arp-mac-src": {64, 48},
+	"--arp-ip-src":  {112, 32},
+	"--arp-mac-dst": {144, 48},
+	"--arp-ip-dst":  {192, 32},
+}
+
+var nwfilterNftARPFields = map[string]string{
+	"--arp-htype":   "arp htype",
+	"--arp-ptype":   "arp ptype",
+	"--arp-opcode":  "arp operation",
+	"--arp-mac-src": "arp saddr ether",
+	"--arp-ip-src":  "arp saddr ip",
+	"--arp-mac-dst": "arp daddr ether",
+	"--arp-ip-dst":  "arp daddr ip",
+}
+
+// nwfilterNftChain names the per-interface chains as libvirt does,
+// but with 'I' for traffic from the guest and 'O' for traffic to
+// the guest
+func nwfilterNftChain(layer nwfilterLayer, prefix, chain, ifname string) string {
+	prefix = strings.NewReplacer("J", "I", "P", "O").Replace(prefix)
+	return nwfilterIPTablesChain(layer, prefix, chain, ifname)
+}
+
+// nwfilterNftExpr accumulates the tokens of an nftables rule
+type nwfilterNftExpr struct {
+	tokens []string
+}
+
+func (e *nwfilterNftExpr) add(tokens ...string) {
+	e.tokens = append(e.tokens, tokens...)
+}
+
+// match compares a field with a value, which may be a range,
+// prefix or set
+func (e *nwfilterNftExpr) match(field string, neg bool, val string) {
+	e.add(strings.Fields(field)...)
+	if neg {
+		e.add("!=")
+	}
+	e.add(val)
+}
+
+// masked compares a field with a value under a mask
+func (e *nwfilterNftExpr) masked(field string, neg bool, mask, val string) {
+	e.add(strings.Fields(field)...)
+	e.add("&", mask)
+	if neg {
+		e.add("!=")
+	} else {
+		e.add("==")
+	}
+	e.add(val)
+}
+
+func nwfilterNftRange(val string) string {
+	parts := strings.SplitN(val, ":", 2)
+	if len(parts) == 2 && parts[0] != parts[1] {
+		return parts[0] + "-" + parts[1]
+	}
+	return parts[0]
+}
+
+// nwfilterNftPrefix normalizes an address with a prefix length to
+// the network address, which nftables requires
+func nwfilterNftPrefix(val string) string {
+	if !strings.Contains(val, "/") {
+		return val
+	}
+	_, ipnet, err := net.ParseCIDR(val)
+	if err != nil {
+		return val
+	}
+	return ipnet.String()
+}
+
+func nwfilterNftEtherType(val string) (string, error) {
+	n, err := nwfilterParseUint(val, nwfilterTypeEtherType)
+	if err != nil {
+		return "", err
+	}
+	switch n {
+	case 0x0800:
+		return "ip", nil
+	case 0x86dd:
+		return "ip6", nil
+	case 0x0806:
+		return "arp", nil
+	case 0x8100:
+		return "8021q", nil
+	}
+	return fmt.Sprintf("0x%04x", n), nil
+}
+
+func nwfilterNftHex(b []byte) string {
+	return fmt.Sprintf("0x%x", b)
+}
+
+// mac matches a MAC address, which may have a mask
+func (e *nwfilterNftExpr) mac(field string, neg bool, val string) error {
+	parts := strings.SplitN(val, "/", 2)
+	if len(parts) == 1 {
+		e.match(field, neg, val)
+		return nil
+	}
+	addr, err := nwfilterParseMAC(parts[0])
+	if err != nil {
+		return err
+	}
+	mask, err := nwfilterParseMAC(parts[1])
+	if err != nil {
+		return err
+	}
+	for i := range addr {
+		addr[i] &= mask[i]
+	}
+	e.masked(field, neg, mask.String(), addr.String())
+	return nil
+}
+
+// raw matches a field at a bit offset of a header, for those fields
+// which nftables has no name for
+func (e *nwfilterNftExpr) raw(base string, field [2]int, neg bool, val string) error {
+	lhs := fmt.Sprintf("@%s,%d,%d", base, field[0], field[1])
+	parts := strings.SplitN(val, "/", 2)
+
+	var addr, mask []byte
+	switch field[1] {
+	case 48:
+		mac, err := nwfilterParseMAC(parts[0])
+		if err != nil {
+			return err
+		}
+		addr = mac
+		if len(parts) == 2 {
+			if mask, err = nwfilterParseMAC(parts[1]); err != nil {
+				return err
+			}
+		}
+	case 32:
+		if ip := net.ParseIP(parts[0]).To4(); ip != nil {
+			addr = ip
+			if len(parts) == 2 {
+				n, err := nwfilterParseMask(parts[1], 32)
+				if err != nil {
+					return err
+				}
+				mask = make([]byte, 4)
+				binary.BigEndian.PutUint32(mask, ^uint32(0)<<uint(32-n))
+			}
+		}
+	}
+
+	if addr == nil {
+		e.match(lhs, neg, nwfilterNftRange(val))
+		return nil
+	}
+	if mask == nil || len(parts) == 1 {
+		e.match(lhs, neg, nwfilterNftHex(addr))
+		return nil
+	}
+	for i := range addr {
+		addr[i] &= mask[i]
+	}
+	e.masked(lhs, neg, nwfilterNftHex(mask), nwfilterNftHex(addr))
+	return nil
+}
+
+// icmpv6 converts the ebtables form type:end/code:end of an ICMPv6
+// match
+func (e *nwfilterNftExpr) icmpv6(neg bool, val string) error {
+	parts := strings.SplitN(val, "/", 2)
+	code := ""
+	if len(parts) == 2 && parts[1] != "0:255" {
+		code = nwfilterNftRange(parts[1])
+	}
+	if neg && code != "" {
+		return fmt.Errorf("Negated ICMPv6 type and code cannot be expressed with nftables")
+	}
+	e.match("icmpv6 type", neg, nwfilterNftRange(parts[0]))
+	if code != "" {
+		e.match("icmpv6 code", false, code)
+	}
+	return nil
+}
+
+func nwfilterNftEbtablesRule(rule *nwfilterFWRule) (*nwfilterNftExpr, error) {
+	e := &nwfilterNftExpr{}
+	var ethertype string
+	for _, m := range rule.matches {
+		val := ""
+		if len(m.args) > 0 {
+			val = m.args[0]
+		}
+
+		var err error
+		switch m.opt {
+		case "-s":
+			err = e.mac("ether saddr", m.neg, val)
+		case "-d":
+			err = e.mac("ether daddr", m.neg, val)
+		case "-p":
+			ethertype, err = nwfilterNftEtherType(val)
+			e.match("ether type", m.neg, ethertype)
+		case "--vlan-id":
+			e.match("vlan id", m.neg, val)
+		case "--vlan-encap":
+			var encap string
+			encap, err = nwfilterNftEtherType(val)
+			e.match("vlan type", m.neg, encap)
+		case "--arp-htype", "--arp-ptype", "--arp-opcode",
+			"--arp-mac-src", "--arp-mac-dst", "--arp-ip-src", "--arp-ip-dst":
+			if ethertype == "0x8035" {
+				err = e.raw("nh", nwfilterNftRARPFields[m.opt], m.neg, val)
+			} else if strings.HasPrefix(m.opt, "--arp-ip-") {
+				e.match(nwfilterNftARPFields[m.opt], m.neg, nwfilterNftPrefix(val))
+			} else {
+				e.match(nwfilterNftARPFields[m.opt], m.neg, val)
+			}
+		case "--ip-source", "--ip6-source":
+			e.match(ethertype+" saddr", m.neg, nwfilterNftPrefix(val))
+		case "--ip-destination", "--ip6-destination":
+			e.match(ethertype+" daddr", m.neg, nwfilterNftPrefix(val))
+		case "--ip-protocol":
+			e.match("ip protocol", m.neg, val)
+		case "--ip6-protocol":
+			e.match("ip6 nexthdr", m.neg, val)
+		case "--ip-source-port", "--ip6-source-port":
+			e.match("th sport", m.neg, nwfilterNftRange(val))
+		case "--ip-destination-port", "--ip6-destination-port":
+			e.match("th dport", m.neg, nwfilterNftRange(val))
+		case "--ip-tos":
+			e.match("ip dscp", m.neg, val)
+		case "--ip6-icmp-type":
+			err = e.icmpv6(m.neg, val)
+		default:
+			field, ok := nwfilterNftSTPFields[m.opt]
+			if !ok {
+				return nil, fmt.Errorf("Cannot express '%s' with nftables", m.opt)
+			}
+			err = e.raw("ll", field, m.neg, val)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	e.add(strings.ToLower(rule.target))
+	return e, nil
+}
+
+// nwfilterNftTCPFlags converts an iptables list of TCP flags
+func nwfilterNftTCPFlags(val string) string {
+	switch val {
+	case "ALL":
+		return strings.ToLower(strings.Join(nwfilterTCPFlags, "|"))
+	case "NONE":
+		return "0x0"
+	}
+	return strings.ToLower(strings.Replace(val, ",", "|", -1))
+}
+
+func nwfilterNftL3Rule(rule *nwfilterFWRule) (*nwfilterNftExpr, error) {
+	e := &nwfilterNftExpr{}
+	family := "ip"
+	if rule.layer == nwfilterLayerIPv6 {
+		family = "ip6"
+	}
+	e.add("ether", "type", family)
+
+	var proto string
+	for _, m := range rule.matches {
+		val := ""
+		if len(m.args) > 0 {
+			val = m.args[0]
+		}
+
+		switch m.opt {
+		case "-p":
+			proto = val
+			switch proto {
+			case "all":
+			case "icmpv6":
+				e.add("meta", "l4proto", "ipv6-icmp")
+			default:
+				e.add("meta", "l4proto", proto)
+			}
+		case "--mac-source":
+			e.match("ether saddr", m.neg, val)
+		case "--source", "--src-range":
+			e.match(family+" saddr", m.neg, nwfilterNftPrefix(val))
+		case "--destination", "--dst-range":
+			e.match(family+" daddr", m.neg, nwfilterNftPrefix(val))
+		case "--dscp":
+			e.match(family+" dscp", m.neg, val)
+		case "--tcp-flags":
+			e.masked("tcp flags", m.neg, "("+nwfilterNftTCPFlags(m.args[0])+")",
+				nwfilterNftTCPFlags(m.args[1]))
+		case "--sport":
+			e.match(proto+" sport", m.neg, nwfilterNftRange(val))
+		case "--dport":
+			e.match(proto+" dport", m.neg, nwfilterNftRange(val))
+		case "--tcp-option":
+			n, err := strconv.ParseUint(val, 10, 8)
+			name, ok := nwfilterNftTCPOptions[n]
+			if err != nil || !ok {
+				return nil, fmt.Errorf("TCP option '%s' cannot be expressed with nftables", val)
+			}
+			if m.neg {
+				e.add("tcp", "option", name, "missing")
+			} else {
+				e.add("tcp", "option", name, "exists")
+			}
+		case "--icmp-type", "--icmpv6-type":
+			parts := strings.SplitN(val, "/", 2)
+			if m.neg && len(parts) == 2 {
+				return nil, fmt.Errorf("Negated ICMP type and code cannot be expressed with nftables")
+			}
+			e.match(proto+" type", m.neg, parts[0])
+			if len(parts) == 2 {
+				e.match(proto+" code", false, parts[1])
+			}
+		case "--state":
+			e.add("ct", "state", strings.ToLower(val))
+		case "--match-set":
+			var fields []string
+			for _, flag := range strings.Split(m.args[1], ",") {
+				if flag == "src" {
+					fields = append(fields, family, "saddr")
+				} else {
+					fields = append(fields, family, "daddr")
+				}
+				fields = append(fields, ".")
+			}
+			e.match(strings.Join(fields[:len(fields)-1], " "), m.neg, "@"+val)
+		case "--connlimit-above":
+			if m.neg {
+				e.add("ct", "count", val)
+			} else {
+				e.add("ct", "count", "over", val)
+			}
+		default:
+			return nil, fmt.Errorf("Cannot express '%s' with nftables", m.opt)
+		}
+	}
+
+	e.add(strings.ToLower(rule.target))
+	if rule.comment != "" {
+		e.add("comment", `"`+strings.Replace(rule.comment, `"`, "'", -1)+`"`)
+	}
+	return e, nil
+}
+
+// nwfilterNftablesCommands renders the plan as nft commands, using a
+// bridge table in place of the ebtables, iptables and ip6tables
+// chains. Both address families share the per-interface chains
+// since every rule matches the ethernet type
+func nwfilterNftablesCommands(plan *nwfilterPlan, ifname string) ([][]string, error) {
+	var cmds [][]string
+	nft := func(args ...string) {
+		cmds = append(cmds, append([]string{"nft"}, args...))
+	}
+
+	nft("add", "table", "bridge", nwfilterNftTable)
+	for _, base := range nwfilterNftBaseChains {
+		nft("add", "chain", "bridge", nwfilterNftTable, base.name,
+			fmt.Sprintf("{ type filter hook %s priority %d ; }", base.name, base.priority))
+	}
+	for _, m := range nwfilterNftMaps {
+		nft("add", "map", "bridge", nwfilterNftTable, m.name, "{ type ifname : verdict ; }")
+	}
+	for _, base := range nwfilterNftBaseChains {
+		nft("flush", "chain", "bridge", nwfilterNftTable, base.name)
+		for _, m := range nwfilterNftMaps {
+			if m.chain == base.name {
+				nft("add", "rule", "bridge", nwfilterNftTable, base.name, m.match, "vmap", "@"+m.name)
+			}
+		}
+	}
+
+	// Detach anything left from an earlier attempt
+	for _, m := range nwfilterNftMaps {
+		nft("delete", "element", "bridge", nwfilterNftTable, m.name,
+			fmt.Sprintf(`{ "%s" }`, ifname))
+	}
+
+	rootIn := nwfilterNftChain(nwfilterLayerEthernet, "J", nwfilterRootChain, ifname)
+	rootOut := nwfilterNftChain(nwfilterLayerEthernet, "P", nwfilterRootChain, ifname)
+	haveIP := plan.haveIPv4 || plan.haveIPv6
+	elements := map[string]string{}
+	var chains []string
+	if plan.chainsIn {
+		chains = append(chains, rootIn)
+		elements["prerouting-iif"] = rootIn
+	}
+	if plan.chainsOut {
+		chains = append(chains, rootOut)
+		elements["postrouting-oif"] = rootOut
+	}
+	for _, step := range plan.ebtables {
+		if step.chain != nil {
+			prefix := "P"
+			if step.chain.incoming {
+				prefix = "J"
+			}
+			chains = append(chains, nwfilterNftChain(nwfilterLayerEthernet, prefix, step.chain.name, ifname))
+		}
+	}
+	if haveIP {
+		for _, prefix := range []string{"FJ", "FP", "HJ"} {
+			chains = append(chains, nwfilterNftChain(nwfilterLayerIPv4, prefix, "", ifname))
+		}
+		elements["forward-iif"] = nwfilterNftChain(nwfilterLayerIPv4, "FJ", "", ifname)
+		elements["forward-oif"] = nwfilterNftChain(nwfilterLayerIPv4, "FP", "", ifname)
+		elements["input-iif"] = nwfilterNftChain(nwfilterLayerIPv4, "HJ", "", ifname)
+	}
+	for _, chain := range chains {
+		nft("add", "chain", "bridge", nwfilterNftTable, chain)
+		nft("flush", "chain", "bridge", nwfilterNftTable, chain)
+	}
+
+	for _, step := range plan.ebtables {
+		if step.chain != nil {
+			prefix := "P"
+			if step.chain.incoming {
+				prefix = "J"
+			}
+			root := nwfilterNftChain(nwfilterLayerEthernet, prefix, nwfilterRootChain, ifname)
+			chain := nwfilterNftChain(nwfilterLayerEthernet, prefix, step.chain.name, ifname)
+			args := []string{"add", "rule", "bridge", nwfilterNftTable, root}
+			switch proto := nwfilterL2Protocol(step.chain.name); proto.name {
+			case "mac":
+			case "stp":
+				args = append(args, "ether", "daddr", nwfilterMACBGA)
+			default:
+				ethertype, _ := nwfilterNftEtherType(fmt.Sprintf("0x%x", proto.ethertype))
+				args = append(args, "ether", "type", ethertype)
+			}
+			nft(append(args, "jump", chain)...)
+			continue
+		}
+		e, err := nwfilterNftEbtablesRule(step.rule)
+		if err != nil {
+			return nil, err
+		}
+		chain := nwfilterNftChain(nwfilterLayerEthernet, step.rule.prefix, step.rule.chain, ifname)
+		nft(append([]string{"add", "rule", "bridge", nwfilterNftTable, chain}, e.tokens...)...)
+	}
+
+	for _, rule := range append(append([]*nwfilterFWRule{}, plan.ipv4...), plan.ipv6...) {
+		e, err := nwfilterNftL3Rule(rule)
+		if err != nil {
+			return nil, err
+		}
+		chain := nwfilterNftChain(rule.layer, rule.prefix, "", ifname)
+		nft(append([]string{"add", "rule", "bridge", nwfilterNftTable, chain}, e.tokens...)...)
+	}
+
+	for _, m := range nwfilterNftMaps {
+		if chain, ok := elements[m.name]; ok {
+			nft("add", "element", "bridge", nwfilterNftTable, m.name,
+				fmt.Sprintf(`{ "%s" : jump %s }`, ifname, chain))
+		}
+	}
+	return cmds, nil
+}
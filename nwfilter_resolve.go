@@ -0,0 +1,437 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// NWFilterResolvedRule is a rule of a filter with all variables
+// replaced by their values
+type NWFilterResolvedRule struct {
+	// Name of the filter the rule is from
+	Filter string
+	// Chain the rule is placed in, and the priority of that chain
+	Chain         string
+	ChainPriority int
+	// Priority of the rule, with the default applied
+	Priority int
+	Rule     *NWFilterRule
+}
+
+const (
+	nwfilterRootChain           = "root"
+	nwfilterDefaultRulePriority = 500
+)
+
+// nwfilterChainPriorities are the default priorities of filter
+// chains, matched against the start of the chain name
+var nwfilterChainPriorities = []struct {
+	name     string
+	priority int
+}{
+	{"root", 0},
+	{"stp", -810},
+	{"mac", -800},
+	{"vlan", -750},
+	{"ipv4", -700},
+	{"ipv6", -600},
+	{"arp", -500},
+	{"rarp", -400},
+}
+
+// nwfilterChain returns the chain name of a filter and its priority,
+// which defaults according to the protocol of the chain
+func nwfilterChain(filter *NWFilter) (string, int) {
+	chain := filter.Chain
+	if chain == "" {
+		chain = nwfilterRootChain
+	}
+	if filter.Priority != 0 {
+		return chain, filter.Priority
+	}
+	for _, p := range nwfilterChainPriorities {
+		if strings.HasPrefix(chain, p.name) {
+			return chain, p.priority
+		}
+	}
+	return chain, 0
+}
+
+func nwfilterVars(params []NWFilterParameter) map[string][]string {
+	vars := make(map[string][]string)
+	for _, param := range params {
+		vars[param.Name] = append(vars[param.Name], param.Value)
+	}
+	return vars
+}
+
+// nwfilterRefVars returns the variables in scope of a referenced
+// filter, where the parameters of the reference replace any
+// inherited variable of the same name
+func nwfilterRefVars(vars map[string][]string, params []NWFilterParameter) map[string][]string {
+	ret := make(map[string][]string)
+	for name, vals := range vars {
+		ret[name] = vals
+	}
+	for name, vals := range nwfilterVars(params) {
+		ret[name] = vals
+	}
+	return ret
+}
+
+// nwfilterVarAccess is a reference to a variable, which either
+// selects a single value by index, or iterates over the values
+// together with any other variables using the same iterator
+type nwfilterVarAccess struct {
+	name   string
+	index  int
+	iterID int
+}
+
+// nwfilterParseVarAccess parses a variable reference such as "A",
+// "A[2]" or "A[@1]", where a plain name uses iterator 0
+func nwfilterParseVarAccess(ref string) (*nwfilterVarAccess, error) {
+	open := strings.Index(ref, "[")
+	if open < 0 {
+		return &nwfilterVarAccess{name: ref, index: -1}, nil
+	}
+	if open == 0 || !strings.HasSuffix(ref, "]") {
+		return nil, fmt.Errorf("Malformed variable reference '%s'", ref)
+	}
+	access := &nwfilterVarAccess{name: ref[:open], index: -1}
+	index := ref[open+1 : len(ref)-1]
+	if strings.HasPrefix(index, "@") {
+		id, err := strconv.ParseUint(index[1:], 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("Malformed variable reference '%s'", ref)
+		}
+		access.iterID = int(id)
+		return access, nil
+	}
+	idx, err := strconv.ParseUint(index, 10, 31)
+	if err != nil {
+		return nil, fmt.Errorf("Malformed variable reference '%s'", ref)
+	}
+	access.index = int(idx)
+	return access, nil
+}
+
+// nwfilterRuleFields returns pointers to every field of a rule which
+// may hold a variable, in the order they are declared
+func nwfilterRuleFields(rule *NWFilterRule) []*NWFilterField {
+	var fields []*NWFilterField
+	fieldType := reflect.TypeOf(NWFilterField{})
+
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		switch v.Kind() {
+		case reflect.Ptr:
+			if !v.IsNil() {
+				walk(v.Elem())
+			}
+		case reflect.Struct:
+			if v.Type() == fieldType {
+				fields = append(fields, v.Addr().Interface().(*NWFilterField))
+				return
+			}
+			for i := 0; i < v.NumField(); i++ {
+				walk(v.Field(i))
+			}
+		}
+	}
+	walk(reflect.ValueOf(rule))
+	return fields
+}
+
+// nwfilterIter steps through the values of the variables sharing an
+// iterator in parallel
+type nwfilterIter struct {
+	id    int
+	names []string
+	max   int
+	cur   int
+}
+
+// unique reports whether the current combination of values has not
+// been seen at an earlier position, since libvirt skips duplicates
+func (it *nwfilterIter) unique(vars map[string][]string) bool {
+	for i := 0; i < it.cur; i++ {
+		same := true
+		for _, name := range it.names {
+			if vars[name][i] != vars[name][it.cur] {
+				same = false
+				break
+			}
+		}
+		if same {
+			return false
+		}
+	}
+	return true
+}
+
+// nwfilterExpandRule creates an instance of the rule for every
+// combination of values of the variables it uses, mirroring libvirt's
+// virNWFilterVarCombIter
+func nwfilterExpandRule(rule *NWFilterRule, vars map[string][]string) ([]*NWFilterRule, error) {
+	var iters []*nwfilterIter
+	var accesses []*nwfilterVarAccess
+	for _, field := range nwfilterRuleFields(rule) {
+		if field.Var == "" {
+			accesses = append(accesses, nil)
+			continue
+		}
+		access, err := nwfilterParseVarAccess(field.Var)
+		if err != nil {
+			return nil, err
+		}
+		accesses = append(accesses, access)
+
+		vals, ok := vars[access.name]
+		if !ok {
+			return nil, fmt.Errorf("Variable '%s' is not defined", access.name)
+		}
+		if len(vals) == 0 {
+			return nil, fmt.Errorf("Variable '%s' has no values", access.name)
+		}
+		if access.index >= 0 {
+			if access.index >= len(vals) {
+				return nil, fmt.Errorf("Index %d of variable '%s' is out of range",
+					access.index, access.name)
+			}
+			continue
+		}
+
+		var iter *nwfilterIter
+		for _, it := range iters {
+			if it.id == access.iterID {
+				iter = it
+			}
+		}
+		if iter == nil {
+			iters = append(iters, &nwfilterIter{
+				id:    access.iterID,
+				names: []string{access.name},
+				max:   len(vals),
+			})
+			continue
+		}
+		found := false
+		for _, name := range iter.names {
+			if name == access.name {
+				found = true
+			}
+		}
+		if !found {
+			if iter.max != len(vals) {
+				return nil, fmt.Errorf("Variables '%s' and '%s' of iterator %d have different numbers of values",
+					iter.names[0], access.name, iter.id)
+			}
+			iter.names = append(iter.names, access.name)
+		}
+	}
+
+	var rules []*NWFilterRule
+	for {
+		inst := rule.Clone()
+		for i, field := range nwfilterRuleFields(inst) {
+			access := accesses[i]
+			if access == nil {
+				continue
+			}
+			index := access.index
+			if index < 0 {
+				for _, it := range iters {
+					if it.id == access.iterID {
+						index = it.cur
+					}
+				}
+			}
+			*field = nwfilterResolvedField(vars[access.name][index])
+		}
+		rules = append(rules, inst)
+
+		// The first iterator changes fastest
+		i := 0
+		for ; i < len(iters); i++ {
+			it := iters[i]
+			it.cur++
+			for it.cur < it.max && !it.unique(vars) {
+				it.cur++
+			}
+			if it.cur < it.max {
+				break
+			}
+			it.cur = 0
+		}
+		if i == len(iters) {
+			return rules, nil
+		}
+	}
+}
+
+func nwfilterResolvedField(val string) NWFilterField {
+	field := NWFilterField{Str: val}
+	if strings.HasPrefix(val, "0x") {
+		if n, err := strconv.ParseUint(val[2:], 16, 64); err == nil {
+			uval := uint(n)
+			field.Uint = &uval
+		}
+	}
+	return field
+}
+
+type nwfilterResolver struct {
+	filters map[string]*NWFilter
+	active  []string
+	rules   []NWFilterResolvedRule
+}
+
+func (r *nwfilterResolver) add(filter *NWFilter, vars map[string][]string) error {
+	for i, name := range r.active {
+		if name == filter.Name {
+			cycle := append(append([]string{}, r.active[i:]...), filter.Name)
+			return fmt.Errorf("Filter reference cycle %s", strings.Join(cycle, " -> "))
+		}
+	}
+	r.active = append(r.active, filter.Name)
+	defer func() {
+		r.active = r.active[:len(r.active)-1]
+	}()
+
+	chain, chainPriority := nwfilterChain(filter)
+	for _, entry := range filter.Entries {
+		if entry.Rule != nil {
+			priority := entry.Rule.Priority
+			if priority == 0 {
+				priority = nwfilterDefaultRulePriority
+			}
+			rules, err := nwfilterExpandRule(entry.Rule, vars)
+			if err != nil {
+				return fmt.Errorf("Filter '%s': %s", filter.Name, err)
+			}
+			for _, rule := range rules {
+				r.rules = append(r.rules, NWFilterResolvedRule{
+					Filter:        filter.Name,
+					Chain:         chain,
+					ChainPriority: chainPriority,
+					Priority:      priority,
+					Rule:          rule,
+				})
+			}
+		} else if entry.Ref != nil {
+			ref, ok := r.filters[entry.Ref.Filter]
+			if !ok {
+				return fmt.Errorf("Filter '%s' referenced by '%s' does not exist",
+					entry.Ref.Filter, filter.Name)
+			}
+			err := r.add(ref, nwfilterRefVars(vars, entry.Ref.Parameters))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func nwfilterResolve(filter *NWFilter, filters []NWFilter, vars map[string][]string) ([]NWFilterResolvedRule, error) {
+	r := &nwfilterResolver{
+		filters: make(map[string]*NWFilter),
+	}
+	for i := range filters {
+		r.filters[filters[i].Name] = &filters[i]
+	}
+	if err := r.add(filter, vars); err != nil {
+		return nil, err
+	}
+
+	// Rules of the root chain come first, since they create the
+	// jumps to the other chains
+	sort.SliceStable(r.rules, func(i, j int) bool {
+		rooti := r.rules[i].Chain == nwfilterRootChain
+		rootj := r.rules[j].Chain == nwfilterRootChain
+		if rooti != rootj {
+			return rooti
+		}
+		return r.rules[i].Priority < r.rules[j].Priority
+	})
+	return r.rules, nil
+}
+
+func nwfilterResolveRef(name string, filters []NWFilter, vars map[string][]string) ([]NWFilterResolvedRule, error) {
+	for i := range filters {
+		if filters[i].Name == name {
+			return nwfilterResolve(&filters[i], filters, vars)
+		}
+	}
+	return nil, fmt.Errorf("Filter '%s' does not exist", name)
+}
+
+// Resolve flattens the filter and those it references from filters
+// into a list of rules, ordered by chain and priority as libvirt
+// does. A parameter given more than once holds a list of values,
+// and rules using lists are expanded into one rule per combination
+// of values
+func (s *NWFilter) Resolve(filters []NWFilter, params []NWFilterParameter) ([]NWFilterResolvedRule, error) {
+	return nwfilterResolve(s, filters, nwfilterVars(params))
+}
+
+// Resolve flattens the filter referenced by the interface, using the
+// parameters of the reference
+func (s *DomainInterfaceFilterRef) Resolve(filters []NWFilter) ([]NWFilterResolvedRule, error) {
+	params := make([]NWFilterParameter, len(s.Parameters))
+	for i, param := range s.Parameters {
+		params[i] = NWFilterParameter{param.Name, param.Value}
+	}
+	return nwfilterResolveRef(s.Filter, filters, nwfilterVars(params))
+}
+
+// Resolve flattens the filter referenced by the binding, using the
+// parameters of the binding. As with libvirt, the MAC variable
+// defaults to the MAC address of the binding
+func (s *NWFilterBinding) Resolve(filters []NWFilter) ([]NWFilterResolvedRule, error) {
+	return s.resolve(filters, nil)
+}
+
+func (s *NWFilterBinding) resolve(filters []NWFilter, defaults []NWFilterParameter) ([]NWFilterResolvedRule, error) {
+	if s.FilterRef == nil {
+		return nil, fmt.Errorf("Filter binding has no filter reference")
+	}
+	params := make([]NWFilterParameter, len(s.FilterRef.Parameters))
+	for i, param := range s.FilterRef.Parameters {
+		params[i] = NWFilterParameter{param.Name, param.Value}
+	}
+	vars := nwfilterRefVars(nwfilterVars(defaults), params)
+	if _, ok := vars["MAC"]; !ok && s.MAC != nil && s.MAC.Address != "" {
+		vars["MAC"] = []string{s.MAC.Address}
+	}
+	return nwfilterResolveRef(s.FilterRef.Filter, filters, vars)
+}
@@ -0,0 +1,287 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+var nwfilterResolveParams = []NWFilterParameter{
+	{Name: "A", Value: "1.1.1.1"},
+	{Name: "A", Value: "2.2.2.2"},
+	{Name: "A", Value: "3.3.3.3"},
+	{Name: "A", Value: "3.3.3.3"},
+	{Name: "B", Value: "80"},
+	{Name: "B", Value: "90"},
+	{Name: "B", Value: "80"},
+	{Name: "B", Value: "80"},
+}
+
+// nwfilterResolvedSummary describes each rule by its filter, chain,
+// priority and the addresses and ports of its tcp match
+func nwfilterResolvedSummary(rules []NWFilterResolvedRule) []string {
+	var ret []string
+	for _, rule := range rules {
+		line := fmt.Sprintf("%s %s %d", rule.Filter, rule.Chain, rule.Priority)
+		if tcp := rule.Rule.TCP; tcp != nil {
+			line += fmt.Sprintf(" %s:%s", tcp.SrcIPAddr.Str, tcp.DstPortStart.Str)
+		}
+		ret = append(ret, line)
+	}
+	return ret
+}
+
+var nwfilterResolveTestData = []struct {
+	Filter  string
+	Filters []string
+	Rules   []string
+}{
+	{
+		Filter: strings.Join([]string{
+			`<filter name='lockstep'>`,
+			`  <rule action='accept' direction='out'>`,
+			`    <tcp srcipaddr='$A' dstportstart='$B'/>`,
+			`  </rule>`,
+			`</filter>`,
+		}, "\n"),
+		Rules: []string{
+			"lockstep root 500 1.1.1.1:80",
+			"lockstep root 500 2.2.2.2:90",
+			"lockstep root 500 3.3.3.3:80",
+		},
+	},
+	{
+		Filter: strings.Join([]string{
+			`<filter name='combine'>`,
+			`  <rule action='accept' direction='out'>`,
+			`    <tcp srcipaddr='$A[@1]' dstportstart='$B[@2]'/>`,
+			`  </rule>`,
+			`  <rule action='accept' direction='out'>`,
+			`    <tcp srcipaddr='$A[1]' dstportstart='$B[1]'/>`,
+			`  </rule>`,
+			`</filter>`,
+		}, "\n"),
+		Rules: []string{
+			"combine root 500 1.1.1.1:80",
+			"combine root 500 2.2.2.2:80",
+			"combine root 500 3.3.3.3:80",
+			"combine root 500 1.1.1.1:90",
+			"combine root 500 2.2.2.2:90",
+			"combine root 500 3.3.3.3:90",
+			"combine root 500 2.2.2.2:90",
+		},
+	},
+	{
+		Filter: strings.Join([]string{
+			`<filter name='top'>`,
+			`  <filterref filter='web'>`,
+			`    <parameter name='A' value='10.0.0.1'/>`,
+			`  </filterref>`,
+			`  <rule action='drop' direction='inout' priority='900'>`,
+			`    <all/>`,
+			`  </rule>`,
+			`  <filterref filter='arp'/>`,
+			`  <rule action='accept' direction='out' priority='-10'>`,
+			`    <tcp srcipaddr='$A[0]' dstportstart='22'/>`,
+			`  </rule>`,
+			`</filter>`,
+		}, "\n"),
+		Filters: []string{
+			strings.Join([]string{
+				`<filter name='web' chain='ipv4'>`,
+				`  <rule action='accept' direction='out'>`,
+				`    <tcp srcipaddr='$A' dstportstart='443'/>`,
+				`  </rule>`,
+				`</filter>`,
+			}, "\n"),
+			strings.Join([]string{
+				`<filter name='arp' chain='arp' priority='-900'>`,
+				`  <rule action='accept' direction='inout' priority='100'>`,
+				`    <arp/>`,
+				`  </rule>`,
+				`</filter>`,
+			}, "\n"),
+		},
+		Rules: []string{
+			"top root -10 1.1.1.1:22",
+			"top root 900",
+			"arp arp 100",
+			"web ipv4 500 10.0.0.1:443",
+		},
+	},
+}
+
+func TestNWFilterResolve(t *testing.T) {
+	for _, test := range nwfilterResolveTestData {
+		filter := &NWFilter{}
+		if err := filter.Unmarshal(test.Filter); err != nil {
+			t.Fatal(err)
+		}
+		var filters []NWFilter
+		for _, doc := range test.Filters {
+			ref := NWFilter{}
+			if err := ref.Unmarshal(doc); err != nil {
+				t.Fatal(err)
+			}
+			filters = append(filters, ref)
+		}
+
+		rules, err := filter.Resolve(filters, nwfilterResolveParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		summary := strings.Join(nwfilterResolvedSummary(rules), "\n")
+		expect := strings.Join(test.Rules, "\n")
+		if summary != expect {
+			t.Errorf("Bad rules for %s:\n%s\n does not match\n%s", test.Filter, summary, expect)
+		}
+		for _, rule := range rules {
+			for _, field := range nwfilterRuleFields(rule.Rule) {
+				if field.Var != "" {
+					t.Errorf("Variable '%s' was not resolved in %s", field.Var, test.Filter)
+				}
+			}
+		}
+	}
+}
+
+func TestNWFilterResolveRefs(t *testing.T) {
+	filter := NWFilter{}
+	err := filter.Unmarshal(strings.Join([]string{
+		`<filter name='no-mac-spoofing' chain='mac'>`,
+		`  <rule action='return' direction='out'>`,
+		`    <mac srcmacaddr='$MAC'/>`,
+		`  </rule>`,
+		`</filter>`,
+	}, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	filters := []NWFilter{filter}
+
+	ref := &DomainInterfaceFilterRef{
+		Filter: "no-mac-spoofing",
+		Parameters: []DomainInterfaceFilterParam{
+			{Name: "MAC", Value: "52:54:00:aa:bb:cc"},
+		},
+	}
+	rules, err := ref.Resolve(filters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 1 || rules[0].Rule.MAC.SrcMACAddr.Str != "52:54:00:aa:bb:cc" {
+		t.Errorf("Bad rules from interface filter reference: %v", rules)
+	}
+	if rules[0].ChainPriority != -800 {
+		t.Errorf("Expected mac chain priority -800, got %d", rules[0].ChainPriority)
+	}
+	if filter.Entries[0].Rule.MAC.SrcMACAddr.Var != "MAC" {
+		t.Errorf("Resolving modified the filter")
+	}
+
+	binding := &NWFilterBinding{
+		MAC:       &NWFilterBindingMAC{Address: "52:54:00:11:22:33"},
+		FilterRef: &NWFilterBindingFilterRef{Filter: "no-mac-spoofing"},
+	}
+	rules, err = binding.Resolve(filters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 1 || rules[0].Rule.MAC.SrcMACAddr.Str != "52:54:00:11:22:33" {
+		t.Errorf("Bad rules from binding: %v", rules)
+	}
+
+	ref.Filter = "missing"
+	if _, err := ref.Resolve(filters); err == nil {
+		t.Errorf("Expected error for missing filter")
+	}
+}
+
+func TestNWFilterResolveErrors(t *testing.T) {
+	var tests = []struct {
+		Filters []string
+		Error   string
+	}{
+		{
+			Filters: []string{
+				`<filter name='a'><filterref filter='b'/></filter>`,
+				`<filter name='b'><filterref filter='c'/></filter>`,
+				`<filter name='c'><filterref filter='b'/></filter>`,
+			},
+			Error: "Filter reference cycle b -> c -> b",
+		},
+		{
+			Filters: []string{
+				`<filter name='a'><filterref filter='b'/></filter>`,
+			},
+			Error: "Filter 'b' referenced by 'a' does not exist",
+		},
+		{
+			Filters: []string{
+				`<filter name='a'><rule action='drop' direction='out'><ip srcipaddr='$X'/></rule></filter>`,
+			},
+			Error: "Filter 'a': Variable 'X' is not defined",
+		},
+		{
+			Filters: []string{
+				`<filter name='a'><rule action='drop' direction='out'><ip srcipaddr='$A[4]'/></rule></filter>`,
+			},
+			Error: "Filter 'a': Index 4 of variable 'A' is out of range",
+		},
+		{
+			Filters: []string{
+				`<filter name='a'><rule action='drop' direction='out'><ip srcipaddr='$A' dstipaddr='$C'/></rule></filter>`,
+			},
+			Error: "Filter 'a': Variables 'A' and 'C' of iterator 0 have different numbers of values",
+		},
+		{
+			Filters: []string{
+				`<filter name='a'><rule action='drop' direction='out'><ip srcipaddr='$A[@x]'/></rule></filter>`,
+			},
+			Error: "Filter 'a': Malformed variable reference 'A[@x]'",
+		},
+	}
+
+	for _, test := range tests {
+		var filters []NWFilter
+		for _, doc := range test.Filters {
+			filter := NWFilter{}
+			if err := filter.Unmarshal(doc); err != nil {
+				t.Fatal(err)
+			}
+			filters = append(filters, filter)
+		}
+		params := append(nwfilterResolveParams, NWFilterParameter{Name: "C", Value: "1080"})
+		_, err := filters[0].Resolve(filters, params)
+		if err == nil {
+			t.Errorf("Expected error for %s", test.Filters[0])
+		} else if err.Error() != test.Error {
+			t.Errorf("Expected error '%s', got '%s'", test.Error, err)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+// StoragePoolType is the storage pool type attribute
+type StoragePoolType string
+
+const (
+	StoragePoolTypeDir         StoragePoolType = "dir"
+	StoragePoolTypeFS          StoragePoolType = "fs"
+	StoragePoolTypeNetFS       StoragePoolType = "netfs"
+	StoragePoolTypeLogical     StoragePoolType = "logical"
+	StoragePoolTypeDisk        StoragePoolType = "disk"
+	StoragePoolTypeISCSI       StoragePoolType = "iscsi"
+	StoragePoolTypeISCSIDirect StoragePoolType = "iscsi-direct"
+	StoragePoolTypeSCSI        StoragePoolType = "scsi"
+	StoragePoolTypeMPath       StoragePoolType = "mpath"
+	StoragePoolTypeRBD         StoragePoolType = "rbd"
+	StoragePoolTypeSheepdog    StoragePoolType = "sheepdog"
+	StoragePoolTypeGluster     StoragePoolType = "gluster"
+	StoragePoolTypeZFS         StoragePoolType = "zfs"
+	StoragePoolTypeVStorage    StoragePoolType = "vstorage"
+)
+
+// IsValid reports whether v is one of the StoragePoolType constants
+func (v StoragePoolType) IsValid() bool {
+	switch v {
+	case StoragePoolTypeDir, StoragePoolTypeFS, StoragePoolTypeNetFS,
+		StoragePoolTypeLogical, StoragePoolTypeDisk,
+		StoragePoolTypeISCSI, StoragePoolTypeISCSIDirect,
+		StoragePoolTypeSCSI, StoragePoolTypeMPath, StoragePoolTypeRBD,
+		StoragePoolTypeSheepdog, StoragePoolTypeGluster,
+		StoragePoolTypeZFS, StoragePoolTypeVStorage:
+		return true
+	}
+	return false
+}
+
+// StorageVolumeType is the storage volume type attribute
+type StorageVolumeType string
+
+const (
+	StorageVolumeTypeFile    StorageVolumeType = "file"
+	StorageVolumeTypeBlock   StorageVolumeType = "block"
+	StorageVolumeTypeDir     StorageVolumeType = "dir"
+	StorageVolumeTypeNetwork StorageVolumeType = "network"
+	StorageVolumeTypeNetDir  StorageVolumeType = "netdir"
+	StorageVolumeTypePloop   StorageVolumeType = "ploop"
+)
+
+// IsValid reports whether v is one of the StorageVolumeType constants
+func (v StorageVolumeType) IsValid() bool {
+	switch v {
+	case StorageVolumeTypeFile, StorageVolumeTypeBlock,
+		StorageVolumeTypeDir, StorageVolumeTypeNetwork,
+		StorageVolumeTypeNetDir, StorageVolumeTypePloop:
+		return true
+	}
+	return false
+}
+
+// StorageVolumeFormat is a file volume target format type
+type StorageVolumeFormat string
+
+const (
+	StorageVolumeFormatNone  StorageVolumeFormat = "none"
+	StorageVolumeFormatRaw   StorageVolumeFormat = "raw"
+	StorageVolumeFormatDir   StorageVolumeFormat = "dir"
+	StorageVolumeFormatBochs StorageVolumeFormat = "bochs"
+	StorageVolumeFormatCloop StorageVolumeFormat = "cloop"
+	StorageVolumeFormatDMG   StorageVolumeFormat = "dmg"
+	StorageVolumeFormatISO   StorageVolumeFormat = "iso"
+	StorageVolumeFormatVPC   StorageVolumeFormat = "vpc"
+	StorageVolumeFormatVDI   StorageVolumeFormat = "vdi"
+	StorageVolumeFormatFAT   StorageVolumeFormat = "fat"
+	StorageVolumeFormatVHD   StorageVolumeFormat = "vhd"
+	StorageVolumeFormatPloop StorageVolumeFormat = "ploop"
+	StorageVolumeFormatCow   StorageVolumeFormat = "cow"
+	StorageVolumeFormatQCow  StorageVolumeFormat = "qcow"
+	StorageVolumeFormatQCow2 StorageVolumeFormat = "qcow2"
+	StorageVolumeFormatQED   StorageVolumeFormat = "qed"
+	StorageVolumeFormatVMDK  StorageVolumeFormat = "vmdk"
+	StorageVolumeFormatLUKS  StorageVolumeFormat = "luks"
+)
+
+// IsValid reports whether v is one of the StorageVolumeFormat constants
+func (v StorageVolumeFormat) IsValid() bool {
+	switch v {
+	case StorageVolumeFormatNone, StorageVolumeFormatRaw,
+		StorageVolumeFormatDir, StorageVolumeFormatBochs,
+		StorageVolumeFormatCloop, StorageVolumeFormatDMG,
+		StorageVolumeFormatISO, StorageVolumeFormatVPC,
+		StorageVolumeFormatVDI, StorageVolumeFormatFAT,
+		StorageVolumeFormatVHD, StorageVolumeFormatPloop,
+		StorageVolumeFormatCow, StorageVolumeFormatQCow,
+		StorageVolumeFormatQCow2, StorageVolumeFormatQED,
+		StorageVolumeFormatVMDK, StorageVolumeFormatLUKS:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,157 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"encoding/xml"
+)
+
+type StoragePoolCapabilities struct {
+	XMLName   xml.Name                          `xml:"storagepoolCapabilities"`
+	PoolTypes []StoragePoolCapabilitiesPoolType `xml:"pool"`
+}
+
+type StoragePoolCapabilitiesPoolType struct {
+	Type        string                              `xml:"type,attr"`
+	Supported   string                              `xml:"supported,attr"`
+	PoolOptions *StoragePoolCapabilitiesPoolOptions `xml:"poolOptions"`
+	VolOptions  *StoragePoolCapabilitiesVolOptions  `xml:"volOptions"`
+}
+
+type StoragePoolCapabilitiesPoolOptions struct {
+	DefaultFormat *StoragePoolCapabilitiesDefaultFormat `xml:"defaultFormat"`
+	Enums         []StoragePoolCapabilitiesEnum         `xml:"enum"`
+}
+
+type StoragePoolCapabilitiesVolOptions struct {
+	DefaultFormat *StoragePoolCapabilitiesDefaultFormat `xml:"defaultFormat"`
+	Enums         []StoragePoolCapabilitiesEnum         `xml:"enum"`
+}
+
+type StoragePoolCapabilitiesDefaultFormat struct {
+	Type string `xml:"type,attr"`
+}
+
+type StoragePoolCapabilitiesEnum struct {
+	Name   string   `xml:"name,attr"`
+	Values []string `xml:"value"`
+}
+
+func (c *StoragePoolCapabilities) Unmarshal(doc string) error {
+	return xml.Unmarshal([]byte(doc), c)
+}
+
+func (c *StoragePoolCapabilities) UnmarshalStrict(doc string) error {
+	return unmarshalStrict(c, doc)
+}
+
+func (c *StoragePoolCapabilities) Marshal() (string, error) {
+	doc, err := xml.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(doc), nil
+}
+
+func findStoragePoolCapabilitiesEnum(enums []StoragePoolCapabilitiesEnum, name string) []string {
+	for _, enum := range enums {
+		if enum.Name == name {
+			return enum.Values
+		}
+	}
+	return nil
+}
+
+// PoolType returns the capabilities reported for the pool type
+// typ, or nil if the pool type is not listed at all
+func (c *StoragePoolCapabilities) PoolType(typ string) *StoragePoolCapabilitiesPoolType {
+	for i := range c.PoolTypes {
+		if c.PoolTypes[i].Type == typ {
+			return &c.PoolTypes[i]
+		}
+	}
+	return nil
+}
+
+// IsPoolTypeSupported reports whether the pool type typ is
+// listed and marked as supported
+func (c *StoragePoolCapabilities) IsPoolTypeSupported(typ string) bool {
+	pool := c.PoolType(typ)
+	return pool != nil && pool.Supported == "yes"
+}
+
+// SupportedPoolTypes returns the names of all pool types marked
+// as supported
+func (c *StoragePoolCapabilities) SupportedPoolTypes() []string {
+	var types []string
+	for _, pool := range c.PoolTypes {
+		if pool.Supported == "yes" {
+			types = append(types, pool.Type)
+		}
+	}
+	return types
+}
+
+// SourceFormats returns the source format types accepted for
+// the pool type typ
+func (c *StoragePoolCapabilities) SourceFormats(typ string) []string {
+	pool := c.PoolType(typ)
+	if pool == nil || pool.PoolOptions == nil {
+		return nil
+	}
+	return findStoragePoolCapabilitiesEnum(pool.PoolOptions.Enums, "sourceFormatType")
+}
+
+// DefaultSourceFormat returns the default source format type
+// for the pool type typ, or an empty string if there is none
+func (c *StoragePoolCapabilities) DefaultSourceFormat(typ string) string {
+	pool := c.PoolType(typ)
+	if pool == nil || pool.PoolOptions == nil || pool.PoolOptions.DefaultFormat == nil {
+		return ""
+	}
+	return pool.PoolOptions.DefaultFormat.Type
+}
+
+// VolumeFormats returns the target format types accepted for
+// volumes in the pool type typ
+func (c *StoragePoolCapabilities) VolumeFormats(typ string) []string {
+	pool := c.PoolType(typ)
+	if pool == nil || pool.VolOptions == nil {
+		return nil
+	}
+	return findStoragePoolCapabilitiesEnum(pool.VolOptions.Enums, "targetFormatType")
+}
+
+// DefaultVolumeFormat returns the default target format type
+// for volumes in the pool type typ, or an empty string if there
+// is none
+func (c *StoragePoolCapabilities) DefaultVolumeFormat(typ string) string {
+	pool := c.PoolType(typ)
+	if pool == nil || pool.VolOptions == nil || pool.VolOptions.DefaultFormat == nil {
+		return ""
+	}
+	return pool.VolOptions.DefaultFormat.Type
+}
@@ -0,0 +1,117 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+var storagePoolCapabilitiesTestXML = []string{
+	`<storagepoolCapabilities>`,
+	`  <pool type="dir" supported="yes">`,
+	`    <volOptions>`,
+	`      <defaultFormat type="raw"></defaultFormat>`,
+	`      <enum name="targetFormatType">`,
+	`        <value>none</value>`,
+	`        <value>raw</value>`,
+	`        <value>qcow2</value>`,
+	`      </enum>`,
+	`    </volOptions>`,
+	`  </pool>`,
+	`  <pool type="fs" supported="yes">`,
+	`    <poolOptions>`,
+	`      <defaultFormat type="auto"></defaultFormat>`,
+	`      <enum name="sourceFormatType">`,
+	`        <value>auto</value>`,
+	`        <value>ext4</value>`,
+	`        <value>xfs</value>`,
+	`      </enum>`,
+	`    </poolOptions>`,
+	`    <volOptions>`,
+	`      <defaultFormat type="raw"></defaultFormat>`,
+	`      <enum name="targetFormatType">`,
+	`        <value>raw</value>`,
+	`        <value>qcow2</value>`,
+	`      </enum>`,
+	`    </volOptions>`,
+	`  </pool>`,
+	`  <pool type="rbd" supported="no"></pool>`,
+	`</storagepoolCapabilities>`,
+}
+
+func TestStoragePoolCapabilities(t *testing.T) {
+	expect := strings.Join(storagePoolCapabilitiesTestXML, "\n")
+
+	caps := &StoragePoolCapabilities{}
+	err := caps.Unmarshal(expect)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := caps.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc != expect {
+		t.Fatal("Bad xml:\n", string(doc), "\n does not match\n", expect, "\n")
+	}
+
+	if !caps.IsPoolTypeSupported("fs") {
+		t.Fatal("Expected fs pool type to be supported")
+	}
+	if caps.IsPoolTypeSupported("rbd") {
+		t.Fatal("Expected rbd pool type to be unsupported")
+	}
+	if caps.IsPoolTypeSupported("zfs") {
+		t.Fatal("Expected unknown zfs pool type to be unsupported")
+	}
+
+	types := strings.Join(caps.SupportedPoolTypes(), ",")
+	if types != "dir,fs" {
+		t.Fatalf("Unexpected supported pool types '%s'", types)
+	}
+
+	formats := strings.Join(caps.SourceFormats("fs"), ",")
+	if formats != "auto,ext4,xfs" {
+		t.Fatalf("Unexpected fs source formats '%s'", formats)
+	}
+	if caps.SourceFormats("dir") != nil {
+		t.Fatal("Expected no dir source formats")
+	}
+
+	formats = strings.Join(caps.VolumeFormats("dir"), ",")
+	if formats != "none,raw,qcow2" {
+		t.Fatalf("Unexpected dir volume formats '%s'", formats)
+	}
+
+	if caps.DefaultSourceFormat("fs") != "auto" {
+		t.Fatal("Unexpected fs default source format")
+	}
+	if caps.DefaultVolumeFormat("fs") != "raw" {
+		t.Fatal("Unexpected fs default volume format")
+	}
+}
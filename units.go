@@ -0,0 +1,320 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ScaledSize is implemented by the elements holding a size as a
+// number plus a unit suffix
+type ScaledSize interface {
+	Bytes() (uint64, error)
+}
+
+// UnitScale returns the multiplier of a unit suffix, following the
+// rules of libvirt's virScaleInteger. Matching is case insensitive.
+// A bare letter or an "iB" suffix is a power of 1024, while a "B"
+// suffix is a power of 1000, so "M" and "MiB" mean 1048576 but "MB"
+// means 1000000. An empty unit means bytes
+func UnitScale(unit string) (uint64, error) {
+	lower := strings.ToLower(unit)
+	switch lower {
+	case "", "b", "byte", "bytes":
+		return 1, nil
+	}
+
+	var base uint64 = 1024
+	if len(lower) == 2 && lower[1] == 'b' {
+		base = 1000
+	} else if len(lower) != 1 && lower[1:] != "ib" {
+		return 0, fmt.Errorf("Unknown unit '%s'", unit)
+	}
+	power := strings.IndexByte("kmgtpe", lower[0])
+	if power < 0 {
+		return 0, fmt.Errorf("Unknown unit '%s'", unit)
+	}
+	scale := uint64(1)
+	for i := 0; i <= power; i++ {
+		scale *= base
+	}
+	return scale, nil
+}
+
+// UnitToBytes converts a value in the given unit to bytes
+func UnitToBytes(value uint64, unit string) (uint64, error) {
+	scale, err := UnitScale(unit)
+	if err != nil {
+		return 0, err
+	}
+	if value > ^uint64(0)/scale {
+		return 0, fmt.Errorf("Size %d%s is too large", value, unit)
+	}
+	return value * scale, nil
+}
+
+// BytesToUnit converts a number of bytes to the given unit, rounding
+// up any fraction as libvirt does
+func BytesToUnit(bytes uint64, unit string) (uint64, error) {
+	scale, err := UnitScale(unit)
+	if err != nil {
+		return 0, err
+	}
+	value := bytes / scale
+	if bytes%scale != 0 {
+		value++
+	}
+	return value, nil
+}
+
+// CompareSizes returns -1, 0 or 1 if a is smaller than, equal to or
+// larger than b
+func CompareSizes(a, b ScaledSize) (int, error) {
+	abytes, err := a.Bytes()
+	if err != nil {
+		return 0, err
+	}
+	bbytes, err := b.Bytes()
+	if err != nil {
+		return 0, err
+	}
+	if abytes < bbytes {
+		return -1, nil
+	} else if abytes > bbytes {
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// AddSizes returns the total of all the sizes in bytes
+func AddSizes(sizes ...ScaledSize) (uint64, error) {
+	var total uint64
+	for _, size := range sizes {
+		bytes, err := size.Bytes()
+		if err != nil {
+			return 0, err
+		}
+		if total+bytes < total {
+			return 0, fmt.Errorf("Total size is too large")
+		}
+		total += bytes
+	}
+	return total, nil
+}
+
+func scaledToBytes(value uint64, unit, defaultUnit string) (uint64, error) {
+	if unit == "" {
+		unit = defaultUnit
+	}
+	return UnitToBytes(value, unit)
+}
+
+func bytesToScaledUint(bytes uint64, unit string) (uint, error) {
+	value, err := BytesToUnit(bytes, unit)
+	if err != nil {
+		return 0, err
+	}
+	if value > uint64(^uint(0)) {
+		return 0, fmt.Errorf("Size %d%s is too large", value, unit)
+	}
+	return uint(value), nil
+}
+
+// Bytes returns the memory size in bytes. Sizes without a unit are
+// in KiB
+func (m *DomainMemory) Bytes() (uint64, error) {
+	return scaledToBytes(uint64(m.Value), m.Unit, "KiB")
+}
+
+// SetBytes stores the size expressed in the given unit
+func (m *DomainMemory) SetBytes(bytes uint64, unit string) error {
+	value, err := bytesToScaledUint(bytes, unit)
+	if err != nil {
+		return err
+	}
+	m.Value, m.Unit = value, unit
+	return nil
+}
+
+// ConvertTo re-expresses the size in the given unit
+func (m *DomainMemory) ConvertTo(unit string) error {
+	bytes, err := m.Bytes()
+	if err != nil {
+		return err
+	}
+	return m.SetBytes(bytes, unit)
+}
+
+// Bytes returns the memory size in bytes. Sizes without a unit are
+// in KiB
+func (m *DomainCurrentMemory) Bytes() (uint64, error) {
+	return scaledToBytes(uint64(m.Value), m.Unit, "KiB")
+}
+
+// SetBytes stores the size expressed in the given unit
+func (m *DomainCurrentMemory) SetBytes(bytes uint64, unit string) error {
+	value, err := bytesToScaledUint(bytes, unit)
+	if err != nil {
+		return err
+	}
+	m.Value, m.Unit = value, unit
+	return nil
+}
+
+// ConvertTo re-expresses the size in the given unit
+func (m *DomainCurrentMemory) ConvertTo(unit string) error {
+	bytes, err := m.Bytes()
+	if err != nil {
+		return err
+	}
+	return m.SetBytes(bytes, unit)
+}
+
+// Bytes returns the memory size in bytes. Sizes without a unit are
+// in KiB
+func (m *DomainMaxMemory) Bytes() (uint64, error) {
+	return scaledToBytes(uint64(m.Value), m.Unit, "KiB")
+}
+
+// SetBytes stores the size expressed in the given unit
+func (m *DomainMaxMemory) SetBytes(bytes uint64, unit string) error {
+	value, err := bytesToScaledUint(bytes, unit)
+	if err != nil {
+		return err
+	}
+	m.Value, m.Unit = value, unit
+	return nil
+}
+
+// ConvertTo re-expresses the size in the given unit
+func (m *DomainMaxMemory) ConvertTo(unit string) error {
+	bytes, err := m.Bytes()
+	if err != nil {
+		return err
+	}
+	return m.SetBytes(bytes, unit)
+}
+
+// Bytes returns the memory device size in bytes. Sizes without a
+// unit are in KiB
+func (s *DomainMemorydevTargetSize) Bytes() (uint64, error) {
+	return scaledToBytes(uint64(s.Value), s.Unit, "KiB")
+}
+
+// SetBytes stores the size expressed in the given unit
+func (s *DomainMemorydevTargetSize) SetBytes(bytes uint64, unit string) error {
+	value, err := bytesToScaledUint(bytes, unit)
+	if err != nil {
+		return err
+	}
+	s.Value, s.Unit = value, unit
+	return nil
+}
+
+// ConvertTo re-expresses the size in the given unit
+func (s *DomainMemorydevTargetSize) ConvertTo(unit string) error {
+	bytes, err := s.Bytes()
+	if err != nil {
+		return err
+	}
+	return s.SetBytes(bytes, unit)
+}
+
+// Bytes returns the shared memory size in bytes. Sizes without a
+// unit are in bytes
+func (s *DomainShmemSize) Bytes() (uint64, error) {
+	return scaledToBytes(uint64(s.Value), s.Unit, "bytes")
+}
+
+// SetBytes stores the size expressed in the given unit
+func (s *DomainShmemSize) SetBytes(bytes uint64, unit string) error {
+	value, err := bytesToScaledUint(bytes, unit)
+	if err != nil {
+		return err
+	}
+	s.Value, s.Unit = value, unit
+	return nil
+}
+
+// ConvertTo re-expresses the size in the given unit
+func (s *DomainShmemSize) ConvertTo(unit string) error {
+	bytes, err := s.Bytes()
+	if err != nil {
+		return err
+	}
+	return s.SetBytes(bytes, unit)
+}
+
+// Bytes returns the pool size in bytes. Sizes without a unit are in
+// bytes
+func (s *StoragePoolSize) Bytes() (uint64, error) {
+	return scaledToBytes(s.Value, s.Unit, "bytes")
+}
+
+// SetBytes stores the size expressed in the given unit
+func (s *StoragePoolSize) SetBytes(bytes uint64, unit string) error {
+	value, err := BytesToUnit(bytes, unit)
+	if err != nil {
+		return err
+	}
+	s.Value, s.Unit = value, unit
+	return nil
+}
+
+// ConvertTo re-expresses the size in the given unit
+func (s *StoragePoolSize) ConvertTo(unit string) error {
+	bytes, err := s.Bytes()
+	if err != nil {
+		return err
+	}
+	return s.SetBytes(bytes, unit)
+}
+
+// Bytes returns the volume size in bytes. Sizes without a unit are
+// in bytes
+func (s *StorageVolumeSize) Bytes() (uint64, error) {
+	return scaledToBytes(s.Value, s.Unit, "bytes")
+}
+
+// SetBytes stores the size expressed in the given unit
+func (s *StorageVolumeSize) SetBytes(bytes uint64, unit string) error {
+	value, err := BytesToUnit(bytes, unit)
+	if err != nil {
+		return err
+	}
+	s.Value, s.Unit = value, unit
+	return nil
+}
+
+// ConvertTo re-expresses the size in the given unit
+func (s *StorageVolumeSize) ConvertTo(unit string) error {
+	bytes, err := s.Bytes()
+	if err != nil {
+		return err
+	}
+	return s.SetBytes(bytes, unit)
+}
@@ -0,0 +1,122 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"testing"
+)
+
+func TestUnitToBytes(t *testing.T) {
+	var tests = []struct {
+		Value    uint64
+		Unit     string
+		Expected uint64
+	}{
+		{5, "", 5},
+		{5, "b", 5},
+		{5, "bytes", 5},
+		{1, "k", 1024},
+		{1, "KiB", 1024},
+		{1, "KB", 1000},
+		{1, "M", 1048576},
+		{1, "mib", 1048576},
+		{1, "MB", 1000000},
+		{1, "G", 1073741824},
+		{1, "GB", 1000000000},
+		{1, "T", 1099511627776},
+		{1, "TB", 1000000000000},
+		{1, "P", 1125899906842624},
+		{1, "PB", 1000000000000000},
+		{1, "E", 1152921504606846976},
+		{1, "EB", 1000000000000000000},
+	}
+
+	for _, test := range tests {
+		bytes, err := UnitToBytes(test.Value, test.Unit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes != test.Expected {
+			t.Errorf("%d%s: expected %d bytes got %d", test.Value, test.Unit, test.Expected, bytes)
+		}
+	}
+
+	for _, unit := range []string{"KiBB", "Q", "iB", "kbytes"} {
+		if _, err := UnitToBytes(1, unit); err == nil {
+			t.Errorf("Expected error for unit '%s'", unit)
+		}
+	}
+	if _, err := UnitToBytes(16, "EiB"); err == nil {
+		t.Error("Expected overflow error")
+	}
+}
+
+func TestScaledSizes(t *testing.T) {
+	mem := &DomainMemory{Value: 1048576}
+	cur := &DomainCurrentMemory{Value: 1, Unit: "GiB"}
+	vol := &StorageVolumeSize{Value: 1, Unit: "GB"}
+	shmem := &DomainShmemSize{Value: 4, Unit: "M"}
+
+	cmp, err := CompareSizes(mem, cur)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmp != 0 {
+		t.Errorf("Expected %v == %v", mem, cur)
+	}
+	cmp, err = CompareSizes(vol, cur)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmp != -1 {
+		t.Errorf("Expected %v < %v", vol, cur)
+	}
+
+	total, err := AddSizes(mem, vol, shmem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 1073741824+1000000000+4194304 {
+		t.Errorf("Unexpected total %d", total)
+	}
+
+	err = vol.ConvertTo("MiB")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vol.Value != 954 || vol.Unit != "MiB" {
+		t.Errorf("Expected 954 MiB got %d %s", vol.Value, vol.Unit)
+	}
+
+	pool := &StoragePoolSize{}
+	err = pool.SetBytes(3*1024*1024*1024, "G")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pool.Value != 3 || pool.Unit != "G" {
+		t.Errorf("Expected 3 G got %d %s", pool.Value, pool.Unit)
+	}
+}
@@ -0,0 +1,507 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// ValidationError describes a single problem found by one of the
+// Validate methods. Path is the location of the offending field,
+// expressed using Go field names, for example
+// "Devices.Disks[1].Target"
+type ValidationError struct {
+	Path    string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return e.Path + ": " + e.Message
+}
+
+// ValidationErrors is the error returned by the Validate methods,
+// holding every problem that was found
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+type validator struct {
+	errs ValidationErrors
+}
+
+func (v *validator) report(path string, format string, args ...interface{}) {
+	v.errs = append(v.errs, ValidationError{
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *validator) required(path string, set bool) {
+	if !set {
+		v.report(path, "value is required")
+	}
+}
+
+// union reports an error if more than one of the alternatives
+// is set, or if none is set and one is mandatory
+func (v *validator) union(path string, mandatory bool, set ...bool) {
+	count := 0
+	for _, s := range set {
+		if s {
+			count++
+		}
+	}
+	if count > 1 {
+		v.report(path, "only one of %d alternatives may be set, but %d are", len(set), count)
+	} else if count == 0 && mandatory {
+		v.report(path, "one of %d alternatives must be set", len(set))
+	}
+}
+
+func (v *validator) mac(path, addr string) {
+	if addr == "" {
+		return
+	}
+	if _, err := net.ParseMAC(addr); err != nil {
+		v.report(path, "invalid MAC address '%s'", addr)
+	}
+}
+
+// enum reports an error if a non-empty value is not one of the
+// values the schema permits
+func (v *validator) enum(path, value string, valid bool) {
+	if value != "" && !valid {
+		v.report(path, "invalid value '%s'", value)
+	}
+}
+
+func (v *validator) result() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+func (v *validator) domainAddress(path string, a *DomainAddress) {
+	if a == nil {
+		return
+	}
+	v.union(path, false,
+		a.PCI != nil, a.Drive != nil, a.VirtioSerial != nil, a.CCID != nil,
+		a.USB != nil, a.SpaprVIO != nil, a.VirtioS390 != nil, a.CCW != nil,
+		a.VirtioMMIO != nil, a.ISA != nil, a.DIMM != nil, a.Unassigned != nil)
+}
+
+func (v *validator) domainDiskSource(path string, s *DomainDiskSource) {
+	if s == nil {
+		return
+	}
+	v.union(path, false,
+		s.File != nil, s.Block != nil, s.Dir != nil, s.Network != nil,
+		s.Volume != nil, s.NVME != nil, s.VHostUser != nil)
+}
+
+func (v *validator) domainDevices(path string, d *DomainDeviceList) {
+	for i, disk := range d.Disks {
+		diskPath := fmt.Sprintf("%s.Disks[%d]", path, i)
+		v.enum(diskPath+".Device", disk.Device, DomainDiskDevice(disk.Device).IsValid())
+		if disk.Target == nil {
+			v.report(diskPath+".Target", "value is required")
+		} else {
+			v.required(diskPath+".Target.Dev", disk.Target.Dev != "")
+			v.enum(diskPath+".Target.Bus", disk.Target.Bus,
+				DomainDiskBus(disk.Target.Bus).IsValid())
+		}
+		if drv := disk.Driver; drv != nil {
+			v.enum(diskPath+".Driver.Cache", drv.Cache, DomainDiskCache(drv.Cache).IsValid())
+			v.enum(diskPath+".Driver.IO", drv.IO, DomainDiskIO(drv.IO).IsValid())
+			v.enum(diskPath+".Driver.ErrorPolicy", drv.ErrorPolicy,
+				DomainDiskErrorPolicy(drv.ErrorPolicy).IsValid())
+			v.enum(diskPath+".Driver.Discard", drv.Discard,
+				DomainDiskDiscard(drv.Discard).IsValid())
+			v.enum(diskPath+".Driver.DetectZeros", drv.DetectZeros,
+				DomainDiskDetectZeroes(drv.DetectZeros).IsValid())
+		}
+		v.domainDiskSource(diskPath+".Source", disk.Source)
+		v.domainAddress(diskPath+".Address", disk.Address)
+	}
+	for i, controller := range d.Controllers {
+		controllerPath := fmt.Sprintf("%s.Controllers[%d]", path, i)
+		v.required(controllerPath+".Type", controller.Type != "")
+		v.enum(controllerPath+".Type", controller.Type,
+			DomainControllerType(controller.Type).IsValid())
+		v.domainAddress(controllerPath+".Address", controller.Address)
+	}
+	for i, fs := range d.Filesystems {
+		v.domainAddress(fmt.Sprintf("%s.Filesystems[%d].Address", path, i), fs.Address)
+	}
+	for i, iface := range d.Interfaces {
+		ifacePath := fmt.Sprintf("%s.Interfaces[%d]", path, i)
+		src := iface.Source
+		if src == nil {
+			v.report(ifacePath+".Source", "value is required")
+		} else {
+			v.union(ifacePath+".Source", true,
+				src.User != nil, src.Ethernet != nil, src.VHostUser != nil,
+				src.Server != nil, src.Client != nil, src.MCast != nil,
+				src.Network != nil, src.Bridge != nil, src.Internal != nil,
+				src.Direct != nil, src.Hostdev != nil, src.UDP != nil,
+				src.VDPA != nil)
+		}
+		if iface.MAC != nil {
+			v.mac(ifacePath+".MAC.Address", iface.MAC.Address)
+		}
+		v.domainAddress(ifacePath+".Address", iface.Address)
+	}
+	for i, graphic := range d.Graphics {
+		v.union(fmt.Sprintf("%s.Graphics[%d]", path, i), true,
+			graphic.SDL != nil, graphic.VNC != nil, graphic.RDP != nil,
+			graphic.Desktop != nil, graphic.Spice != nil, graphic.EGLHeadless != nil)
+	}
+	for i, video := range d.Videos {
+		videoPath := fmt.Sprintf("%s.Videos[%d]", path, i)
+		v.enum(videoPath+".Model.Type", video.Model.Type,
+			DomainVideoModelType(video.Model.Type).IsValid())
+		v.domainAddress(videoPath+".Address", video.Address)
+	}
+	for i, input := range d.Inputs {
+		inputPath := fmt.Sprintf("%s.Inputs[%d]", path, i)
+		v.enum(inputPath+".Type", input.Type, DomainInputType(input.Type).IsValid())
+		v.enum(inputPath+".Bus", input.Bus, DomainInputBus(input.Bus).IsValid())
+	}
+	for i, hostdev := range d.Hostdevs {
+		hostdevPath := fmt.Sprintf("%s.Hostdevs[%d]", path, i)
+		v.union(hostdevPath, true,
+			hostdev.SubsysUSB != nil, hostdev.SubsysSCSI != nil,
+			hostdev.SubsysSCSIHost != nil, hostdev.SubsysPCI != nil,
+			hostdev.SubsysMDev != nil, hostdev.CapsStorage != nil,
+			hostdev.CapsMisc != nil, hostdev.CapsNet != nil)
+		v.domainAddress(hostdevPath+".Address", hostdev.Address)
+	}
+	for i, rng := range d.RNGs {
+		rngPath := fmt.Sprintf("%s.RNGs[%d]", path, i)
+		v.required(rngPath+".Model", rng.Model != "")
+		v.enum(rngPath+".Model", rng.Model, DomainRNGModel(rng.Model).IsValid())
+		v.domainAddress(rngPath+".Address", rng.Address)
+	}
+	if d.MemBalloon != nil {
+		v.enum(path+".MemBalloon.Model", d.MemBalloon.Model,
+			DomainMemBalloonModel(d.MemBalloon.Model).IsValid())
+		v.domainAddress(path+".MemBalloon.Address", d.MemBalloon.Address)
+	}
+	if d.Watchdog != nil {
+		v.domainAddress(path+".Watchdog.Address", d.Watchdog.Address)
+	}
+}
+
+// Validate performs offline sanity checks of the domain
+// configuration, returning ValidationErrors describing every
+// problem found, or nil if the configuration looks usable
+func (d *Domain) Validate() error {
+	v := &validator{}
+
+	v.required("Type", d.Type != "")
+	v.enum("Type", d.Type, DomainType(d.Type).IsValid())
+	v.required("Name", d.Name != "")
+	v.required("Memory", d.Memory != nil)
+	v.enum("OnPoweroff", d.OnPoweroff, DomainLifecycleAction(d.OnPoweroff).IsValid())
+	v.enum("OnReboot", d.OnReboot, DomainLifecycleAction(d.OnReboot).IsValid())
+	v.enum("OnCrash", d.OnCrash, DomainCrashAction(d.OnCrash).IsValid())
+	if d.VCPU != nil && d.VCPU.Current > d.VCPU.Value {
+		v.report("VCPU.Current", "current vCPU count %d exceeds maximum %d",
+			d.VCPU.Current, d.VCPU.Value)
+	}
+	if d.Devices != nil {
+		v.domainDevices("Devices", d.Devices)
+	}
+
+	return v.result()
+}
+
+// Validate performs offline sanity checks of the network
+// configuration
+func (n *Network) Validate() error {
+	v := &validator{}
+
+	v.required("Name", n.Name != "")
+	if n.MAC != nil {
+		v.mac("MAC.Address", n.MAC.Address)
+	}
+	for i, ip := range n.IPs {
+		ipPath := fmt.Sprintf("IPs[%d]", i)
+		v.required(ipPath+".Address", ip.Address != "")
+		v.enum(ipPath+".Family", ip.Family, NetworkIPFamily(ip.Family).IsValid())
+		if ip.Netmask != "" && ip.Prefix != 0 {
+			v.report(ipPath, "netmask and prefix are mutually exclusive")
+		}
+		if ip.Family == string(NetworkIPFamilyIPv6) && ip.Netmask != "" {
+			v.report(ipPath+".Netmask", "netmask is not permitted for IPv6, use prefix")
+		}
+		if ip.DHCP == nil {
+			continue
+		}
+		for j, rng := range ip.DHCP.Ranges {
+			rangePath := fmt.Sprintf("%s.DHCP.Ranges[%d]", ipPath, j)
+			v.required(rangePath+".Start", rng.Start != "")
+			v.required(rangePath+".End", rng.End != "")
+		}
+		for j, host := range ip.DHCP.Hosts {
+			hostPath := fmt.Sprintf("%s.DHCP.Hosts[%d]", ipPath, j)
+			if host.MAC == "" && host.ID == "" && host.Name == "" {
+				v.report(hostPath, "one of MAC, ID or Name is required")
+			}
+			v.mac(hostPath+".MAC", host.MAC)
+		}
+	}
+	for i, pg := range n.PortGroups {
+		v.required(fmt.Sprintf("PortGroups[%d].Name", i), pg.Name != "")
+	}
+	if n.Forward != nil {
+		v.enum("Forward.Mode", n.Forward.Mode,
+			NetworkForwardMode(n.Forward.Mode).IsValid())
+		// libvirt defaults the forward mode to 'nat'
+		mode := n.Forward.Mode
+		if mode == "" {
+			mode = "nat"
+		}
+		if n.Forward.NAT != nil && mode != "nat" {
+			v.report("Forward.NAT", "NAT settings are only permitted with forward mode 'nat'")
+		}
+	}
+
+	return v.result()
+}
+
+type storagePoolSourceRules struct {
+	Host      bool
+	Device    bool
+	Dir       bool
+	Name      bool
+	Adapter   bool
+	Initiator bool
+	Target    bool
+}
+
+var storagePoolRequires = map[string]storagePoolSourceRules{
+	"dir":          {Target: true},
+	"fs":           {Device: true, Target: true},
+	"netfs":        {Host: true, Dir: true, Target: true},
+	"logical":      {},
+	"disk":         {Device: true},
+	"iscsi":        {Host: true, Device: true},
+	"iscsi-direct": {Host: true, Device: true, Initiator: true},
+	"scsi":         {Adapter: true},
+	"mpath":        {},
+	"rbd":          {Host: true, Name: true},
+	"sheepdog":     {Host: true, Name: true},
+	"gluster":      {Host: true, Name: true},
+	"zfs":          {Name: true},
+	"vstorage":     {Name: true},
+}
+
+// Validate performs offline sanity checks of the storage pool
+// configuration, including that the source fields populated are
+// appropriate for the pool type
+func (s *StoragePool) Validate() error {
+	v := &validator{}
+
+	v.required("Type", s.Type != "")
+	v.required("Name", s.Name != "")
+
+	rules, ok := storagePoolRequires[s.Type]
+	if !ok {
+		if s.Type != "" {
+			v.report("Type", "unknown pool type '%s'", s.Type)
+		}
+		return v.result()
+	}
+
+	src := s.Source
+	if src == nil {
+		src = &StoragePoolSource{}
+	}
+	if rules.Target {
+		v.required("Target.Path", s.Target != nil && s.Target.Path != "")
+	}
+	if rules.Host {
+		v.required("Source.Host", len(src.Host) != 0)
+	}
+	if rules.Device {
+		v.required("Source.Device", len(src.Device) != 0)
+	}
+	if rules.Dir {
+		v.required("Source.Dir", src.Dir != nil)
+	}
+	if rules.Name {
+		v.required("Source.Name", src.Name != "")
+	}
+	if rules.Adapter {
+		v.required("Source.Adapter", src.Adapter != nil)
+	}
+	if rules.Initiator {
+		v.required("Source.Initiator", src.Initiator != nil)
+	}
+	if s.Type == "logical" && src.Name == "" && len(src.Device) == 0 {
+		v.report("Source", "one of Name or Device is required for pool type 'logical'")
+	}
+
+	if src.Adapter != nil && s.Type != "scsi" {
+		v.report("Source.Adapter", "not permitted for pool type '%s'", s.Type)
+	}
+	if src.Initiator != nil && s.Type != "iscsi" && s.Type != "iscsi-direct" {
+		v.report("Source.Initiator", "not permitted for pool type '%s'", s.Type)
+	}
+	if src.Dir != nil && s.Type != "netfs" && s.Type != "gluster" {
+		v.report("Source.Dir", "not permitted for pool type '%s'", s.Type)
+	}
+
+	return v.result()
+}
+
+// Validate performs offline sanity checks of the storage volume
+// configuration
+func (s *StorageVolume) Validate() error {
+	v := &validator{}
+
+	v.required("Name", s.Name != "")
+	v.required("Capacity", s.Capacity != nil)
+
+	return v.result()
+}
+
+// Validate performs offline sanity checks of the secret
+// configuration, including that the usage fields match the
+// usage type
+func (s *Secret) Validate() error {
+	v := &validator{}
+
+	if s.Usage == nil {
+		return v.result()
+	}
+
+	u := s.Usage
+	switch u.Type {
+	case "":
+		v.report("Usage.Type", "value is required")
+	case "volume":
+		v.required("Usage.Volume", u.Volume != "")
+	case "ceph", "tls", "vtpm":
+		v.required("Usage.Name", u.Name != "")
+	case "iscsi":
+		v.required("Usage.Target", u.Target != "")
+	default:
+		v.report("Usage.Type", "unknown usage type '%s'", u.Type)
+	}
+	if u.Volume != "" && u.Type != "volume" {
+		v.report("Usage.Volume", "not permitted for usage type '%s'", u.Type)
+	}
+	if u.Target != "" && u.Type != "iscsi" {
+		v.report("Usage.Target", "not permitted for usage type '%s'", u.Type)
+	}
+	if u.Name != "" && u.Type != "ceph" && u.Type != "tls" && u.Type != "vtpm" {
+		v.report("Usage.Name", "not permitted for usage type '%s'", u.Type)
+	}
+
+	return v.result()
+}
+
+func (v *validator) nwfilterRule(path string, r *NWFilterRule) {
+	v.required(path+".Action", r.Action != "")
+	v.required(path+".Direction", r.Direction != "")
+	v.union(path, false,
+		r.ARP != nil, r.RARP != nil, r.MAC != nil, r.VLAN != nil, r.STP != nil,
+		r.IP != nil, r.IPv6 != nil, r.TCP != nil, r.UDP != nil, r.UDPLite != nil,
+		r.ESP != nil, r.AH != nil, r.SCTP != nil, r.ICMP != nil, r.All != nil,
+		r.IGMP != nil, r.TCPIPv6 != nil, r.UDPIPv6 != nil, r.UDPLiteIPv6 != nil,
+		r.ESPIPv6 != nil, r.AHIPv6 != nil, r.SCTPIPv6 != nil, r.ICMPv6 != nil,
+		r.AllIPv6 != nil)
+}
+
+// Validate performs offline sanity checks of the network filter
+func (f *NWFilter) Validate() error {
+	v := &validator{}
+
+	v.required("Name", f.Name != "")
+	for i, entry := range f.Entries {
+		entryPath := fmt.Sprintf("Entries[%d]", i)
+		v.union(entryPath, true, entry.Rule != nil, entry.Ref != nil)
+		if entry.Rule != nil {
+			v.nwfilterRule(entryPath+".Rule", entry.Rule)
+		}
+		if entry.Ref != nil {
+			v.required(entryPath+".Ref.Filter", entry.Ref.Filter != "")
+		}
+	}
+
+	return v.result()
+}
+
+// Validate performs offline sanity checks of the network port
+func (p *NetworkPort) Validate() error {
+	v := &validator{}
+
+	if p.Owner == nil {
+		v.report("Owner", "value is required")
+	} else {
+		v.required("Owner.Name", p.Owner.Name != "")
+		v.required("Owner.UUID", p.Owner.UUID != "")
+	}
+	if p.MAC == nil {
+		v.report("MAC", "value is required")
+	} else {
+		v.required("MAC.Address", p.MAC.Address != "")
+		v.mac("MAC.Address", p.MAC.Address)
+	}
+	if p.Plug != nil {
+		v.union("Plug", true,
+			p.Plug.Bridge != nil, p.Plug.Network != nil,
+			p.Plug.Direct != nil, p.Plug.HostDevPCI != nil)
+	}
+
+	return v.result()
+}
+
+// Validate performs offline sanity checks of the node device
+func (d *NodeDevice) Validate() error {
+	v := &validator{}
+
+	c := &d.Capability
+	v.union("Capability", true,
+		c.System != nil, c.PCI != nil, c.USB != nil, c.USBDevice != nil,
+		c.Net != nil, c.SCSIHost != nil, c.SCSITarget != nil, c.SCSI != nil,
+		c.Storage != nil, c.DRM != nil, c.CCW != nil, c.MDev != nil,
+		c.CSS != nil, c.APQueue != nil, c.APCard != nil, c.APMatrix != nil)
+
+	return v.result()
+}
@@ -0,0 +1,306 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"testing"
+)
+
+func testValidationPaths(t *testing.T, err error, expect []string) {
+	if len(expect) == 0 {
+		if err != nil {
+			t.Fatalf("Unexpected validation error %s", err)
+		}
+		return
+	}
+	errs, ok := err.(ValidationErrors)
+	if !ok {
+		t.Fatalf("Expected ValidationErrors, got %v", err)
+	}
+	if len(errs) != len(expect) {
+		t.Fatalf("Expected %d errors, got %s", len(expect), errs)
+	}
+	for i, e := range errs {
+		if e.Path != expect[i] {
+			t.Fatalf("Expected error at '%s', got '%s'", expect[i], e)
+		}
+	}
+}
+
+func TestDomainValidate(t *testing.T) {
+	dom := &Domain{
+		Type: "kvm",
+		Name: "demo",
+		Memory: &DomainMemory{
+			Value: 1048576,
+		},
+		Devices: &DomainDeviceList{
+			Disks: []DomainDisk{
+				DomainDisk{
+					Source: &DomainDiskSource{
+						File: &DomainDiskSourceFile{
+							File: "/demo.img",
+						},
+					},
+					Target: &DomainDiskTarget{
+						Dev: "vda",
+					},
+				},
+			},
+			Interfaces: []DomainInterface{
+				DomainInterface{
+					MAC: &DomainInterfaceMAC{
+						Address: "52:54:00:00:00:01",
+					},
+					Source: &DomainInterfaceSource{
+						Network: &DomainInterfaceSourceNetwork{
+							Network: "default",
+						},
+					},
+				},
+			},
+		},
+	}
+	testValidationPaths(t, dom.Validate(), nil)
+
+	dom.Name = ""
+	dom.Devices.Disks = append(dom.Devices.Disks, DomainDisk{
+		Address: &DomainAddress{
+			PCI:   &DomainAddressPCI{},
+			Drive: &DomainAddressDrive{},
+		},
+	})
+	dom.Devices.Interfaces[0].Source.Bridge = &DomainInterfaceSourceBridge{
+		Bridge: "br0",
+	}
+	dom.Devices.Interfaces[0].MAC.Address = "52:54:00"
+	testValidationPaths(t, dom.Validate(), []string{
+		"Name",
+		"Devices.Disks[1].Target",
+		"Devices.Disks[1].Address",
+		"Devices.Interfaces[0].Source",
+		"Devices.Interfaces[0].MAC.Address",
+	})
+}
+
+func TestNetworkValidate(t *testing.T) {
+	net := &Network{
+		Name: "default",
+		IPs: []NetworkIP{
+			NetworkIP{
+				Address: "192.168.122.1",
+				Netmask: "255.255.255.0",
+				Prefix:  24,
+				DHCP: &NetworkDHCP{
+					Ranges: []NetworkDHCPRange{
+						NetworkDHCPRange{
+							Start: "192.168.122.2",
+						},
+					},
+					Hosts: []NetworkDHCPHost{
+						NetworkDHCPHost{
+							IP: "192.168.122.3",
+						},
+					},
+				},
+			},
+		},
+	}
+	testValidationPaths(t, net.Validate(), []string{
+		"IPs[0]",
+		"IPs[0].DHCP.Ranges[0].End",
+		"IPs[0].DHCP.Hosts[0]",
+	})
+}
+
+func TestDomainValidateEnums(t *testing.T) {
+	dom := &Domain{
+		Type:       "kvm",
+		Name:       "demo",
+		Memory:     &DomainMemory{Value: 1048576},
+		OnPoweroff: "coredump-destroy",
+		OnCrash:    string(DomainCrashActionCoredumpDestroy),
+		Devices: &DomainDeviceList{
+			Disks: []DomainDisk{
+				DomainDisk{
+					Device: "disk",
+					Driver: &DomainDiskDriver{
+						Cache: "writeback",
+						IO:    "async",
+					},
+					Target: &DomainDiskTarget{
+						Dev: "vda",
+						Bus: "virtio-blk",
+					},
+				},
+			},
+			Inputs: []DomainInput{
+				DomainInput{
+					Type: "tablet",
+					Bus:  "usb",
+				},
+			},
+		},
+	}
+	testValidationPaths(t, dom.Validate(), []string{
+		"OnPoweroff",
+		"Devices.Disks[0].Target.Bus",
+		"Devices.Disks[0].Driver.IO",
+	})
+
+	net := &Network{
+		Name: "default",
+		Forward: &NetworkForward{
+			Mode: "masquerade",
+		},
+	}
+	testValidationPaths(t, net.Validate(), []string{
+		"Forward.Mode",
+	})
+
+	net = &Network{
+		Name: "default",
+		Forward: &NetworkForward{
+			NAT: &NetworkForwardNAT{
+				Ports: []NetworkForwardNATPort{
+					NetworkForwardNATPort{
+						Start: 1024,
+						End:   65535,
+					},
+				},
+			},
+		},
+	}
+	testValidationPaths(t, net.Validate(), nil)
+
+	net.Forward.Mode = "route"
+	testValidationPaths(t, net.Validate(), []string{
+		"Forward.NAT",
+	})
+}
+
+func TestStoragePoolValidate(t *testing.T) {
+	pool := &StoragePool{
+		Type: "netfs",
+		Name: "nfs",
+		Source: &StoragePoolSource{
+			Host: []StoragePoolSourceHost{
+				StoragePoolSourceHost{
+					Name: "nfs.example.org",
+				},
+			},
+			Dir: &StoragePoolSourceDir{
+				Path: "/export",
+			},
+		},
+		Target: &StoragePoolTarget{
+			Path: "/mnt/nfs",
+		},
+	}
+	testValidationPaths(t, pool.Validate(), nil)
+
+	pool.Type = "dir"
+	pool.Source.Adapter = &StoragePoolSourceAdapter{}
+	testValidationPaths(t, pool.Validate(), []string{
+		"Source.Adapter",
+		"Source.Dir",
+	})
+
+	pool.Type = "rbd"
+	pool.Source = nil
+	testValidationPaths(t, pool.Validate(), []string{
+		"Source.Host",
+		"Source.Name",
+	})
+}
+
+func TestSecretValidate(t *testing.T) {
+	secret := &Secret{
+		Usage: &SecretUsage{
+			Type:   "ceph",
+			Volume: "/var/lib/libvirt/images/demo.img",
+		},
+	}
+	testValidationPaths(t, secret.Validate(), []string{
+		"Usage.Name",
+		"Usage.Volume",
+	})
+}
+
+func TestNWFilterValidate(t *testing.T) {
+	filter := &NWFilter{
+		Name: "demo",
+		Entries: []NWFilterEntry{
+			NWFilterEntry{
+				Rule: &NWFilterRule{
+					Action:    "accept",
+					Direction: "in",
+					TCP:       &NWFilterRuleTCP{},
+					UDP:       &NWFilterRuleUDP{},
+				},
+			},
+			NWFilterEntry{
+				Ref: &NWFilterRef{},
+			},
+		},
+	}
+	testValidationPaths(t, filter.Validate(), []string{
+		"Entries[0].Rule",
+		"Entries[1].Ref.Filter",
+	})
+}
+
+func TestNetworkPortValidate(t *testing.T) {
+	port := &NetworkPort{
+		Owner: &NetworkPortOwner{
+			Name: "demo",
+		},
+		MAC: &NetworkPortMAC{
+			Address: "52:54:00:00:00:01",
+		},
+		Plug: &NetworkPortPlug{},
+	}
+	testValidationPaths(t, port.Validate(), []string{
+		"Owner.UUID",
+		"Plug",
+	})
+}
+
+func TestNodeDeviceValidate(t *testing.T) {
+	dev := &NodeDevice{}
+	testValidationPaths(t, dev.Validate(), []string{
+		"Capability",
+	})
+}
+
+func TestStorageVolumeValidate(t *testing.T) {
+	vol := &StorageVolume{
+		Name: "demo.img",
+	}
+	testValidationPaths(t, vol.Validate(), []string{
+		"Capacity",
+	})
+}
@@ -43,6 +43,8 @@ var xmldirs = []string{
 	"testdata/libvirt/tests/capabilityschemadata",
 	"testdata/libvirt/tests/cputestdata",
 	"testdata/libvirt/tests/domaincapsdata",
+	"testdata/libvirt/tests/domainbackupxml2xmlin",
+	"testdata/libvirt/tests/domainbackupxml2xmlout",
 	"testdata/libvirt/tests/domainconfdata",
 	"testdata/libvirt/tests/domainschemadata",
 	"testdata/libvirt/tests/genericxml2xmlindata",
@@ -63,6 +65,8 @@ var xmldirs = []string{
 	"testdata/libvirt/tests/nwfilterxml2xmlin",
 	"testdata/libvirt/tests/nwfilterxml2xmlout",
 	"testdata/libvirt/tests/qemuagentdata",
+	"testdata/libvirt/tests/qemudomaincheckpointxml2xmlin",
+	"testdata/libvirt/tests/qemudomaincheckpointxml2xmlout",
 	"testdata/libvirt/tests/qemucapabilitiesdata",
 	"testdata/libvirt/tests/qemudomainsnapshotxml2xmlin",
 	"testdata/libvirt/tests/qemudomainsnapshotxml2xmlout",
@@ -74,6 +78,7 @@ var xmldirs = []string{
 	"testdata/libvirt/tests/qemuxml2xmloutdata",
 	"testdata/libvirt/tests/secretxml2xmlin",
 	"testdata/libvirt/tests/securityselinuxlabeldata",
+	"testdata/libvirt/tests/storagepoolcapsschemadata",
 	"testdata/libvirt/tests/storagepoolschemadata",
 	"testdata/libvirt/tests/storagepoolxml2xmlin",
 	"testdata/libvirt/tests/storagepoolxml2xmlout",
@@ -311,6 +316,8 @@ func testRoundTrip(t *testing.T, xml string, filename string) {
 		doc = &NodeDevice{}
 	} else if strings.HasPrefix(xml, "<volume") {
 		doc = &StorageVolume{}
+	} else if strings.HasPrefix(xml, "<storagepoolCapabilities") {
+		doc = &StoragePoolCapabilities{}
 	} else if strings.HasPrefix(xml, "<pool") {
 		doc = &StoragePool{}
 	} else if strings.HasPrefix(xml, "<cpuTest") || strings.HasPrefix(xml, "<cpudata") {
@@ -336,6 +343,10 @@ func testRoundTrip(t *testing.T, xml string, filename string) {
 		}
 	} else if strings.HasPrefix(xml, "<domainsnapshot") {
 		doc = &DomainSnapshot{}
+	} else if strings.HasPrefix(xml, "<domainbackup") {
+		doc = &DomainBackup{}
+	} else if strings.HasPrefix(xml, "<domaincheckpoint") {
+		doc = &DomainCheckpoint{}
 	} else if strings.HasPrefix(xml, "<domainCapabilities") {
 		doc = &DomainCaps{}
 	} else if strings.HasPrefix(xml, "<disk") {
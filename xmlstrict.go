@@ -0,0 +1,225 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// UnrecognizedXMLError is returned by the UnmarshalStrict methods
+// when the document contains elements or attributes which were not
+// consumed into any struct field. The paths use the same syntax as
+// XMLMismatch, for example "/domain[0]/devices[0]/disk[1]/@foo"
+type UnrecognizedXMLError struct {
+	Elements   []string
+	Attributes []string
+}
+
+func (e *UnrecognizedXMLError) Error() string {
+	paths := append(append([]string{}, e.Elements...), e.Attributes...)
+	return "Unrecognized XML content: " + strings.Join(paths, ", ")
+}
+
+// unconsumedXML is an attribute, or a child element, of parent
+// which decoding does not use
+type unconsumedXML struct {
+	parent *XMLElement
+	attr   string
+	child  *XMLElement
+	path   string
+}
+
+// xmlProbe finds the parts of a document which decoding does not
+// use, by decoding it again with each attribute and element removed
+// or altered in turn. Anything which changes neither the decoded
+// value nor whether decoding succeeds, and which marshalling the
+// decoded value does not reproduce, was ignored. Asking the decoder
+// itself, UnmarshalXML methods included, means there is no separate
+// description of what each type reads to keep up to date
+type xmlProbe struct {
+	typ  reflect.Type
+	root *XMLElement
+	base interface{}
+	// Elements of the marshalled base value, by path
+	marshalled map[string]*XMLElement
+	unused     []unconsumedXML
+}
+
+func (p *xmlProbe) decode() (interface{}, error) {
+	obj := reflect.New(p.typ).Interface()
+	err := obj.(Document).Unmarshal(p.root.String())
+	return obj, err
+}
+
+// changed reports whether the document, as currently modified,
+// decodes differently to the original
+func (p *xmlProbe) changed() bool {
+	obj, err := p.decode()
+	return err != nil || !reflect.DeepEqual(obj, p.base)
+}
+
+func (p *xmlProbe) attrUsed(el *XMLElement, key string) bool {
+	val := el.Attrs[key]
+	defer func() {
+		el.Attrs[key] = val
+	}()
+
+	delete(el.Attrs, key)
+	if p.changed() {
+		return true
+	}
+	// Removing an attribute set to its default has no effect, but
+	// any other value will either change the result or fail to parse
+	el.Attrs[key] = val + "x"
+	return p.changed()
+}
+
+func (p *xmlProbe) childUsed(el *XMLElement, idx int) bool {
+	children := el.Children
+	defer func() {
+		el.Children = children
+	}()
+
+	el.Children = append(append([]*XMLElement{}, children[:idx]...), children[idx+1:]...)
+	if p.changed() {
+		return true
+	}
+	el.Children = children
+
+	// Likewise an empty element decoded as a string
+	child := children[idx]
+	if len(child.Children) != 0 {
+		return false
+	}
+	content := child.Content
+	defer func() {
+		child.Content = content
+	}()
+	child.Content = content + "x"
+	return p.changed()
+}
+
+func (p *xmlProbe) addMarshalled(path string, el *XMLElement) {
+	p.marshalled[path] = el
+	indexes := make(map[string]int)
+	for _, child := range el.Children {
+		index := indexes[child.Name]
+		indexes[child.Name] = index + 1
+		p.addMarshalled(fmt.Sprintf("%s/%s[%d]", path, child.Name, index), child)
+	}
+}
+
+func (p *xmlProbe) walk(path string, el *XMLElement) {
+	var keys []string
+	for key := range el.Attrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if out, ok := p.marshalled[path]; ok && out.Attrs[key] == el.Attrs[key] {
+			continue
+		}
+		if !p.attrUsed(el, key) {
+			p.unused = append(p.unused, unconsumedXML{
+				parent: el,
+				attr:   key,
+				path:   path + "/@" + key,
+			})
+		}
+	}
+
+	indexes := make(map[string]int)
+	for idx, child := range el.Children {
+		index := indexes[child.Name]
+		indexes[child.Name] = index + 1
+		childPath := fmt.Sprintf("%s/%s[%d]", path, child.Name, index)
+		if _, ok := p.marshalled[childPath]; !ok && !p.childUsed(el, idx) {
+			p.unused = append(p.unused, unconsumedXML{
+				parent: el,
+				child:  child,
+				path:   childPath,
+			})
+			continue
+		}
+		p.walk(childPath, child)
+	}
+}
+
+// findUnconsumedXML lists the attributes and elements of the tree
+// which decoding into a value of the same type as obj ignores
+func findUnconsumedXML(obj Document, root *XMLElement) ([]unconsumedXML, error) {
+	p := &xmlProbe{
+		typ:        reflect.TypeOf(obj).Elem(),
+		root:       root,
+		marshalled: make(map[string]*XMLElement),
+	}
+	base, err := p.decode()
+	if err != nil {
+		return nil, err
+	}
+	p.base = base
+
+	path := "/" + root.Name + "[0]"
+	if doc, err := base.(Document).Marshal(); err == nil {
+		if out, err := LoadXML(doc); err == nil && out.Name == root.Name {
+			p.addMarshalled(path, out)
+		}
+	}
+	p.walk(path, root)
+	return p.unused, nil
+}
+
+func unmarshalStrict(obj Document, doc string) error {
+	err := obj.Unmarshal(doc)
+	if err != nil {
+		return err
+	}
+
+	root, err := LoadXML(doc)
+	if err != nil {
+		return err
+	}
+
+	unused, err := findUnconsumedXML(obj, root)
+	if err != nil {
+		return err
+	}
+	if len(unused) == 0 {
+		return nil
+	}
+	uerr := &UnrecognizedXMLError{}
+	for _, u := range unused {
+		if u.child != nil {
+			uerr.Elements = append(uerr.Elements, u.path)
+		} else {
+			uerr.Attributes = append(uerr.Attributes, u.path)
+		}
+	}
+	return uerr
+}
@@ -0,0 +1,181 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestUnmarshalStrict(t *testing.T) {
+	doc := strings.Join([]string{
+		`<domain type="kvm">`,
+		`  <name>demo</name>`,
+		`  <vcpu current="0">2</vcpu>`,
+		`  <devices>`,
+		`    <disk type="file" device="disk">`,
+		`      <source file="/var/lib/libvirt/images/demo.qcow2"></source>`,
+		`      <target dev="vda" bus="virtio"></target>`,
+		`    </disk>`,
+		`    <serial type="pty">`,
+		`      <target port="0"></target>`,
+		`    </serial>`,
+		`  </devices>`,
+		`</domain>`,
+	}, "\n")
+
+	dom := &Domain{}
+	err := dom.UnmarshalStrict(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc = strings.Join([]string{
+		`<domain type="kvm" bogus="yes">`,
+		`  <name>demo</name>`,
+		`  <devices>`,
+		`    <disk type="block" device="disk">`,
+		`      <source dev="/dev/sda" file="/var/lib/libvirt/images/demo.qcow2"></source>`,
+		`      <target dev="vda" bus="virtio"></target>`,
+		`    </disk>`,
+		`    <serial type="tcp">`,
+		`      <source mode="bind" host="127.0.0.1" service="9999" path="/bogus"></source>`,
+		`      <target port="0"></target>`,
+		`    </serial>`,
+		`    <bogusdevice></bogusdevice>`,
+		`  </devices>`,
+		`</domain>`,
+	}, "\n")
+
+	dom = &Domain{}
+	err = dom.UnmarshalStrict(doc)
+	if err == nil {
+		t.Fatal("Expected unrecognized XML to be reported")
+	}
+	uerr, ok := err.(*UnrecognizedXMLError)
+	if !ok {
+		t.Fatalf("Expected UnrecognizedXMLError, got %s", err)
+	}
+
+	expectElements := "/domain[0]/devices[0]/bogusdevice[0]"
+	if strings.Join(uerr.Elements, ",") != expectElements {
+		t.Fatalf("Expected elements '%s', got %v", expectElements, uerr.Elements)
+	}
+	expectAttrs := strings.Join([]string{
+		"/domain[0]/@bogus",
+		"/domain[0]/devices[0]/disk[0]/source[0]/@file",
+		"/domain[0]/devices[0]/serial[0]/source[0]/@path",
+	}, ",")
+	if strings.Join(uerr.Attributes, ",") != expectAttrs {
+		t.Fatalf("Expected attributes '%s', got %v", expectAttrs, uerr.Attributes)
+	}
+
+	net := &Network{}
+	err = net.UnmarshalStrict(`<network><name>default</name><bogus/></network>`)
+	if _, ok := err.(*UnrecognizedXMLError); !ok {
+		t.Fatalf("Expected UnrecognizedXMLError, got %v", err)
+	}
+
+	filter := &NWFilter{}
+	err = filter.UnmarshalStrict(strings.Join([]string{
+		`<filter name="demo" chain="root">`,
+		`  <rule action="accept" direction="in" priority="500">`,
+		`    <tcp srcipaddr="10.0.0.1" dstportstart="22" bogus="yes"></tcp>`,
+		`  </rule>`,
+		`</filter>`,
+	}, "\n"))
+	uerr, ok = err.(*UnrecognizedXMLError)
+	if !ok {
+		t.Fatalf("Expected UnrecognizedXMLError, got %v", err)
+	}
+	expectAttrs = "/filter[0]/rule[0]/tcp[0]/@bogus"
+	if len(uerr.Elements) != 0 || strings.Join(uerr.Attributes, ",") != expectAttrs {
+		t.Fatalf("Expected attributes '%s', got %v %v", expectAttrs, uerr.Elements, uerr.Attributes)
+	}
+}
+
+func TestUnmarshalStrictTestData(t *testing.T) {
+	type strictDocument interface {
+		UnmarshalStrict(doc string) error
+	}
+	var objs []strictDocument
+	var docs []string
+
+	for _, test := range domainTestData {
+		if obj, ok := test.Object.(strictDocument); ok {
+			objs = append(objs, obj)
+			docs = append(docs, strings.Join(test.Expected, "\n"))
+		}
+	}
+	for _, test := range domainSnapshotTestData {
+		objs = append(objs, &DomainSnapshot{})
+		docs = append(docs, strings.Join(test.Expected, "\n"))
+	}
+	for _, test := range domainCheckpointTestData {
+		objs = append(objs, &DomainCheckpoint{})
+		docs = append(docs, strings.Join(test.Expected, "\n"))
+	}
+	for _, test := range domainBackupTestData {
+		objs = append(objs, &DomainBackup{})
+		docs = append(docs, strings.Join(test.Expected, "\n"))
+	}
+	for _, test := range networkTestData {
+		objs = append(objs, &Network{})
+		docs = append(docs, strings.Join(test.Expected, "\n"))
+	}
+	for _, test := range NodeDeviceTestData {
+		objs = append(objs, &NodeDevice{})
+		docs = append(docs, strings.Join(test.XML, "\n"))
+	}
+	for _, test := range nwfilterFirewallTestData {
+		for _, doc := range append([]string{test.Filter}, test.Filters...) {
+			objs = append(objs, &NWFilter{})
+			docs = append(docs, doc)
+		}
+	}
+	for _, test := range secretTestData {
+		objs = append(objs, &Secret{})
+		docs = append(docs, strings.Join(test.Expected, "\n"))
+	}
+	for _, test := range storagePoolTestData {
+		objs = append(objs, &StoragePool{})
+		docs = append(docs, strings.Join(test.Expected, "\n"))
+	}
+	for _, test := range storageVolumeTestData {
+		objs = append(objs, &StorageVolume{})
+		docs = append(docs, strings.Join(test.Expected, "\n"))
+	}
+
+	for i, obj := range objs {
+		typ := reflect.ValueOf(obj).Elem().Type()
+		newobj := reflect.New(typ).Interface().(strictDocument)
+		err := newobj.UnmarshalStrict(docs[i])
+		if err != nil {
+			t.Errorf("Unexpected error from strict %s parsing: %s\n%s", typ.Name(), err, docs[i])
+		}
+	}
+}
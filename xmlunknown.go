@@ -0,0 +1,276 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+package libvirtxml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// UnknownXML holds the attributes and elements of a document which
+// an UnmarshalPreserving method did not recognise, so that the
+// matching MarshalPreserving method can write them back out. Both
+// maps are keyed by the path of the element they belong to, in the
+// same form as DocumentChange paths, with "" being the root element.
+// Keying repeated elements by their natural key, such as disks by
+// target device, means the unknown XML follows its element if the
+// document is reordered, and is dropped if the element is removed
+type UnknownXML struct {
+	Attrs    map[string][]xml.Attr
+	Elements map[string][]*XMLElement
+}
+
+// walkUnknownXMLPaths calls fn for el and each of its descendants,
+// in document order, with the path used as the UnknownXML key
+func walkUnknownXMLPaths(path string, el *XMLElement, fn func(string, *XMLElement)) {
+	fn(path, el)
+	names := documentDiffChildNames(el, documentDiffChildCounts(el, el))
+	for i, child := range el.Children {
+		walkUnknownXMLPaths(documentDiffJoin(path, names[i]), child, fn)
+	}
+}
+
+func unmarshalPreserving(obj Document, doc string) (*UnknownXML, error) {
+	err := obj.Unmarshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := LoadXML(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	unused, err := findUnconsumedXML(obj, root)
+	if err != nil {
+		return nil, err
+	}
+
+	// The unknown XML is taken out of the tree before working out
+	// the paths, so that they match the paths in the marshalled
+	// document, where it is missing
+	values := make([]string, len(unused))
+	for i, u := range unused {
+		if u.child != nil {
+			for idx, child := range u.parent.Children {
+				if child == u.child {
+					u.parent.Children = append(u.parent.Children[:idx], u.parent.Children[idx+1:]...)
+					break
+				}
+			}
+		} else {
+			values[i] = u.parent.Attrs[u.attr]
+			delete(u.parent.Attrs, u.attr)
+		}
+	}
+
+	paths := make(map[*XMLElement]string)
+	walkUnknownXMLPaths("", root, func(path string, el *XMLElement) {
+		paths[el] = path
+	})
+
+	unknown := &UnknownXML{
+		Attrs:    make(map[string][]xml.Attr),
+		Elements: make(map[string][]*XMLElement),
+	}
+	for i, u := range unused {
+		path := paths[u.parent]
+		if u.child != nil {
+			unknown.Elements[path] = append(unknown.Elements[path], u.child)
+		} else {
+			ns, local := splitXMLName(u.attr)
+			unknown.Attrs[path] = append(unknown.Attrs[path], xml.Attr{
+				Name:  xml.Name{Space: ns, Local: local},
+				Value: values[i],
+			})
+		}
+	}
+	return unknown, nil
+}
+
+// writeUnknownAttrs adds attrs to the start tag raw, which ends
+// with its closing '>'. Namespaced attributes are given prefixes
+// declared on the same tag
+func writeUnknownAttrs(buf *bytes.Buffer, raw string, start xml.StartElement, attrs []xml.Attr) {
+	buf.WriteString(raw[:len(raw)-1])
+	declared := make(map[string]bool)
+	for _, attr := range start.Attr {
+		if attr.Name.Space == "xmlns" {
+			declared[attr.Name.Local] = true
+		}
+	}
+	prefixes := make(map[string]string)
+	for _, attr := range attrs {
+		name := attr.Name.Local
+		if attr.Name.Space != "" {
+			prefix, ok := prefixes[attr.Name.Space]
+			if !ok {
+				for n := 0; ; n++ {
+					prefix = fmt.Sprintf("ns%d", n)
+					if !declared[prefix] {
+						break
+					}
+				}
+				declared[prefix] = true
+				prefixes[attr.Name.Space] = prefix
+				buf.WriteString(" xmlns:" + prefix + "=\"")
+				xml.EscapeText(buf, []byte(attr.Name.Space))
+				buf.WriteString("\"")
+			}
+			name = prefix + ":" + name
+		}
+		buf.WriteString(" " + name + "=\"")
+		xml.EscapeText(buf, []byte(attr.Value))
+		buf.WriteString("\"")
+	}
+	buf.WriteString(">")
+}
+
+// marshalPreserving marshals obj and then adds the unknown XML,
+// copying everything else from the marshalled document unchanged
+func marshalPreserving(obj Document, unknown *UnknownXML) (string, error) {
+	doc, err := obj.Marshal()
+	if err != nil || unknown == nil {
+		return doc, err
+	}
+
+	root, err := LoadXML(doc)
+	if err != nil {
+		return "", err
+	}
+	var paths []string
+	walkUnknownXMLPaths("", root, func(path string, el *XMLElement) {
+		paths = append(paths, path)
+	})
+
+	var buf bytes.Buffer
+	var stack []string
+	// Whitespace is held back until the next token, since the
+	// indentation before an end tag moves after any unknown
+	// elements added to it
+	var pending string
+	d := xml.NewDecoder(strings.NewReader(doc))
+	offset := 0
+	for {
+		tok, err := d.RawToken()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return "", err
+		}
+		end := int(d.InputOffset())
+		raw := doc[offset:end]
+		offset = end
+
+		switch tok := tok.(type) {
+		case xml.CharData:
+			if strings.TrimSpace(string(tok)) == "" {
+				pending += raw
+				continue
+			}
+		case xml.StartElement:
+			if len(paths) == 0 {
+				return "", fmt.Errorf("Unexpected element '%s' in marshalled document", tok.Name.Local)
+			}
+			path := paths[0]
+			paths = paths[1:]
+			stack = append(stack, path)
+			if attrs := unknown.Attrs[path]; len(attrs) != 0 {
+				buf.WriteString(pending)
+				pending = ""
+				writeUnknownAttrs(&buf, raw, tok, attrs)
+				continue
+			}
+		case xml.EndElement:
+			path := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			indent := strings.Repeat("  ", len(stack))
+			for _, el := range unknown.Elements[path] {
+				w := &xmlWriter{
+					prefixes: make(map[string]string),
+					prefix:   indent + "  ",
+					indent:   "  ",
+				}
+				w.writeElement(el, nil)
+				buf.WriteString("\n" + indent + "  " + w.buf.String())
+				pending = "\n" + indent
+			}
+		}
+		buf.WriteString(pending)
+		pending = ""
+		buf.WriteString(raw)
+	}
+	buf.WriteString(pending)
+	return buf.String(), nil
+}
+
+// UnmarshalPreserving parses the document like Unmarshal, and in
+// addition returns any elements and attributes which were not
+// recognised, for MarshalPreserving to write back out rather than
+// them being lost
+func (d *Domain) UnmarshalPreserving(doc string) (*UnknownXML, error) {
+	return unmarshalPreserving(d, doc)
+}
+
+// MarshalPreserving formats the document like Marshal, adding the
+// unknown XML found by UnmarshalPreserving, which may be nil
+func (d *Domain) MarshalPreserving(unknown *UnknownXML) (string, error) {
+	return marshalPreserving(d, unknown)
+}
+
+func (d *DomainDisk) UnmarshalPreserving(doc string) (*UnknownXML, error) {
+	return unmarshalPreserving(d, doc)
+}
+
+func (d *DomainDisk) MarshalPreserving(unknown *UnknownXML) (string, error) {
+	return marshalPreserving(d, unknown)
+}
+
+func (d *DomainInterface) UnmarshalPreserving(doc string) (*UnknownXML, error) {
+	return unmarshalPreserving(d, doc)
+}
+
+func (d *DomainInterface) MarshalPreserving(unknown *UnknownXML) (string, error) {
+	return marshalPreserving(d, unknown)
+}
+
+func (s *Network) UnmarshalPreserving(doc string) (*UnknownXML, error) {
+	return unmarshalPreserving(s, doc)
+}
+
+func (s *Network) MarshalPreserving(unknown *UnknownXML) (string, error) {
+	return marshalPreserving(s, unknown)
+}
+
+func (s *StoragePool) UnmarshalPreserving(doc string) (*UnknownXML, error) {
+	return unmarshalPreserving(s, doc)
+}
+
+func (s *StoragePool) MarshalPreserving(unknown *UnknownXML) (string, error) {
+	return marshalPreserving(s, unknown)
+}
@@ -0,0 +1,296 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+var unknownXMLTestDomain = []string{
+	`<domain type="kvm" newattr="wibble">`,
+	`  <name>demo</name>`,
+	`  <features>`,
+	`    <acpi></acpi>`,
+	`    <newfeature state="on"></newfeature>`,
+	`  </features>`,
+	`  <devices>`,
+	`    <disk type="file" device="disk" newdiskattr="yes">`,
+	`      <source file="/var/lib/libvirt/images/demo.qcow2"></source>`,
+	`      <target dev="vda" bus="virtio"></target>`,
+	`      <newdiskelement>`,
+	`        <child value="1"></child>`,
+	`      </newdiskelement>`,
+	`    </disk>`,
+	`    <interface type="network">`,
+	`      <source network="default"></source>`,
+	`      <newnicelement></newnicelement>`,
+	`    </interface>`,
+	`    <newdevice model="foo"></newdevice>`,
+	`  </devices>`,
+	`  <newtoplevel>bar</newtoplevel>`,
+	`  <qemu:commandline xmlns:qemu="http://libvirt.org/schemas/domain/qemu/1.0">`,
+	`    <qemu:arg value="-newarg"></qemu:arg>`,
+	`  </qemu:commandline>`,
+	`</domain>`,
+}
+
+func TestUnknownXMLDomain(t *testing.T) {
+	doc := strings.Join(unknownXMLTestDomain, "\n")
+
+	dom := &Domain{}
+	unknown, err := dom.UnmarshalPreserving(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newdoc, err := dom.MarshalPreserving(unknown)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mismatches, err := CompareXML(doc, newdoc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mismatches) != 0 {
+		t.Fatalf("Unknown XML was not preserved %v\n%s", mismatches, newdoc)
+	}
+
+	for path, count := range map[string]int{
+		"":                         1,
+		"features":                 1,
+		"devices":                  1,
+		"devices/disk[target=vda]": 1,
+		"devices/interface":        1,
+	} {
+		if len(unknown.Elements[path]) != count {
+			t.Fatalf("Expected %d unknown elements at '%s', got %d", count, path, len(unknown.Elements[path]))
+		}
+	}
+	for _, path := range []string{"", "devices/disk[target=vda]"} {
+		if len(unknown.Attrs[path]) != 1 {
+			t.Fatalf("Expected an unknown attribute at '%s', got %v", path, unknown.Attrs[path])
+		}
+	}
+}
+
+func TestUnknownXMLNetwork(t *testing.T) {
+	doc := strings.Join([]string{
+		`<network newattr="yes">`,
+		`  <name>default</name>`,
+		`  <newelement></newelement>`,
+		`</network>`,
+	}, "\n")
+
+	net := &Network{}
+	unknown, err := net.UnmarshalPreserving(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newdoc, err := net.MarshalPreserving(unknown)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newdoc != doc {
+		t.Fatal("Bad xml:\n", newdoc, "\n does not match\n", doc, "\n")
+	}
+}
+
+func TestUnknownXMLStoragePool(t *testing.T) {
+	doc := strings.Join([]string{
+		`<pool type="dir" newattr="yes">`,
+		`  <name>default</name>`,
+		`  <newelement>`,
+		`    <child value="1"></child>`,
+		`  </newelement>`,
+		`</pool>`,
+	}, "\n")
+
+	pool := &StoragePool{}
+	unknown, err := pool.UnmarshalPreserving(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newdoc, err := pool.MarshalPreserving(unknown)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newdoc != doc {
+		t.Fatal("Bad xml:\n", newdoc, "\n does not match\n", doc, "\n")
+	}
+}
+
+func TestUnknownXMLDisk(t *testing.T) {
+	doc := strings.Join([]string{
+		`<disk type="file" device="disk" newattr="yes">`,
+		`  <source file="/var/lib/libvirt/images/demo.qcow2"></source>`,
+		`  <target dev="vda" bus="virtio"></target>`,
+		`  <newelement></newelement>`,
+		`</disk>`,
+	}, "\n")
+
+	disk := &DomainDisk{}
+	unknown, err := disk.UnmarshalPreserving(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newdoc, err := disk.MarshalPreserving(unknown)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newdoc != doc {
+		t.Fatal("Bad xml:\n", newdoc, "\n does not match\n", doc, "\n")
+	}
+}
+
+func TestUnknownXMLReordered(t *testing.T) {
+	doc := strings.Join([]string{
+		`<domain type="kvm">`,
+		`  <name>demo</name>`,
+		`  <devices>`,
+		`    <disk type="file" device="disk">`,
+		`      <target dev="vda" bus="virtio"></target>`,
+		`    </disk>`,
+		`    <disk type="file" device="disk" newattr="yes">`,
+		`      <target dev="vdb" bus="virtio"></target>`,
+		`      <newelement></newelement>`,
+		`    </disk>`,
+		`  </devices>`,
+		`</domain>`,
+	}, "\n")
+	expect := strings.Join([]string{
+		`<domain type="kvm">`,
+		`  <name>demo</name>`,
+		`  <devices>`,
+		`    <disk type="file" device="disk" newattr="yes">`,
+		`      <target dev="vdb" bus="virtio"></target>`,
+		`      <newelement></newelement>`,
+		`    </disk>`,
+		`  </devices>`,
+		`</domain>`,
+	}, "\n")
+
+	dom := &Domain{}
+	unknown, err := dom.UnmarshalPreserving(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dom.Devices.Disks = dom.Devices.Disks[1:]
+	newdoc, err := dom.MarshalPreserving(unknown)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newdoc != expect {
+		t.Fatal("Bad xml:\n", newdoc, "\n does not match\n", expect, "\n")
+	}
+}
+
+func TestUnknownXMLNamespace(t *testing.T) {
+	doc := strings.Join([]string{
+		`<domain type="kvm" xmlns:foo="http://example.org/foo">`,
+		`  <name>demo</name>`,
+		`  <devices foo:attr="2">`,
+		`    <foo:x foo:attr="1">`,
+		`      <foo:y></foo:y>`,
+		`    </foo:x>`,
+		`  </devices>`,
+		`</domain>`,
+	}, "\n")
+	expect := strings.Join([]string{
+		`<domain type="kvm">`,
+		`  <name>demo</name>`,
+		`  <devices xmlns:ns0="http://example.org/foo" ns0:attr="2">`,
+		`    <ns0:x xmlns:ns0="http://example.org/foo" ns0:attr="1">`,
+		`      <ns0:y></ns0:y>`,
+		`    </ns0:x>`,
+		`  </devices>`,
+		`</domain>`,
+	}, "\n")
+
+	dom := &Domain{}
+	unknown, err := dom.UnmarshalPreserving(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newdoc, err := dom.MarshalPreserving(unknown)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newdoc != expect {
+		t.Fatal("Bad xml:\n", newdoc, "\n does not match\n", expect, "\n")
+	}
+
+	mismatches, err := CompareXML(doc, newdoc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mismatches) != 0 {
+		t.Fatalf("Unknown XML was not preserved %v\n%s", mismatches, newdoc)
+	}
+}
+
+func TestUnknownXMLNotPreserved(t *testing.T) {
+	doc := strings.Join(unknownXMLTestDomain, "\n")
+
+	dom := &Domain{}
+	err := dom.Unmarshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newdoc, err := dom.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"newattr", "newfeature", "newdiskattr", "newdiskelement",
+		"newnicelement", "newdevice", "newtoplevel"} {
+		if strings.Contains(newdoc, name) {
+			t.Fatalf("Unknown XML '%s' was unexpectedly preserved\n%s", name, newdoc)
+		}
+	}
+
+	dom = &Domain{}
+	unknown, err := dom.UnmarshalPreserving(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaindoc, err := dom.MarshalPreserving(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plaindoc != newdoc {
+		t.Fatal("Bad xml:\n", plaindoc, "\n does not match\n", newdoc, "\n")
+	}
+	if len(unknown.Elements) == 0 {
+		t.Fatal("No unknown XML found")
+	}
+}
@@ -28,25 +28,29 @@ package libvirtxml
 import (
 	"encoding/xml"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 )
 
-type element struct {
+// XMLElement is a generic, namespace aware, representation of an
+// XML element, which can hold any libvirt XML document regardless
+// of whether it has a corresponding struct in this package
+type XMLElement struct {
 	XMLNS    string
 	Name     string
 	Attrs    map[string]string
 	Content  string
-	Children []*element
+	Children []*XMLElement
 }
 
-type elementstack []*element
+type elementstack []*XMLElement
 
-func (s *elementstack) push(v *element) {
+func (s *elementstack) push(v *XMLElement) {
 	*s = append(*s, v)
 }
 
-func (s *elementstack) pop() *element {
+func (s *elementstack) pop() *XMLElement {
 	res := (*s)[len(*s)-1]
 	*s = (*s)[:len(*s)-1]
 	return res
@@ -72,20 +76,44 @@ func xmlName(xmlns string, name xml.Name) string {
 	return name.Local + "(" + xmlns + ")"
 }
 
-func loadXML(xmlstr string, ignoreNSDecl bool) (*element, error) {
+// splitXMLName reverses xmlName
+func splitXMLName(name string) (string, string) {
+	if strings.HasSuffix(name, ")") {
+		if idx := strings.Index(name, "("); idx != -1 {
+			return name[idx+1 : len(name)-1], name[:idx]
+		}
+	}
+	return "", name
+}
+
+func rawXMLName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+// LoadXML parses an XML document into a generic element tree.
+// Element and attribute names in a namespace are qualified with
+// the namespace URI, so that documents using different prefixes
+// for the same namespace produce identical trees
+func LoadXML(xmlstr string) (*XMLElement, error) {
 	xmlnsMap := make(map[string]string)
 	xmlr := strings.NewReader(xmlstr)
 
 	d := xml.NewDecoder(xmlr)
-	var root *element
+	var root *XMLElement
 	stack := elementstack{}
+	// Raw names of the open elements, since RawToken does not
+	// check that end elements match
+	var names []xml.Name
 	for {
 		t, err := d.RawToken()
 		if err != nil {
 			return nil, err
 		}
 
-		var parent *element
+		var parent *XMLElement
 		if root != nil {
 			if len(stack) == 0 {
 				return nil, fmt.Errorf("Unexpectedly empty stack")
@@ -107,7 +135,7 @@ func loadXML(xmlstr string, ignoreNSDecl bool) (*element, error) {
 				}
 			}
 			xmlns = getNamespaceURI(xmlnsMap, xmlns, t.Name)
-			child := &element{
+			child := &XMLElement{
 				XMLNS: xmlns,
 				Name:  xmlName(xmlns, t.Name),
 				Attrs: make(map[string]string),
@@ -124,6 +152,7 @@ func loadXML(xmlstr string, ignoreNSDecl bool) (*element, error) {
 				child.Attrs[xmlName(attrNS, a.Name)] = a.Value
 			}
 			stack.push(child)
+			names = append(names, t.Name)
 			if root == nil {
 				root = child
 			} else {
@@ -131,6 +160,15 @@ func loadXML(xmlstr string, ignoreNSDecl bool) (*element, error) {
 				parent.Content = ""
 			}
 		case xml.EndElement:
+			if len(names) == 0 {
+				return nil, fmt.Errorf("Unexpected end element '%s'", rawXMLName(t.Name))
+			}
+			open := names[len(names)-1]
+			if open != t.Name {
+				return nil, fmt.Errorf("End element '%s' does not match '%s'",
+					rawXMLName(t.Name), rawXMLName(open))
+			}
+			names = names[:len(names)-1]
 			stack.pop()
 		case xml.CharData:
 			if parent != nil && len(parent.Children) == 0 {
@@ -149,56 +187,159 @@ func loadXML(xmlstr string, ignoreNSDecl bool) (*element, error) {
 	return root, nil
 }
 
-func testCompareValue(filename, path, key, expected, actual string) error {
+type XMLMismatchKind string
+
+const (
+	XMLMismatchName              XMLMismatchKind = "name"
+	XMLMismatchAttrValue         XMLMismatchKind = "attribute-value"
+	XMLMismatchAttrMissing       XMLMismatchKind = "attribute-missing"
+	XMLMismatchAttrUnexpected    XMLMismatchKind = "attribute-unexpected"
+	XMLMismatchContent           XMLMismatchKind = "content"
+	XMLMismatchElementMissing    XMLMismatchKind = "element-missing"
+	XMLMismatchElementUnexpected XMLMismatchKind = "element-unexpected"
+)
+
+// XMLMismatch describes a single difference found when comparing
+// an expected XML tree against an actual XML tree. Paths use the
+// form "/domain[0]/devices[0]/disk[1]/@type", where the index
+// counts elements with the same name under the same parent.
+// "Missing" means present in the expected tree only, while
+// "unexpected" means present in the actual tree only
+type XMLMismatch struct {
+	Kind       XMLMismatchKind
+	ExpectPath string
+	ActualPath string
+	Expected   string
+	Actual     string
+}
+
+func (m XMLMismatch) String() string {
+	switch m.Kind {
+	case XMLMismatchName:
+		return fmt.Sprintf("%s: name '%s' doesn't match '%s'",
+			m.ExpectPath, m.Expected, m.Actual)
+	case XMLMismatchAttrValue:
+		return fmt.Sprintf("%s: attribute actual value '%s' does not match expected value '%s'",
+			m.ActualPath, m.Actual, m.Expected)
+	case XMLMismatchAttrMissing:
+		return fmt.Sprintf("%s: attribute '%s'  in expected XML missing in actual XML",
+			m.ExpectPath, m.Expected)
+	case XMLMismatchAttrUnexpected:
+		return fmt.Sprintf("%s: attribute in actual XML missing in expected XML",
+			m.ActualPath)
+	case XMLMismatchContent:
+		return fmt.Sprintf("%s: actual content '%s' does not match expected '%s'",
+			m.ActualPath, m.Actual, m.Expected)
+	case XMLMismatchElementMissing:
+		return fmt.Sprintf("%s: element in expected XML missing in actual XML",
+			m.ExpectPath)
+	case XMLMismatchElementUnexpected:
+		return fmt.Sprintf("%s: element in actual XML missing in expected XML",
+			m.ActualPath)
+	}
+	return fmt.Sprintf("%s: %s", m.ActualPath, m.Kind)
+}
+
+// XMLCompareOptions lists element or attribute paths, using
+// the same syntax as XMLMismatch, which are permitted to be
+// present in only the expected or only the actual tree
+type XMLCompareOptions struct {
+	IgnoreExpectPaths []string
+	IgnoreActualPaths []string
+}
+
+// XMLValuesEqual reports whether two attribute values are the
+// same, treating integers written in different bases, such as
+// "0x1f" and "31", as equal
+func XMLValuesEqual(expected, actual string) bool {
 	if expected == actual {
-		return nil
+		return true
 	}
 
 	i1, err1 := strconv.ParseInt(expected, 0, 64)
 	i2, err2 := strconv.ParseInt(actual, 0, 64)
-	if err1 == nil && err2 == nil && i1 == i2 {
-		return nil
-	}
-	path = path + "/@" + key
-	return fmt.Errorf("%s: %s: attribute actual value '%s' does not match expected value '%s'",
-		filename, path, actual, expected)
+	return err1 == nil && err2 == nil && i1 == i2
+}
+
+type xmlComparator struct {
+	ignoreExpect map[string]bool
+	ignoreActual map[string]bool
+	mismatches   []XMLMismatch
+}
+
+func (c *xmlComparator) report(m XMLMismatch) {
+	c.mismatches = append(c.mismatches, m)
 }
 
-func testCompareElement(filename, expectPath, actualPath string, expect, actual *element, extraExpectNodes, extraActualNodes map[string]bool) error {
+func (c *xmlComparator) compareElement(expectPath, actualPath string, expect, actual *XMLElement) {
 	if expect.Name != actual.Name {
-		return fmt.Errorf("%s: name '%s' doesn't match '%s'",
-			expectPath, expect.Name, actual.Name)
+		c.report(XMLMismatch{
+			Kind:       XMLMismatchName,
+			ExpectPath: expectPath,
+			ActualPath: actualPath,
+			Expected:   expect.Name,
+			Actual:     actual.Name,
+		})
+		return
 	}
 
-	expectAttr := expect.Attrs
-	for key, val := range actual.Attrs {
-		expectval, ok := expectAttr[key]
+	var keys []string
+	for key := range actual.Attrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		val := actual.Attrs[key]
+		expectval, ok := expect.Attrs[key]
 		if !ok {
 			attrPath := actualPath + "/@" + key
-			if _, ok := extraActualNodes[attrPath]; ok {
+			if c.ignoreActual[attrPath] {
 				continue
 			}
-			return fmt.Errorf("%s: %s: attribute in actual XML missing in expected XML",
-				filename, attrPath)
+			c.report(XMLMismatch{
+				Kind:       XMLMismatchAttrUnexpected,
+				ActualPath: attrPath,
+				Actual:     val,
+			})
+			continue
 		}
-		err := testCompareValue(filename, actualPath, key, expectval, val)
-		if err != nil {
-			return err
+		if !XMLValuesEqual(expectval, val) {
+			c.report(XMLMismatch{
+				Kind:       XMLMismatchAttrValue,
+				ExpectPath: expectPath + "/@" + key,
+				ActualPath: actualPath + "/@" + key,
+				Expected:   expectval,
+				Actual:     val,
+			})
+		}
+	}
+	keys = nil
+	for key := range expect.Attrs {
+		if _, ok := actual.Attrs[key]; !ok {
+			keys = append(keys, key)
 		}
-		delete(expectAttr, key)
 	}
-	for key, _ := range expectAttr {
+	sort.Strings(keys)
+	for _, key := range keys {
 		attrPath := expectPath + "/@" + key
-		if _, ok := extraExpectNodes[attrPath]; ok {
+		if c.ignoreExpect[attrPath] {
 			continue
 		}
-		return fmt.Errorf("%s: %s: attribute '%s'  in expected XML missing in actual XML",
-			filename, attrPath, expectAttr[key])
+		c.report(XMLMismatch{
+			Kind:       XMLMismatchAttrMissing,
+			ExpectPath: attrPath,
+			Expected:   expect.Attrs[key],
+		})
 	}
 
 	if expect.Content != actual.Content {
-		return fmt.Errorf("%s: %s: actual content '%s' does not match expected '%s'",
-			filename, actualPath, actual.Content, expect.Content)
+		c.report(XMLMismatch{
+			Kind:       XMLMismatchContent,
+			ExpectPath: expectPath,
+			ActualPath: actualPath,
+			Expected:   expect.Content,
+			Actual:     actual.Content,
+		})
 	}
 
 	used := make([]bool, len(actual.Children))
@@ -209,7 +350,7 @@ func testCompareElement(filename, expectPath, actualPath string, expect, actual
 		expectChildIndexes[expectChild.Name] = expectIndex + 1
 		subExpectPath := fmt.Sprintf("%s/%s[%d]", expectPath, expectChild.Name, expectIndex)
 
-		var actualChild *element = nil
+		var actualChild *XMLElement = nil
 		for i := 0; i < len(used); i++ {
 			if !used[i] && actual.Children[i].Name == expectChild.Name {
 				actualChild = actual.Children[i]
@@ -218,21 +359,21 @@ func testCompareElement(filename, expectPath, actualPath string, expect, actual
 			}
 		}
 		if actualChild == nil {
-			if _, ok := extraExpectNodes[subExpectPath]; ok {
+			if c.ignoreExpect[subExpectPath] {
 				continue
 			}
-			return fmt.Errorf("%s: %s: element in expected XML missing in actual XML",
-				filename, subExpectPath)
+			c.report(XMLMismatch{
+				Kind:       XMLMismatchElementMissing,
+				ExpectPath: subExpectPath,
+			})
+			continue
 		}
 
 		actualIndex, _ := actualChildIndexes[actualChild.Name]
 		actualChildIndexes[actualChild.Name] = actualIndex + 1
 		subActualPath := fmt.Sprintf("%s/%s[%d]", actualPath, actualChild.Name, actualIndex)
 
-		err := testCompareElement(filename, subExpectPath, subActualPath, expectChild, actualChild, extraExpectNodes, extraActualNodes)
-		if err != nil {
-			return err
-		}
+		c.compareElement(subExpectPath, subActualPath, expectChild, actualChild)
 	}
 
 	actualChildIndexes = make(map[string]uint)
@@ -244,14 +385,14 @@ func testCompareElement(filename, expectPath, actualPath string, expect, actual
 		}
 		subActualPath := fmt.Sprintf("%s/%s[%d]", actualPath, actualChild.Name, actualIndex)
 
-		if _, ok := extraActualNodes[subActualPath]; ok {
+		if c.ignoreActual[subActualPath] {
 			continue
 		}
-		return fmt.Errorf("%s: %s: element in actual XML missing in expected XML",
-			filename, subActualPath)
+		c.report(XMLMismatch{
+			Kind:       XMLMismatchElementUnexpected,
+			ActualPath: subActualPath,
+		})
 	}
-
-	return nil
 }
 
 func makeExtraNodeMap(nodes []string) map[string]bool {
@@ -262,30 +403,50 @@ func makeExtraNodeMap(nodes []string) map[string]bool {
 	return ret
 }
 
-func testCompareXML(filename, expectStr, actualStr string, extraExpectNodes, extraActualNodes []string) error {
-	extraExpectNodeMap := makeExtraNodeMap(extraExpectNodes)
-	extraActualNodeMap := makeExtraNodeMap(extraActualNodes)
+// CompareXMLElements compares an actual XML tree against an
+// expected XML tree, returning every mismatch found. Repeated
+// child elements are matched up in order of appearance
+func CompareXMLElements(expect, actual *XMLElement, opts *XMLCompareOptions) []XMLMismatch {
+	if opts == nil {
+		opts = &XMLCompareOptions{}
+	}
+	c := &xmlComparator{
+		ignoreExpect: makeExtraNodeMap(opts.IgnoreExpectPaths),
+		ignoreActual: makeExtraNodeMap(opts.IgnoreActualPaths),
+	}
+
+	c.compareElement("/"+expect.Name+"[0]", "/"+actual.Name+"[0]", expect, actual)
 
-	//fmt.Printf("%s\n", expectedstr)
-	expectRoot, err := loadXML(expectStr, true)
+	return c.mismatches
+}
+
+// CompareXML parses two XML documents and compares them with
+// CompareXMLElements
+func CompareXML(expectStr, actualStr string, opts *XMLCompareOptions) ([]XMLMismatch, error) {
+	expectRoot, err := LoadXML(expectStr)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	//fmt.Printf("%s\n", actualstr)
-	actualRoot, err := loadXML(actualStr, true)
+	actualRoot, err := LoadXML(actualStr)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if expectRoot.Name != actualRoot.Name {
-		return fmt.Errorf("%s: /: expected root element '%s' does not match actual '%s'",
-			filename, expectRoot.Name, actualRoot.Name)
-	}
+	return CompareXMLElements(expectRoot, actualRoot, opts), nil
+}
 
-	err = testCompareElement(filename, "/"+expectRoot.Name+"[0]", "/"+actualRoot.Name+"[0]", expectRoot, actualRoot, extraExpectNodeMap, extraActualNodeMap)
+func testCompareXML(filename, expectStr, actualStr string, extraExpectNodes, extraActualNodes []string) error {
+	mismatches, err := CompareXML(expectStr, actualStr, &XMLCompareOptions{
+		IgnoreExpectPaths: extraExpectNodes,
+		IgnoreActualPaths: extraActualNodes,
+	})
 	if err != nil {
 		return err
 	}
 
+	if len(mismatches) != 0 {
+		return fmt.Errorf("%s: %s", filename, mismatches[0])
+	}
+
 	return nil
 }
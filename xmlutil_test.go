@@ -0,0 +1,159 @@
+/*
+ * This file is part of the libvirt-go-xml project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"testing"
+)
+
+func TestLoadXML(t *testing.T) {
+	doc := `<domain xmlns:qemu="http://libvirt.org/schemas/domain/qemu/1.0" type="kvm">
+  <name>demo</name>
+  <qemu:commandline>
+    <qemu:arg value="-newarg"/>
+  </qemu:commandline>
+</domain>`
+
+	root, err := LoadXML(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root.Name != "domain" || root.Attrs["type"] != "kvm" {
+		t.Fatalf("Unexpected root element %s %v", root.Name, root.Attrs)
+	}
+	if len(root.Children) != 2 {
+		t.Fatalf("Expected 2 children, got %d", len(root.Children))
+	}
+	if root.Children[0].Content != "demo" {
+		t.Fatalf("Unexpected name content '%s'", root.Children[0].Content)
+	}
+	cmdline := root.Children[1]
+	if cmdline.XMLNS != "http://libvirt.org/schemas/domain/qemu/1.0" ||
+		cmdline.Name != "commandline(http://libvirt.org/schemas/domain/qemu/1.0)" {
+		t.Fatalf("Unexpected namespaced element %s %s", cmdline.XMLNS, cmdline.Name)
+	}
+}
+
+func TestLoadXMLErrors(t *testing.T) {
+	var tests = []string{
+		``,
+		`</a>`,
+		`<a></b>`,
+		`<a><b></a></b>`,
+		`<x:a></y:a>`,
+		`<a>`,
+	}
+
+	for _, doc := range tests {
+		if _, err := LoadXML(doc); err == nil {
+			t.Errorf("Expected error for '%s'", doc)
+		}
+	}
+}
+
+func TestCompareXML(t *testing.T) {
+	expect := `<domain type="kvm">
+  <name>demo</name>
+  <devices>
+    <controller type="pci" index="0x1f"/>
+    <disk type="file"/>
+    <disk type="block"/>
+  </devices>
+</domain>`
+
+	actual := `<domain type="kvm">
+  <name>demo</name>
+  <devices>
+    <controller type="pci" index="31"/>
+    <disk type="file"/>
+    <disk type="block"/>
+  </devices>
+</domain>`
+
+	mismatches, err := CompareXML(expect, actual, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mismatches) != 0 {
+		t.Fatalf("Unexpected mismatches %v", mismatches)
+	}
+
+	actual = `<domain xmlns:foo="http://example.org/foo" foo:bar="wibble">
+  <name>other</name>
+  <devices>
+    <controller type="pci" index="30"/>
+    <disk type="file"/>
+    <interface type="network"/>
+  </devices>
+</domain>`
+
+	mismatches, err = CompareXML(expect, actual, &XMLCompareOptions{
+		IgnoreActualPaths: []string{
+			"/domain[0]/@bar(http://example.org/foo)",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectMismatches := []XMLMismatch{
+		XMLMismatch{
+			Kind:       XMLMismatchAttrMissing,
+			ExpectPath: "/domain[0]/@type",
+			Expected:   "kvm",
+		},
+		XMLMismatch{
+			Kind:       XMLMismatchContent,
+			ExpectPath: "/domain[0]/name[0]",
+			ActualPath: "/domain[0]/name[0]",
+			Expected:   "demo",
+			Actual:     "other",
+		},
+		XMLMismatch{
+			Kind:       XMLMismatchAttrValue,
+			ExpectPath: "/domain[0]/devices[0]/controller[0]/@index",
+			ActualPath: "/domain[0]/devices[0]/controller[0]/@index",
+			Expected:   "0x1f",
+			Actual:     "30",
+		},
+		XMLMismatch{
+			Kind:       XMLMismatchElementMissing,
+			ExpectPath: "/domain[0]/devices[0]/disk[1]",
+		},
+		XMLMismatch{
+			Kind:       XMLMismatchElementUnexpected,
+			ActualPath: "/domain[0]/devices[0]/interface[0]",
+		},
+	}
+	if len(mismatches) != len(expectMismatches) {
+		t.Fatalf("Expected %d mismatches, got %v", len(expectMismatches), mismatches)
+	}
+	for i, mismatch := range mismatches {
+		if mismatch != expectMismatches[i] {
+			t.Fatalf("Expected mismatch %v, got %v", expectMismatches[i], mismatch)
+		}
+	}
+}